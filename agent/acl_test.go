@@ -136,6 +136,9 @@ func (a *TestACLAgent) LANMembers() []serf.Member {
 func (a *TestACLAgent) LANMembersAllSegments() ([]serf.Member, error) {
 	return nil, fmt.Errorf("Unimplemented")
 }
+func (a *TestACLAgent) LANMembersHealthScore() int {
+	return 0
+}
 func (a *TestACLAgent) LANSegmentMembers(segment string) ([]serf.Member, error) {
 	return nil, fmt.Errorf("Unimplemented")
 }
@@ -155,6 +158,9 @@ func (a *TestACLAgent) RPC(method string, args interface{}, reply interface{}) e
 func (a *TestACLAgent) SnapshotRPC(args *structs.SnapshotRequest, in io.Reader, out io.Writer, replyFn structs.SnapshotReplyFn) error {
 	return fmt.Errorf("Unimplemented")
 }
+func (a *TestACLAgent) SyncPacingHint() time.Duration {
+	return 0
+}
 func (a *TestACLAgent) Shutdown() error {
 	return fmt.Errorf("Unimplemented")
 }
@@ -67,6 +67,15 @@ type StateSyncer struct {
 	// This needs to be set before Run() is called.
 	ClusterSize func() int
 
+	// ServerPacingHint, if set, returns a server-advertised pacing hint
+	// for staggering full syncs after a mass reconnect (e.g. once a
+	// partition heals). When it returns a value larger than
+	// serverUpInterval, it's used as the stagger window instead, so the
+	// leader can spread out a reconnect storm more aggressively than the
+	// fixed default allows. A nil func or a zero return falls back to
+	// serverUpInterval.
+	ServerPacingHint func() time.Duration
+
 	// SyncFull allows triggering an immediate but staggered full sync
 	// in a non-blocking way.
 	SyncFull *Trigger
@@ -243,7 +252,7 @@ func (s *StateSyncer) retrySyncFullEventFn() event {
 	// stagger the delay to avoid a thundering herd.
 	case <-s.SyncFull.Notif():
 		select {
-		case <-time.After(s.stagger(s.serverUpInterval)):
+		case <-time.After(s.stagger(s.reconnectStaggerWindow())):
 			return syncFullNotifEvent
 		case <-s.ShutdownCh:
 			return shutdownEvent
@@ -271,7 +280,7 @@ func (s *StateSyncer) syncChangesEventFn() event {
 	// stagger the delay to avoid a thundering herd.
 	case <-s.SyncFull.Notif():
 		select {
-		case <-time.After(s.stagger(s.serverUpInterval)):
+		case <-time.After(s.stagger(s.reconnectStaggerWindow())):
 			s.resetNextFullSyncCh()
 			return syncFullNotifEvent
 		case <-s.ShutdownCh:
@@ -314,6 +323,21 @@ func (s *StateSyncer) staggerFn(d time.Duration) time.Duration {
 	return libRandomStagger(time.Duration(f) * d)
 }
 
+// reconnectStaggerWindow returns the window used to stagger a full sync
+// triggered by a server coming back up. It defers to ServerPacingHint, if
+// set and larger than the default, so the leader can widen the window
+// during a reconnect storm rather than letting every agent retry within
+// the same fixed few seconds.
+func (s *StateSyncer) reconnectStaggerWindow() time.Duration {
+	if s.ServerPacingHint == nil {
+		return s.serverUpInterval
+	}
+	if hint := s.ServerPacingHint(); hint > s.serverUpInterval {
+		return hint
+	}
+	return s.serverUpInterval
+}
+
 // Pause temporarily disables sync runs.
 func (s *StateSyncer) Pause() {
 	s.pauseLock.Lock()
@@ -96,6 +96,27 @@ func TestAE_staggerDependsOnClusterSize(t *testing.T) {
 	}
 }
 
+func TestAE_reconnectStaggerWindow(t *testing.T) {
+	l := testSyncer(t)
+
+	// No hint set falls back to the default.
+	if got, want := l.reconnectStaggerWindow(), l.serverUpInterval; got != want {
+		t.Fatalf("got %v want %v", got, want)
+	}
+
+	// A hint smaller than the default is ignored.
+	l.ServerPacingHint = func() time.Duration { return time.Millisecond }
+	if got, want := l.reconnectStaggerWindow(), l.serverUpInterval; got != want {
+		t.Fatalf("got %v want %v", got, want)
+	}
+
+	// A hint larger than the default wins.
+	l.ServerPacingHint = func() time.Duration { return time.Hour }
+	if got, want := l.reconnectStaggerWindow(), time.Hour; got != want {
+		t.Fatalf("got %v want %v", got, want)
+	}
+}
+
 func TestAE_Run_SyncFullBeforeChanges(t *testing.T) {
 	shutdownCh := make(chan struct{})
 	state := &mock{
@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/consul/agent/checks"
 	"github.com/hashicorp/consul/agent/config"
 	"github.com/hashicorp/consul/agent/consul"
+	"github.com/hashicorp/consul/agent/debug"
 	"github.com/hashicorp/consul/agent/dns"
 	"github.com/hashicorp/consul/agent/local"
 	"github.com/hashicorp/consul/agent/proxycfg"
@@ -64,6 +65,14 @@ const (
 	checksDir     = "checks"
 	checkStateDir = "checks/state"
 
+	// Path to save write-ahead deregistration intents, see intent_log.go
+	deregisterIntentsDir = "deregister-intents"
+
+	// intentReapInterval is how often pending deregistration intents are
+	// checked against local state so ones that have finished syncing can
+	// be purged from disk.
+	intentReapInterval = 1 * time.Minute
+
 	// Default reasons for node/service maintenance mode
 	defaultNodeMaintReason = "Maintenance mode is enabled for this node, " +
 		"but no reason was provided. This is a default message."
@@ -125,6 +134,7 @@ type delegate interface {
 	Leave() error
 	LANMembers() []serf.Member
 	LANMembersAllSegments() ([]serf.Member, error)
+	LANMembersHealthScore() int
 	LANSegmentMembers(segment string) ([]serf.Member, error)
 	LocalMember() serf.Member
 	JoinLAN(addrs []string) (n int, err error)
@@ -133,6 +143,7 @@ type delegate interface {
 	ResolveTokenToIdentity(secretID string) (structs.ACLIdentity, error)
 	ResolveTokenAndDefaultMeta(secretID string, entMeta *structs.EnterpriseMeta, authzContext *acl.AuthorizerContext) (acl.Authorizer, error)
 	RPC(method string, args interface{}, reply interface{}) error
+	SyncPacingHint() time.Duration
 	UseLegacyACLs() bool
 	SnapshotRPC(args *structs.SnapshotRequest, in io.Reader, out io.Writer, replyFn structs.SnapshotReplyFn) error
 	Shutdown() error
@@ -141,7 +152,7 @@ type delegate interface {
 	enterpriseDelegate
 }
 
-// notifier is called after a successful JoinLAN.
+// notifier is used to send systemd sd_notify messages.
 type notifier interface {
 	Notify(string) error
 }
@@ -189,6 +200,10 @@ type Agent struct {
 	// reap its associated service
 	checkReapAfter map[structs.CheckID]time.Duration
 
+	// drainTimers maps a draining service's ID to the timer that will
+	// deregister it once its drain duration elapses. Guarded by stateLock.
+	drainTimers map[structs.ServiceID]*time.Timer
+
 	// checkMonitors maps the check ID to an associated monitor
 	checkMonitors map[structs.CheckID]*checks.CheckMonitor
 
@@ -198,6 +213,12 @@ type Agent struct {
 	// checkTCPs maps the check ID to an associated TCP check
 	checkTCPs map[structs.CheckID]*checks.CheckTCP
 
+	// checkUDPs maps the check ID to an associated UDP check
+	checkUDPs map[structs.CheckID]*checks.CheckUDP
+
+	// checkICMPs maps the check ID to an associated ICMP check
+	checkICMPs map[structs.CheckID]*checks.CheckICMP
+
 	// checkGRPCs maps the check ID to an associated GRPC check
 	checkGRPCs map[structs.CheckID]*checks.CheckGRPC
 
@@ -213,12 +234,28 @@ type Agent struct {
 	// exposedPorts tracks listener ports for checks exposed through a proxy
 	exposedPorts map[string]int
 
+	// readiness tracks the startup stage of a client agent gated by
+	// wait_for_leader/wait_for_acl. It's set in Start and is always
+	// already complete for server agents.
+	readiness *readinessGate
+
 	// stateLock protects the agent state
 	stateLock sync.Mutex
 
 	// dockerClient is the client for performing docker health checks.
 	dockerClient *checks.DockerClient
 
+	// checkMetricsCardinality bounds the number of distinct service names
+	// used as labels for the opt-in per-check metrics. Nil when
+	// telemetry.enable_check_metrics is not set.
+	checkMetricsCardinality *checks.MetricsCardinality
+
+	// intentionMetricsCardinality bounds the number of distinct
+	// source/destination label pairs used for the opt-in intention
+	// allow/deny decision metrics emitted by ConnectAuthorize. Nil when
+	// telemetry.enable_intention_metrics is not set.
+	intentionMetricsCardinality *checks.MetricsCardinality
+
 	// eventCh is used to receive user events
 	eventCh chan serf.UserEvent
 
@@ -235,8 +272,10 @@ type Agent struct {
 	shutdownCh   chan struct{}
 	shutdownLock sync.Mutex
 
-	// joinLANNotifier is called after a successful JoinLAN.
-	joinLANNotifier notifier
+	// sdNotifier sends systemd sd_notify messages: READY after a
+	// successful JoinLAN or once startup gating (wait_for_leader/
+	// wait_for_acl) clears, and WATCHDOG on every watchdog tick.
+	sdNotifier notifier
 
 	// retryJoinCh transports errors from the retry join
 	// attempts.
@@ -299,6 +338,11 @@ type Agent struct {
 	// Envoy.
 	grpcServer *grpc.Server
 
+	// xdsServer is the xDS protocol implementation backing grpcServer. It's
+	// kept around after startup so the agent HTTP API can query it, e.g. for
+	// Envoy version compatibility information.
+	xdsServer *xds.Server
+
 	// tlsConfigurator is the central instance to provide a *tls.Config
 	// based on the current consul configuration.
 	tlsConfigurator *tlsutil.Configurator
@@ -321,35 +365,38 @@ type Agent struct {
 
 // New process the desired options and creates a new Agent.
 // This process will
-//   * parse the config given the config Flags
-//   * setup logging
-//      * using predefined logger given in an option
-//        OR
-//      * initialize a new logger from the configuration
-//        including setting up gRPC logging
-//   * initialize telemetry
-//   * create a TLS Configurator
-//   * build a shared connection pool
-//   * create the ServiceManager
-//   * setup the NodeID if one isn't provided in the configuration
-//   * create the AutoConfig object for future use in fully
+//   - parse the config given the config Flags
+//   - setup logging
+//   - using predefined logger given in an option
+//     OR
+//   - initialize a new logger from the configuration
+//     including setting up gRPC logging
+//   - initialize telemetry
+//   - create a TLS Configurator
+//   - build a shared connection pool
+//   - create the ServiceManager
+//   - setup the NodeID if one isn't provided in the configuration
+//   - create the AutoConfig object for future use in fully
 //     resolving the configuration
 func New(bd BaseDeps) (*Agent, error) {
 	a := Agent{
-		checkReapAfter:  make(map[structs.CheckID]time.Duration),
-		checkMonitors:   make(map[structs.CheckID]*checks.CheckMonitor),
-		checkTTLs:       make(map[structs.CheckID]*checks.CheckTTL),
-		checkHTTPs:      make(map[structs.CheckID]*checks.CheckHTTP),
-		checkTCPs:       make(map[structs.CheckID]*checks.CheckTCP),
-		checkGRPCs:      make(map[structs.CheckID]*checks.CheckGRPC),
-		checkDockers:    make(map[structs.CheckID]*checks.CheckDocker),
-		checkAliases:    make(map[structs.CheckID]*checks.CheckAlias),
-		eventCh:         make(chan serf.UserEvent, 1024),
-		eventBuf:        make([]*UserEvent, 256),
-		joinLANNotifier: &systemd.Notifier{},
-		retryJoinCh:     make(chan error),
-		shutdownCh:      make(chan struct{}),
-		endpoints:       make(map[string]string),
+		checkReapAfter: make(map[structs.CheckID]time.Duration),
+		drainTimers:    make(map[structs.ServiceID]*time.Timer),
+		checkMonitors:  make(map[structs.CheckID]*checks.CheckMonitor),
+		checkTTLs:      make(map[structs.CheckID]*checks.CheckTTL),
+		checkHTTPs:     make(map[structs.CheckID]*checks.CheckHTTP),
+		checkTCPs:      make(map[structs.CheckID]*checks.CheckTCP),
+		checkUDPs:      make(map[structs.CheckID]*checks.CheckUDP),
+		checkICMPs:     make(map[structs.CheckID]*checks.CheckICMP),
+		checkGRPCs:     make(map[structs.CheckID]*checks.CheckGRPC),
+		checkDockers:   make(map[structs.CheckID]*checks.CheckDocker),
+		checkAliases:   make(map[structs.CheckID]*checks.CheckAlias),
+		eventCh:        make(chan serf.UserEvent, 1024),
+		eventBuf:       make([]*UserEvent, 256),
+		sdNotifier:     &systemd.Notifier{},
+		retryJoinCh:    make(chan error),
+		shutdownCh:     make(chan struct{}),
+		endpoints:      make(map[string]string),
 
 		baseDeps:        bd,
 		tokens:          bd.Tokens,
@@ -365,6 +412,13 @@ func New(bd BaseDeps) (*Agent, error) {
 	}
 	a.rpcClientHealth = &health.Client{Cache: bd.Cache, NetRPC: &a, CacheName: cacheName}
 
+	if bd.RuntimeConfig.Telemetry.EnableCheckMetrics {
+		a.checkMetricsCardinality = checks.NewMetricsCardinality(bd.RuntimeConfig.Telemetry.CheckMetricsMaxServices)
+	}
+	if bd.RuntimeConfig.Telemetry.EnableIntentionMetrics {
+		a.intentionMetricsCardinality = checks.NewMetricsCardinality(bd.RuntimeConfig.Telemetry.IntentionMetricsMaxElements)
+	}
+
 	a.serviceManager = NewServiceManager(&a)
 
 	// TODO: do this somewhere else, maybe move to newBaseDeps
@@ -488,6 +542,11 @@ func (a *Agent) Start(ctx context.Context) error {
 	// the staggering of the state syncing depends on the cluster size.
 	a.sync.ClusterSize = func() int { return len(a.delegate.LANMembers()) }
 
+	// after a partition heals, servers advertise a pacing hint based on
+	// how many agents are reconnecting at once; use it to stagger our
+	// full sync instead of a fixed window.
+	a.sync.ServerPacingHint = a.delegate.SyncPacingHint
+
 	// link the state with the consul server/client and the state syncer
 	// via callbacks. After several attempts this was easier than using
 	// channels since the event notification needs to be non-blocking
@@ -500,13 +559,29 @@ func (a *Agent) Start(ctx context.Context) error {
 	}
 	a.serviceManager.Start()
 
+	// wait_for_leader/wait_for_acl only apply to client agents; servers
+	// already gate their own readiness on Raft leadership.
+	a.readiness = newReadinessGate(!c.ServerMode && c.WaitForLeader, !c.ServerMode && c.WaitForACL)
+
 	// Load checks/services/metadata.
 	if err := a.loadServices(c, nil); err != nil {
 		return err
 	}
-	if err := a.loadChecks(c, nil); err != nil {
+	// Replay any deregistrations that were accepted locally but not yet
+	// confirmed synced before the agent last shut down. Must run after
+	// loadServices so a service definition restored above takes
+	// precedence over a stale pending intent for the same ID.
+	if err := a.loadDeregisterIntents(); err != nil {
 		return err
 	}
+	// If we're gating readiness on joining/ACLs, local checks start once
+	// that gate clears below instead of here, so that failing checks
+	// can't flap or fire notifications before the agent is fully joined.
+	if a.readiness.Ready() {
+		if err := a.loadChecks(c, nil); err != nil {
+			return err
+		}
+	}
 	if err := a.loadMetadata(c); err != nil {
 		return err
 	}
@@ -541,16 +616,26 @@ func (a *Agent) Start(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	go func() {
-		if err := a.proxyConfig.Run(); err != nil {
-			a.logger.Error("proxy config manager exited with error", "error", err)
-		}
-	}()
+	// Like loadChecks above, starting the proxy config manager is deferred
+	// until the readiness gate clears if wait_for_leader/wait_for_acl are
+	// set, so proxies aren't configured before the agent can reach the
+	// servers.
+	if a.readiness.Ready() {
+		go func() {
+			if err := a.proxyConfig.Run(); err != nil {
+				a.logger.Error("proxy config manager exited with error", "error", err)
+			}
+		}()
+	}
 
 	// Start watching for critical services to deregister, based on their
 	// checks.
 	go a.reapServices()
 
+	// Start reaping deregistration intents once their deregistration has
+	// finished syncing.
+	go a.reapDeregisterIntents()
+
 	// Start handling events.
 	go a.handleEvents()
 
@@ -559,6 +644,12 @@ func (a *Agent) Start(ctx context.Context) error {
 		go a.sendCoordinate()
 	}
 
+	// If systemd's watchdog protocol is enabled for this unit, start
+	// notifying it so a hung agent gets restarted instead of left running.
+	if interval, ok := systemd.WatchdogEnabled(); ok {
+		go a.runSystemdWatchdog(interval)
+	}
+
 	// Write out the PID file if necessary.
 	if err := a.storePid(); err != nil {
 		return err
@@ -602,6 +693,22 @@ func (a *Agent) Start(ctx context.Context) error {
 		go a.retryJoinWAN()
 	}
 
+	// If readiness is gated on joining/finding a leader/resolving the
+	// default ACL token, finish bringing up checks and proxies in the
+	// background once those conditions are met.
+	if !a.readiness.Ready() {
+		go func() {
+			a.waitForStartupGates(c)
+			if err := a.loadChecks(c, nil); err != nil {
+				a.logger.Error("failed to load checks after startup gating completed", "error", err)
+				return
+			}
+			if err := a.proxyConfig.Run(); err != nil {
+				a.logger.Error("proxy config manager exited with error", "error", err)
+			}
+		}()
+	}
+
 	// DEPRECATED: Warn users if they're emitting deprecated metrics. Remove this warning and the flagged metrics in a
 	// future release of Consul.
 	if !a.config.Telemetry.DisableCompatOneNine {
@@ -623,13 +730,15 @@ func (a *Agent) listenAndServeGRPC() error {
 	}
 
 	xdsServer := &xds.Server{
-		Logger:       a.logger,
-		CfgMgr:       a.proxyConfig,
-		ResolveToken: a.resolveToken,
-		CheckFetcher: a,
-		CfgFetcher:   a,
+		Logger:                      a.logger,
+		CfgMgr:                      a.proxyConfig,
+		ResolveToken:                a.resolveToken,
+		CheckFetcher:                a,
+		CfgFetcher:                  a,
+		IntentionMetricsCardinality: a.intentionMetricsCardinality,
 	}
 	xdsServer.Initialize()
+	a.xdsServer = xdsServer
 
 	var err error
 	if a.config.HTTPSPort > 0 {
@@ -769,8 +878,9 @@ func (a *Agent) listenHTTP() ([]apiServer, error) {
 			}
 
 			srv := &HTTPHandlers{
-				agent:    a,
-				denylist: NewDenylist(a.config.HTTPBlockEndpoints),
+				agent:       a,
+				denylist:    NewDenylist(a.config.HTTPBlockEndpoints),
+				idempotency: newIdempotencyCache(),
 			}
 			a.configReloaders = append(a.configReloaders, srv.ReloadConfig)
 			a.httpHandlers = srv
@@ -1054,6 +1164,18 @@ func newConsulConfig(runtimeCfg *config.RuntimeConfig, logger hclog.Logger) (*co
 	if runtimeCfg.CheckOutputMaxSize > 0 {
 		cfg.CheckOutputMaxSize = runtimeCfg.CheckOutputMaxSize
 	}
+	if runtimeCfg.EdgeMemoryMode {
+		// Shrink the gossip queues and per-packet buffer so the agent's
+		// memory footprint stays small on constrained edge/IoT hosts.
+		cfg.SerfLANConfig.MinQueueDepth = 128
+		cfg.SerfLANConfig.QueueDepthWarning = 1024
+		cfg.SerfLANConfig.MemberlistConfig.UDPBufferSize = 512
+		if cfg.SerfWANConfig != nil {
+			cfg.SerfWANConfig.MinQueueDepth = 128
+			cfg.SerfWANConfig.QueueDepthWarning = 1024
+			cfg.SerfWANConfig.MemberlistConfig.UDPBufferSize = 512
+		}
+	}
 	if runtimeCfg.RejoinAfterLeave {
 		cfg.RejoinAfterLeave = true
 	}
@@ -1096,11 +1218,18 @@ func newConsulConfig(runtimeCfg *config.RuntimeConfig, logger hclog.Logger) (*co
 	if runtimeCfg.ACLDownPolicy != "" {
 		cfg.ACLDownPolicy = runtimeCfg.ACLDownPolicy
 	}
+	if runtimeCfg.ACLTokenReapingRateLimit != 0 {
+		cfg.ACLTokenReapingRateLimit = runtimeCfg.ACLTokenReapingRateLimit
+	}
+	if runtimeCfg.ACLTokenReapingBurst != 0 {
+		cfg.ACLTokenReapingBurst = runtimeCfg.ACLTokenReapingBurst
+	}
 	cfg.ACLTokenReplication = runtimeCfg.ACLTokenReplication
 	cfg.ACLsEnabled = runtimeCfg.ACLsEnabled
 	if runtimeCfg.ACLEnableKeyListPolicy {
 		cfg.ACLEnableKeyListPolicy = runtimeCfg.ACLEnableKeyListPolicy
 	}
+	cfg.ACLEnforceTokenScoping = runtimeCfg.ACLEnforceTokenScoping
 	if runtimeCfg.SessionTTLMin != 0 {
 		cfg.SessionTTLMin = runtimeCfg.SessionTTLMin
 	}
@@ -1139,6 +1268,8 @@ func newConsulConfig(runtimeCfg *config.RuntimeConfig, logger hclog.Logger) (*co
 	if runtimeCfg.RPCMaxConnsPerClient > 0 {
 		cfg.RPCMaxConnsPerClient = runtimeCfg.RPCMaxConnsPerClient
 	}
+	cfg.RPCMaxBlockingQueriesPerClient = runtimeCfg.RPCMaxBlockingQueriesPerClient
+	cfg.SessionsPerNodeLimit = runtimeCfg.SessionsPerNodeLimit
 
 	// RPC-related performance configs. We allow explicit zero value to disable so
 	// copy it whatever the value.
@@ -1183,6 +1314,7 @@ func newConsulConfig(runtimeCfg *config.RuntimeConfig, logger hclog.Logger) (*co
 	cfg.MaxQueryTime = runtimeCfg.MaxQueryTime
 
 	cfg.AutoEncryptAllowTLS = runtimeCfg.AutoEncryptAllowTLS
+	cfg.SerfWANGossipTLSEnabled = runtimeCfg.SerfWANGossipTLSEnabled
 
 	// Copy the Connect CA bootstrap runtimeCfg
 	if runtimeCfg.ConnectEnabled {
@@ -1339,6 +1471,12 @@ func (a *Agent) ShutdownAgent() error {
 	for _, chk := range a.checkTCPs {
 		chk.Stop()
 	}
+	for _, chk := range a.checkUDPs {
+		chk.Stop()
+	}
+	for _, chk := range a.checkICMPs {
+		chk.Stop()
+	}
 	for _, chk := range a.checkGRPCs {
 		chk.Stop()
 	}
@@ -1426,14 +1564,35 @@ func (a *Agent) ShutdownCh() <-chan struct{} {
 	return a.shutdownCh
 }
 
+// runSystemdWatchdog notifies systemd's watchdog at the given interval until
+// the agent begins shutting down. systemd kills and restarts the unit if a
+// notification doesn't arrive within its configured WatchdogSec, so a hung
+// agent that stops ticking gets recovered automatically.
+func (a *Agent) runSystemdWatchdog(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if a.sdNotifier != nil {
+				if err := a.sdNotifier.Notify(systemd.Watchdog); err != nil {
+					a.logger.Debug("systemd watchdog notify failed", "error", err)
+				}
+			}
+		case <-a.shutdownCh:
+			return
+		}
+	}
+}
+
 // JoinLAN is used to have the agent join a LAN cluster
 func (a *Agent) JoinLAN(addrs []string) (n int, err error) {
 	a.logger.Info("(LAN) joining", "lan_addresses", addrs)
 	n, err = a.delegate.JoinLAN(addrs)
 	if err == nil {
 		a.logger.Info("(LAN) joined", "number_of_nodes", n)
-		if a.joinLANNotifier != nil {
-			if notifErr := a.joinLANNotifier.Notify(systemd.Ready); notifErr != nil {
+		if a.sdNotifier != nil {
+			if notifErr := a.sdNotifier.Notify(systemd.Ready); notifErr != nil {
 				a.logger.Debug("systemd notify failed", "error", notifErr)
 			}
 		}
@@ -1924,6 +2083,11 @@ func (a *Agent) addServiceLocked(req *addServiceRequest) error {
 		return err
 	}
 
+	// A fresh registration replaces whatever NodeService was there before,
+	// so any in-progress drain no longer applies and its deregistration
+	// timer must not fire against the new registration.
+	a.cancelDrainTimerLocked(req.service.CompoundServiceID())
+
 	if a.config.EnableCentralServiceConfig {
 		return a.serviceManager.AddService(req)
 	}
@@ -2307,6 +2471,10 @@ func (a *Agent) removeServiceLocked(serviceID structs.ServiceID, persist bool) e
 		a.serviceManager.RemoveService(serviceID)
 	}
 
+	// The service is going away on its own, so any pending drain timer for
+	// it is moot.
+	a.cancelDrainTimerLocked(serviceID)
+
 	// Reset the HTTP check targets if they were exposed through a proxy
 	// If this is not a proxy or checks were not exposed then this is a no-op
 	svc := a.State.Service(serviceID)
@@ -2322,6 +2490,16 @@ func (a *Agent) removeServiceLocked(serviceID structs.ServiceID, persist bool) e
 		checkIDs = append(checkIDs, id)
 	}
 
+	// Record that this deregistration is pending before anything else
+	// touches disk, so that if the agent is killed before anti-entropy
+	// confirms it synced, the intent survives to be replayed on restart
+	// even though the persisted service file below won't.
+	if persist {
+		if err := a.persistDeregisterIntent(serviceID, a.State.ServiceToken(serviceID)); err != nil {
+			return err
+		}
+	}
+
 	// Remove service immediately
 	if err := a.State.RemoveServiceWithChecks(serviceID, checkIDs); err != nil {
 		a.logger.Warn("Failed to deregister service",
@@ -2478,6 +2656,9 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 		}
 
 		statusHandler := checks.NewStatusHandler(a.State, a.logger, chkType.SuccessBeforePassing, chkType.FailuresBeforeCritical)
+		if a.config.Telemetry.EnableCheckMetrics {
+			statusHandler.EnableMetrics(check.Name, check.ServiceName, a.checkMetricsCardinality)
+		}
 		sid := check.CompoundServiceID()
 
 		cid := check.CompoundCheckID()
@@ -2497,6 +2678,7 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				TTL:           chkType.TTL,
 				Logger:        a.logger,
 				OutputMaxSize: maxOutputSize,
+				StatusHandler: statusHandler,
 			}
 
 			// Restore persisted state, if any
@@ -2580,6 +2762,56 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 			tcp.Start()
 			a.checkTCPs[cid] = tcp
 
+		case chkType.IsUDP():
+			if existing, ok := a.checkUDPs[cid]; ok {
+				existing.Stop()
+				delete(a.checkUDPs, cid)
+			}
+			if chkType.Interval < checks.MinInterval {
+				a.logger.Warn("check has interval below minimum",
+					"check", cid.String(),
+					"minimum_interval", checks.MinInterval,
+				)
+				chkType.Interval = checks.MinInterval
+			}
+
+			udp := &checks.CheckUDP{
+				CheckID:       cid,
+				ServiceID:     sid,
+				UDP:           chkType.UDP,
+				Interval:      chkType.Interval,
+				Timeout:       chkType.Timeout,
+				Logger:        a.logger,
+				StatusHandler: statusHandler,
+			}
+			udp.Start()
+			a.checkUDPs[cid] = udp
+
+		case chkType.IsICMP():
+			if existing, ok := a.checkICMPs[cid]; ok {
+				existing.Stop()
+				delete(a.checkICMPs, cid)
+			}
+			if chkType.Interval < checks.MinInterval {
+				a.logger.Warn("check has interval below minimum",
+					"check", cid.String(),
+					"minimum_interval", checks.MinInterval,
+				)
+				chkType.Interval = checks.MinInterval
+			}
+
+			icmpCheck := &checks.CheckICMP{
+				CheckID:       cid,
+				ServiceID:     sid,
+				ICMP:          chkType.ICMP,
+				Interval:      chkType.Interval,
+				Timeout:       chkType.Timeout,
+				Logger:        a.logger,
+				StatusHandler: statusHandler,
+			}
+			icmpCheck.Start()
+			a.checkICMPs[cid] = icmpCheck
+
 		case chkType.IsGRPC():
 			if existing, ok := a.checkGRPCs[cid]; ok {
 				existing.Stop()
@@ -2648,15 +2880,16 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 			}
 
 			dockerCheck := &checks.CheckDocker{
-				CheckID:           cid,
-				ServiceID:         sid,
-				DockerContainerID: chkType.DockerContainerID,
-				Shell:             chkType.Shell,
-				ScriptArgs:        chkType.ScriptArgs,
-				Interval:          chkType.Interval,
-				Logger:            a.logger,
-				Client:            a.dockerClient,
-				StatusHandler:     statusHandler,
+				CheckID:              cid,
+				ServiceID:            sid,
+				DockerContainerID:    chkType.DockerContainerID,
+				DockerContainerLabel: chkType.DockerContainerLabel,
+				Shell:                chkType.Shell,
+				ScriptArgs:           chkType.ScriptArgs,
+				Interval:             chkType.Interval,
+				Logger:               a.logger,
+				Client:               a.dockerClient,
+				StatusHandler:        statusHandler,
 			}
 			if prev := a.checkDockers[cid]; prev != nil {
 				prev.Stop()
@@ -2716,7 +2949,9 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				CheckID:        cid,
 				Node:           chkType.AliasNode,
 				ServiceID:      aliasServiceID,
+				ServiceName:    chkType.AliasServiceName,
 				EnterpriseMeta: check.EnterpriseMeta,
+				StatusHandler:  statusHandler,
 			}
 			chkImpl.Start()
 			a.checkAliases[cid] = chkImpl
@@ -2883,6 +3118,14 @@ func (a *Agent) cancelCheckMonitors(checkID structs.CheckID) {
 		check.Stop()
 		delete(a.checkTCPs, checkID)
 	}
+	if check, ok := a.checkUDPs[checkID]; ok {
+		check.Stop()
+		delete(a.checkUDPs, checkID)
+	}
+	if check, ok := a.checkICMPs[checkID]; ok {
+		check.Stop()
+		delete(a.checkICMPs, checkID)
+	}
 	if check, ok := a.checkGRPCs[checkID]; ok {
 		check.Stop()
 		delete(a.checkGRPCs, checkID)
@@ -3009,6 +3252,15 @@ func (a *Agent) purgeCheckState(checkID structs.CheckID) error {
 	return err
 }
 
+// DumpDebugProfiles writes heap, goroutine, and mutex profiles to the data
+// directory, pruning old dumps beyond the retention limit. It's triggered
+// by SIGUSR1 (see DebugDumpSignals) or the /v1/agent/debug/dump API
+// endpoint, so that a transient memory spike can be captured after the
+// fact without a pprof server already being attached.
+func (a *Agent) DumpDebugProfiles() ([]string, error) {
+	return debug.WriteProfiles(a.config.DataDir, a.logger)
+}
+
 // Stats is used to get various debugging state from the sub-systems
 func (a *Agent) Stats() map[string]map[string]string {
 	stats := a.delegate.Stats()
@@ -3032,6 +3284,89 @@ func (a *Agent) Stats() map[string]map[string]string {
 	return stats
 }
 
+// GossipPoolStats holds the Lamport clocks and broadcast queue depths Serf
+// tracks for one gossip pool, as returned by serf.Serf.Stats().
+type GossipPoolStats struct {
+	Members     int
+	Failed      int
+	Left        int
+	HealthScore int
+	MemberTime  uint64
+	EventTime   uint64
+	QueryTime   uint64
+	IntentQueue int
+	EventQueue  int
+	QueryQueue  int
+	Encrypted   bool
+}
+
+// GossipStats reports the Lamport clocks and broadcast queue depths of the
+// agent's gossip pool(s), for debugging convergence issues without attaching
+// a debugger. WAN is nil on client agents, which don't participate in the
+// WAN gossip pool.
+//
+// Note: the underlying memberlist library doesn't currently track dropped
+// message counts, so that isn't included here.
+func (a *Agent) GossipStats() (lan GossipPoolStats, wan *GossipPoolStats, err error) {
+	stats := a.delegate.Stats()
+
+	lanRaw, ok := stats["serf_lan"]
+	if !ok {
+		return GossipPoolStats{}, nil, fmt.Errorf("serf_lan stats not available")
+	}
+	lan, err = parseGossipPoolStats(lanRaw)
+	if err != nil {
+		return GossipPoolStats{}, nil, fmt.Errorf("failed to parse serf_lan stats: %v", err)
+	}
+
+	if wanRaw, ok := stats["serf_wan"]; ok {
+		w, err := parseGossipPoolStats(wanRaw)
+		if err != nil {
+			return GossipPoolStats{}, nil, fmt.Errorf("failed to parse serf_wan stats: %v", err)
+		}
+		wan = &w
+	}
+
+	return lan, wan, nil
+}
+
+func parseGossipPoolStats(raw map[string]string) (GossipPoolStats, error) {
+	var stats GossipPoolStats
+	var err error
+
+	parseInt := func(key string) int {
+		v, e := strconv.Atoi(raw[key])
+		if e != nil {
+			err = e
+		}
+		return v
+	}
+	parseUint := func(key string) uint64 {
+		v, e := strconv.ParseUint(raw[key], 10, 64)
+		if e != nil {
+			err = e
+		}
+		return v
+	}
+
+	stats.Members = parseInt("members")
+	stats.Failed = parseInt("failed")
+	stats.Left = parseInt("left")
+	stats.HealthScore = parseInt("health_score")
+	stats.MemberTime = parseUint("member_time")
+	stats.EventTime = parseUint("event_time")
+	stats.QueryTime = parseUint("query_time")
+	stats.IntentQueue = parseInt("intent_queue")
+	stats.EventQueue = parseInt("event_queue")
+	stats.QueryQueue = parseInt("query_queue")
+	stats.Encrypted = raw["encrypted"] == "true"
+
+	if err != nil {
+		return GossipPoolStats{}, err
+	}
+	return stats, nil
+}
+
 // storePid is used to write out our PID to a file if necessary
 func (a *Agent) storePid() error {
 	// Quit fast if no pidfile
@@ -3466,6 +3801,50 @@ func (a *Agent) DisableServiceMaintenance(serviceID structs.ServiceID) error {
 	return nil
 }
 
+// DrainService marks serviceID as draining: xDS starts reporting its
+// endpoint health as DRAINING, DNS stops answering with it, and prepared
+// queries deprioritize it behind healthy instances. If duration is
+// positive, the service is automatically deregistered once it elapses;
+// calling DrainService again before then resets the timer. This gives
+// operators a single primitive to wind down an instance ahead of a
+// rolling deploy without having to coordinate load balancer config,
+// health checks, and deregistration timing by hand.
+func (a *Agent) DrainService(serviceID structs.ServiceID, duration time.Duration) error {
+	a.stateLock.Lock()
+	defer a.stateLock.Unlock()
+
+	if err := a.State.SetServiceDraining(serviceID, true); err != nil {
+		return err
+	}
+
+	a.cancelDrainTimerLocked(serviceID)
+	if duration > 0 {
+		a.drainTimers[serviceID] = time.AfterFunc(duration, func() {
+			a.logger.Info("deregistering service after drain duration elapsed",
+				"service", serviceID.String(),
+			)
+			if err := a.RemoveService(serviceID); err != nil {
+				a.logger.Warn("failed to deregister drained service",
+					"service", serviceID.String(),
+					"error", err,
+				)
+			}
+		})
+	}
+
+	a.logger.Info("Service marked draining", "service", serviceID.String(), "duration", duration)
+	return nil
+}
+
+// cancelDrainTimerLocked stops and clears any pending auto-deregistration
+// timer for serviceID. The caller must hold stateLock.
+func (a *Agent) cancelDrainTimerLocked(serviceID structs.ServiceID) {
+	if timer, ok := a.drainTimers[serviceID]; ok {
+		timer.Stop()
+		delete(a.drainTimers, serviceID)
+	}
+}
+
 // EnableNodeMaintenance places a node into maintenance mode.
 func (a *Agent) EnableNodeMaintenance(reason, token string) {
 	// Ensure node maintenance is not already enabled
@@ -1,10 +1,15 @@
 package agent
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-memdb"
@@ -12,15 +17,20 @@ import (
 
 	"github.com/hashicorp/consul/acl"
 	cachetype "github.com/hashicorp/consul/agent/cache-types"
+	"github.com/hashicorp/consul/agent/consul"
+	"github.com/hashicorp/consul/agent/consul/stream"
 	"github.com/hashicorp/consul/agent/debug"
+	"github.com/hashicorp/consul/agent/rpc/subscribe"
 	"github.com/hashicorp/consul/agent/structs"
 	token_store "github.com/hashicorp/consul/agent/token"
+	"github.com/hashicorp/consul/agent/xds"
 	"github.com/hashicorp/consul/agent/xds/proxysupport"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/ipaddr"
 	"github.com/hashicorp/consul/lib"
 	"github.com/hashicorp/consul/logging"
 	"github.com/hashicorp/consul/logging/monitor"
+	"github.com/hashicorp/consul/proto/pbsubscribe"
 	"github.com/hashicorp/consul/types"
 	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/serf/coordinate"
@@ -41,6 +51,11 @@ type Self struct {
 
 type xdsSelf struct {
 	SupportedProxies map[string][]string
+	// RecentEnvoyRejections lists the most recent xDS connections this agent
+	// refused because the connecting Envoy's version isn't supported, so
+	// operators can tell why a proxy never came up without digging through
+	// Envoy's own logs.
+	RecentEnvoyRejections []xds.RejectedEnvoy `json:",omitempty"`
 }
 
 func (s *HTTPHandlers) AgentSelf(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
@@ -63,13 +78,16 @@ func (s *HTTPHandlers) AgentSelf(resp http.ResponseWriter, req *http.Request) (i
 		}
 	}
 
-	var xds *xdsSelf
+	var xdsInfo *xdsSelf
 	if s.agent.grpcServer != nil {
-		xds = &xdsSelf{
+		xdsInfo = &xdsSelf{
 			SupportedProxies: map[string][]string{
 				"envoy": proxysupport.EnvoyVersions,
 			},
 		}
+		if s.agent.xdsServer != nil {
+			xdsInfo.RecentEnvoyRejections = s.agent.xdsServer.EnvoyVersionCompatibility().RecentRejections
+		}
 	}
 
 	config := struct {
@@ -94,10 +112,75 @@ func (s *HTTPHandlers) AgentSelf(resp http.ResponseWriter, req *http.Request) (i
 		Member:      s.agent.LocalMember(),
 		Stats:       s.agent.Stats(),
 		Meta:        s.agent.State.Metadata(),
-		XDS:         xds,
+		XDS:         xdsInfo,
 	}, nil
 }
 
+// AgentReadyResponse is returned by GET /v1/agent/ready.
+type AgentReadyResponse struct {
+	Ready  bool
+	Stage  string
+	Checks map[string]bool `json:",omitempty"`
+}
+
+// AgentReady
+//
+// GET /v1/agent/ready
+//
+// Reports whether this agent has finished the startup stages gated by the
+// wait_for_leader and wait_for_acl options (joining the LAN gossip pool,
+// finding a cluster leader, and resolving its default ACL token), and
+// whether every ready_check_* criterion this agent is configured to
+// evaluate (serf, server, acl, xds) currently passes. Unlike the startup
+// stages, the ready_check_* criteria are re-evaluated on every call, so
+// Ready can flip back to false after the agent has come up if, say, it
+// loses its connection to the servers. Servers, and client agents with no
+// wait_for_* options set, are always past the startup stages; any
+// ready_check_* criteria configured are evaluated on servers too. The HTTP
+// status is 200 when ready and 503 otherwise, so it can be used directly
+// as an orchestrator readiness probe; no ACL token is required.
+func (s *HTTPHandlers) AgentReady(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	out := AgentReadyResponse{
+		Ready:  s.agent.readiness.Ready(),
+		Stage:  string(s.agent.readiness.Stage()),
+		Checks: s.agent.readyChecks(s.agent.config),
+	}
+	if out.Ready {
+		for _, passed := range out.Checks {
+			if !passed {
+				out.Ready = false
+				break
+			}
+		}
+	}
+	if !out.Ready {
+		resp.WriteHeader(http.StatusServiceUnavailable)
+	}
+	return out, nil
+}
+
+// AgentLive
+//
+// GET /v1/agent/live
+//
+// Reports whether this agent's process is alive and its main loops are
+// responsive, for use as an orchestrator liveness probe. Unlike
+// GET /v1/agent/ready, it doesn't depend on cluster state: it exists so a
+// probe can tell "the process is wedged and needs to be restarted" apart
+// from "the process is fine but isn't ready for traffic yet", which would
+// otherwise both show up as a failing readiness probe. It always returns
+// HTTP 200 unless the agent is already shutting down; no ACL token is
+// required.
+func (s *HTTPHandlers) AgentLive(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	select {
+	case <-s.agent.shutdownCh:
+		resp.WriteHeader(http.StatusServiceUnavailable)
+		return nil, nil
+	default:
+		return nil, nil
+	}
+}
+
 // acceptsOpenMetricsMimeType returns true if mime type is Prometheus-compatible
 func acceptsOpenMetricsMimeType(acceptHeader string) bool {
 	mimeTypes := strings.Split(acceptHeader, ",")
@@ -149,12 +232,46 @@ func (s *HTTPHandlers) AgentMetrics(resp http.ResponseWriter, req *http.Request)
 		}
 
 		handler := promhttp.HandlerFor(prometheus.DefaultGatherer, handlerOptions)
-		handler.ServeHTTP(resp, req)
+
+		if req.URL.Query().Get("merge-service-mesh") != "true" {
+			handler.ServeHTTP(resp, req)
+			return nil, nil
+		}
+
+		// Scrape each local sidecar (and, where configured, its application)
+		// and append their relabeled metrics after the agent's own, so
+		// operators get one merged endpoint instead of one scrape target per
+		// proxy.
+		var buf bytes.Buffer
+		handler.ServeHTTP(&discardHeadersRecorder{ResponseWriter: resp, buf: &buf}, req)
+
+		scraper := newMetricsScraper()
+		for _, svc := range s.agent.State.Services(structs.WildcardEnterpriseMeta()) {
+			buf.Write(scraper.scrapeService(svc))
+		}
+
+		resp.Write(buf.Bytes())
 		return nil, nil
 	}
 	return s.agent.baseDeps.MetricsHandler.DisplayMetrics(resp, req)
 }
 
+// discardHeadersRecorder lets us capture promhttp's response body into buf
+// without it writing headers/status twice when we still need to append more
+// content before flushing the real response.
+type discardHeadersRecorder struct {
+	http.ResponseWriter
+	buf *bytes.Buffer
+}
+
+func (d *discardHeadersRecorder) Write(b []byte) (int, error) {
+	return d.buf.Write(b)
+}
+
+func (d *discardHeadersRecorder) WriteHeader(int) {
+	// Defer writing the status/headers until the merged body is ready.
+}
+
 func (s *HTTPHandlers) AgentReload(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	// Fetch the ACL token, if any, and enforce agent policy.
 	var token string
@@ -197,11 +314,20 @@ func buildAgentService(s *structs.NodeService) api.AgentService {
 		Address:           s.Address,
 		TaggedAddresses:   taggedAddrs,
 		EnableTagOverride: s.EnableTagOverride,
+		Draining:          s.Draining,
 		CreateIndex:       s.CreateIndex,
 		ModifyIndex:       s.ModifyIndex,
 		Weights:           weights,
 	}
 
+	if s.Owner != nil {
+		as.Owner = &api.AgentServiceOwner{
+			Team:    s.Owner.Team,
+			Contact: s.Owner.Contact,
+			URL:     s.Owner.URL,
+		}
+	}
+
 	if as.Tags == nil {
 		as.Tags = []string{}
 	}
@@ -435,7 +561,38 @@ func (s *HTTPHandlers) AgentMembers(resp http.ResponseWriter, req *http.Request)
 	if err := s.agent.filterMembers(token, &members); err != nil {
 		return nil, err
 	}
-	return members, nil
+
+	agentMembers := make([]*api.AgentMember, len(members))
+	localName := s.agent.config.NodeName
+	for i, m := range members {
+		agentMembers[i] = &api.AgentMember{
+			Name:        m.Name,
+			Addr:        m.Addr.String(),
+			Port:        m.Port,
+			Tags:        m.Tags,
+			Status:      int(m.Status),
+			ProtocolMin: m.ProtocolMin,
+			ProtocolMax: m.ProtocolMax,
+			ProtocolCur: m.ProtocolCur,
+			DelegateMin: m.DelegateMin,
+			DelegateMax: m.DelegateMax,
+			DelegateCur: m.DelegateCur,
+		}
+		// Memberlist only tracks a node's own awareness of how well it's
+		// keeping up with the protocol, not a score for every other member,
+		// so this can only be populated for the local node.
+		if !wan && m.Name == localName {
+			agentMembers[i].HealthScore = s.agent.delegate.LANMembersHealthScore()
+		}
+	}
+
+	var filterExpression string
+	s.parseFilter(req, &filterExpression)
+	filter, err := bexpr.CreateFilter(filterExpression, nil, agentMembers)
+	if err != nil {
+		return nil, err
+	}
+	return filter.Execute(agentMembers)
 }
 
 func (s *HTTPHandlers) AgentJoin(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
@@ -917,6 +1074,24 @@ func (s *HTTPHandlers) AgentRegisterService(resp http.ResponseWriter, req *http.
 		return nil, nil
 	}
 
+	// Collect the raw check definitions so defaults can be applied to them
+	// before they're validated below (check intervals/timeouts are required
+	// fields for most check types, so defaults must land before Validate).
+	var rawChkTypes []*structs.CheckType
+	if !args.Check.Empty() {
+		rawChkTypes = append(rawChkTypes, &args.Check)
+	}
+	rawChkTypes = append(rawChkTypes, args.Checks...)
+
+	// Apply any service-defaults config entry for this service so that
+	// centrally configured Meta and check intervals/timeouts don't have to be
+	// repeated in every registration. This is a one-shot fetch; it doesn't
+	// track updates the way ServiceManager's continuous watch does for
+	// sidecar proxies and gateways.
+	if err := s.agent.applyServiceDefaults(req.Context(), token, ns, rawChkTypes); err != nil {
+		return nil, err
+	}
+
 	// Verify the check type.
 	chkTypes, err := args.CheckTypes()
 	if err != nil {
@@ -1004,7 +1179,7 @@ func (s *HTTPHandlers) AgentRegisterService(resp http.ResponseWriter, req *http.
 		}
 	}
 	s.syncChanges()
-	return nil, nil
+	return ns, nil
 }
 
 func (s *HTTPHandlers) AgentDeregisterService(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
@@ -1029,6 +1204,18 @@ func (s *HTTPHandlers) AgentDeregisterService(resp http.ResponseWriter, req *htt
 		return nil, err
 	}
 
+	force, err := getBoolQueryParam(req.URL.Query(), "force")
+	if err != nil {
+		resp.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(resp, "Invalid value for ?force")
+		return nil, nil
+	}
+	if err := s.agent.checkMinHealthyInstances(sid, token, force); err != nil {
+		resp.WriteHeader(http.StatusConflict)
+		fmt.Fprint(resp, err.Error())
+		return nil, nil
+	}
+
 	if err := s.agent.RemoveService(sid); err != nil {
 		return nil, err
 	}
@@ -1083,6 +1270,18 @@ func (s *HTTPHandlers) AgentServiceMaintenance(resp http.ResponseWriter, req *ht
 	}
 
 	if enable {
+		force, err := getBoolQueryParam(params, "force")
+		if err != nil {
+			resp.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(resp, "Invalid value for ?force")
+			return nil, nil
+		}
+		if err := s.agent.checkMinHealthyInstances(sid, token, force); err != nil {
+			resp.WriteHeader(http.StatusConflict)
+			fmt.Fprint(resp, err.Error())
+			return nil, nil
+		}
+
 		reason := params.Get("reason")
 		if err = s.agent.EnableServiceMaintenance(sid, reason, token); err != nil {
 			resp.WriteHeader(http.StatusNotFound)
@@ -1100,6 +1299,62 @@ func (s *HTTPHandlers) AgentServiceMaintenance(resp http.ResponseWriter, req *ht
 	return nil, nil
 }
 
+// AgentServiceDrain marks a locally registered service instance as
+// draining ahead of a planned deregistration: xDS reports its endpoint
+// health as DRAINING, DNS omits it, and prepared queries deprioritize it.
+// If a duration is given, the agent deregisters the instance once it
+// elapses, giving rolling deploys a single primitive instead of having to
+// separately coordinate health checks, load balancer config, and
+// deregistration timing.
+func (s *HTTPHandlers) AgentServiceDrain(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	sid := structs.NewServiceID(strings.TrimPrefix(req.URL.Path, "/v1/agent/service/drain/"), nil)
+
+	if sid.ID == "" {
+		resp.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(resp, "Missing service ID")
+		return nil, nil
+	}
+
+	var duration time.Duration
+	if raw := req.URL.Query().Get("duration"); raw != "" {
+		var err error
+		duration, err = time.ParseDuration(raw)
+		if err != nil {
+			resp.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(resp, "Invalid value for duration: %q", raw)
+			return nil, nil
+		}
+	}
+
+	// Get the provided token, if any, and vet against any ACL policies.
+	var token string
+	s.parseToken(req, &token)
+
+	if err := s.parseEntMetaNoWildcard(req, &sid.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+
+	authz, err := s.agent.resolveTokenAndDefaultMeta(token, &sid.EnterpriseMeta, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sid.Normalize()
+
+	if err := s.agent.vetServiceUpdateWithAuthorizer(authz, sid); err != nil {
+		return nil, err
+	}
+
+	if err := s.agent.DrainService(sid, duration); err != nil {
+		resp.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(resp, err.Error())
+		return nil, nil
+	}
+
+	s.syncChanges()
+	return nil, nil
+}
+
 func (s *HTTPHandlers) AgentNodeMaintenance(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	// Ensure we have some action
 	params := req.URL.Query()
@@ -1205,6 +1460,195 @@ func (s *HTTPHandlers) AgentMonitor(resp http.ResponseWriter, req *http.Request)
 	}
 }
 
+// agentSubscribeTopics maps the query-string topic names accepted by
+// /v1/agent/subscribe to the topics understood by the streaming backend.
+// There is deliberately no "kv" entry: the streaming backend only knows
+// how to publish service health and intention events, so a KV-prefix
+// subscription (unlike the blocking query the "consul watch" command uses
+// for that purpose) isn't something this endpoint can offer.
+var agentSubscribeTopics = map[string]pbsubscribe.Topic{
+	"service-health":         pbsubscribe.Topic_ServiceHealth,
+	"service-health-connect": pbsubscribe.Topic_ServiceHealthConnect,
+	"intention":              pbsubscribe.Topic_IntentionMatch,
+}
+
+// AgentSubscribe streams newline-delimited JSON events from the streaming
+// backend (see agent/rpc/subscribe) to the caller, as a lower-latency
+// alternative to polling a blocking query. It only works when the local
+// agent is a server, since subscriptions are served from a server's local
+// state store and there is currently no way for a client agent to forward
+// one to a server on the caller's behalf.
+func (s *HTTPHandlers) AgentSubscribe(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var token string
+	s.parseToken(req, &token)
+	rule, err := s.agent.resolveToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if rule != nil && rule.AgentRead(s.agent.config.NodeName, nil) != acl.Allow {
+		return nil, acl.ErrPermissionDenied
+	}
+
+	srv, ok := s.agent.delegate.(*consul.Server)
+	if !ok {
+		return nil, BadRequestError{Reason: "Agent must be running in server mode to support streaming subscriptions"}
+	}
+	if !s.agent.config.RPCConfig.EnableStreaming {
+		return nil, BadRequestError{Reason: "Streaming is not enabled on this agent; set rpc.enable_streaming to use /v1/agent/subscribe"}
+	}
+
+	topicName := req.URL.Query().Get("topic")
+	topic, ok := agentSubscribeTopics[topicName]
+	if !ok {
+		return nil, BadRequestError{Reason: fmt.Sprintf("Unknown topic: %q", topicName)}
+	}
+
+	var index uint64
+	if indexStr := req.URL.Query().Get("index"); indexStr != "" {
+		index, err = strconv.ParseUint(indexStr, 10, 64)
+		if err != nil {
+			return nil, BadRequestError{Reason: fmt.Sprintf("Invalid index: %v", err)}
+		}
+	}
+
+	flusher, ok := resp.(http.Flusher)
+	if !ok {
+		return nil, fmt.Errorf("Streaming not supported")
+	}
+
+	// Resolve a second time through the server directly, rather than reusing
+	// rule above: unlike s.agent.resolveToken, this always returns a non-nil
+	// Authorizer (even with ACLs disabled), which is what subscribe.EnforceACL
+	// requires to filter individual events below.
+	authz, err := srv.ResolveToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := srv.LocalSubscribe(&stream.SubscribeRequest{
+		Topic: topic,
+		Key:   req.URL.Query().Get("key"),
+		Token: token,
+		Index: index,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer sub.Unsubscribe()
+
+	resp.WriteHeader(http.StatusOK)
+	resp.Write([]byte(""))
+	flusher.Flush()
+
+	ctx := req.Context()
+	enc := json.NewEncoder(resp)
+	for {
+		event, err := sub.Next(ctx)
+		switch {
+		case errors.Is(err, stream.ErrSubForceClosed):
+			return nil, nil
+		case errors.Is(err, context.Canceled):
+			return nil, nil
+		case err != nil:
+			return nil, err
+		}
+
+		// authz is nil when ACLs are disabled, in which case every event is
+		// allowed through.
+		if authz != nil && subscribe.EnforceACL(authz, event) != acl.Allow {
+			continue
+		}
+
+		if err := enc.Encode(agentSubscribeEventFromStreamEvent(event)); err != nil {
+			return nil, err
+		}
+		flusher.Flush()
+	}
+}
+
+// agentSubscribeEvent is the JSON wire format emitted by AgentSubscribe.
+// It mirrors stream.Event, except Payload is left as-is so that the
+// underlying structs (e.g. structs.CheckServiceNode) marshal using their
+// normal JSON tags instead of requiring a protobuf conversion.
+type agentSubscribeEvent struct {
+	Topic               string
+	Key                 string `json:",omitempty"`
+	Index               uint64
+	Payload             interface{} `json:",omitempty"`
+	EndOfSnapshot       bool        `json:",omitempty"`
+	NewSnapshotToFollow bool        `json:",omitempty"`
+}
+
+func agentSubscribeEventFromStreamEvent(event stream.Event) agentSubscribeEvent {
+	e := agentSubscribeEvent{
+		Topic: event.Topic.String(),
+		Key:   event.Key,
+		Index: event.Index,
+	}
+	switch {
+	case event.IsEndOfSnapshot():
+		e.EndOfSnapshot = true
+	case event.IsNewSnapshotToFollow():
+		e.NewSnapshotToFollow = true
+	default:
+		e.Payload = event.Payload
+	}
+	return e
+}
+
+// AgentConvergenceStatusResponse is the response to GET
+// /v1/agent/convergence/:index. It's the client-agent half of a convergence
+// check; the server-side half is GET /v1/operator/convergence-status.
+type AgentConvergenceStatusResponse struct {
+	// Index is the index that was checked.
+	Index uint64
+
+	// CacheIndex is the highest index across everything currently held in
+	// this agent's local cache.
+	CacheIndex uint64
+
+	// Seen is true if CacheIndex is at least Index, meaning some locally
+	// cached result (from a blocking query or a streaming subscription) is
+	// at least as fresh as the index being checked.
+	Seen bool
+}
+
+// AgentConvergenceStatus reports whether this agent's local cache has seen
+// a result at least as fresh as the given Raft index. Deployment tooling
+// that already knows which agents it deployed to can poll this on each one
+// to wait for "this change is visible here" instead of sleeping an
+// arbitrary duration, the same way GET /v1/operator/convergence-status lets
+// it poll servers.
+//
+// This is necessarily coarse: the agent's cache doesn't know which specific
+// request a target index came from, only the highest index across
+// everything it currently has cached, so a false negative is possible if
+// this agent has simply never been asked about the data in question.
+func (s *HTTPHandlers) AgentConvergenceStatus(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var token string
+	s.parseToken(req, &token)
+	rule, err := s.agent.resolveToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if rule != nil && rule.AgentRead(s.agent.config.NodeName, nil) != acl.Allow {
+		return nil, acl.ErrPermissionDenied
+	}
+
+	indexStr := strings.TrimPrefix(req.URL.Path, "/v1/agent/convergence/")
+	index, err := strconv.ParseUint(indexStr, 10, 64)
+	if err != nil {
+		return nil, BadRequestError{Reason: fmt.Sprintf("Invalid index: %v", err)}
+	}
+
+	cacheIndex := s.agent.cache.HighestIndex()
+	return AgentConvergenceStatusResponse{
+		Index:      index,
+		CacheIndex: cacheIndex,
+		Seen:       cacheIndex >= index,
+	}, nil
+}
+
 func (s *HTTPHandlers) AgentToken(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	if s.checkACLDisabled(resp, req) {
 		return nil, nil
@@ -1401,3 +1845,85 @@ func (s *HTTPHandlers) AgentHost(resp http.ResponseWriter, req *http.Request) (i
 
 	return debug.CollectHostInfo(), nil
 }
+
+// AgentDebugDump writes heap, goroutine, and mutex profiles to the agent's
+// data directory with timestamped filenames, so a transient memory spike
+// can be captured after the fact without a pprof server already being
+// attached. Requires an operator:write ACL token.
+//
+// POST /v1/agent/debug/dump
+func (s *HTTPHandlers) AgentDebugDump(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var token string
+	s.parseToken(req, &token)
+	rule, err := s.agent.resolveToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule != nil && rule.OperatorWrite(nil) != acl.Allow {
+		return nil, acl.ErrPermissionDenied
+	}
+
+	return s.agent.DumpDebugProfiles()
+}
+
+// AgentDebugGossip reports the Lamport clocks and broadcast queue depths of
+// the agent's gossip pool(s), so gossip convergence problems can be
+// diagnosed without recompiling with debug hooks or attaching a debugger.
+// Requires an agent:read ACL token, the same as /v1/agent/self, which already
+// surfaces these same values nested inside its Stats field.
+//
+// GET /v1/agent/debug/gossip
+func (s *HTTPHandlers) AgentDebugGossip(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var token string
+	s.parseToken(req, &token)
+	rule, err := s.agent.resolveToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if rule != nil && rule.AgentRead(s.agent.config.NodeName, nil) != acl.Allow {
+		return nil, acl.ErrPermissionDenied
+	}
+
+	lan, wan, err := s.agent.GossipStats()
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		LAN GossipPoolStats
+		WAN *GossipPoolStats
+	}{
+		LAN: lan,
+		WAN: wan,
+	}, nil
+}
+
+// AgentXDSConfigStatus
+//
+// GET /v1/agent/xds/config-status
+//
+// Reports, for every proxy currently connected to this agent's xDS server,
+// the latest config snapshot version computed against the version(s) it has
+// actually ACKed per resource type, plus any outstanding NACK errors. This
+// lets operators find sidecars that are stuck running stale routing config
+// after a bad config entry. Requires an operator:read ACL token.
+func (s *HTTPHandlers) AgentXDSConfigStatus(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	// Fetch the ACL token, if any, and enforce agent policy.
+	var token string
+	s.parseToken(req, &token)
+	rule, err := s.agent.resolveToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule != nil && rule.OperatorRead(nil) != acl.Allow {
+		return nil, acl.ErrPermissionDenied
+	}
+
+	if s.agent.xdsServer == nil {
+		return []xds.ProxyConfigStatus{}, nil
+	}
+
+	return s.agent.xdsServer.ProxyConfigStatuses(), nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/agent/token"
 	tokenStore "github.com/hashicorp/consul/agent/token"
+	"github.com/hashicorp/consul/agent/xds"
 	"github.com/hashicorp/consul/agent/xds/proxysupport"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/lib"
@@ -360,7 +361,7 @@ func TestAgent_Service(t *testing.T) {
 		Service:     "web-sidecar-proxy",
 		Port:        8000,
 		Proxy:       expectProxy.ToAPI(),
-		ContentHash: "4c7d5f8d3748be6d",
+		ContentHash: "53e0e50a630d8eb1",
 		Weights: api.AgentWeights{
 			Passing: 1,
 			Warning: 1,
@@ -373,14 +374,14 @@ func TestAgent_Service(t *testing.T) {
 	// Copy and modify
 	updatedResponse := *expectedResponse
 	updatedResponse.Port = 9999
-	updatedResponse.ContentHash = "713435ba1f5badcf"
+	updatedResponse.ContentHash = "6ea98f3d4b1e9d13"
 
 	// Simple response for non-proxy service registered in TestAgent config
 	expectWebResponse := &api.AgentService{
 		ID:          "web",
 		Service:     "web",
 		Port:        8181,
-		ContentHash: "6c247f8ffa5d1fb2",
+		ContentHash: "73b9c508ae182f6e",
 		Weights: api.AgentWeights{
 			Passing: 1,
 			Warning: 1,
@@ -1263,7 +1264,7 @@ func TestAgent_Self(t *testing.T) {
 					map[string][]string{"envoy": proxysupport.EnvoyVersions},
 					val.XDS.SupportedProxies,
 				)
-
+				require.Empty(t, val.XDS.RecentEnvoyRejections)
 			} else {
 				require.Nil(t, val.XDS, "xds component should be missing when gRPC is disabled")
 			}
@@ -1583,7 +1584,7 @@ func TestAgent_Members(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Err: %v", err)
 	}
-	val := obj.([]serf.Member)
+	val := obj.([]*api.AgentMember)
 	if len(val) == 0 {
 		t.Fatalf("bad members: %v", obj)
 	}
@@ -1604,7 +1605,7 @@ func TestAgent_Members_WAN(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Err: %v", err)
 	}
-	val := obj.([]serf.Member)
+	val := obj.([]*api.AgentMember)
 	if len(val) == 0 {
 		t.Fatalf("bad members: %v", obj)
 	}
@@ -1626,7 +1627,7 @@ func TestAgent_Members_ACLFilter(t *testing.T) {
 		if err != nil {
 			t.Fatalf("Err: %v", err)
 		}
-		val := obj.([]serf.Member)
+		val := obj.([]*api.AgentMember)
 		if len(val) != 0 {
 			t.Fatalf("bad members: %v", obj)
 		}
@@ -1638,13 +1639,49 @@ func TestAgent_Members_ACLFilter(t *testing.T) {
 		if err != nil {
 			t.Fatalf("Err: %v", err)
 		}
-		val := obj.([]serf.Member)
+		val := obj.([]*api.AgentMember)
 		if len(val) != 1 {
 			t.Fatalf("bad members: %v", obj)
 		}
 	})
 }
 
+func TestAgent_Members_Filter(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/v1/agent/members?filter=Name+==+%q", a.Config.NodeName), nil)
+	obj, err := a.srv.AgentMembers(nil, req)
+	if err != nil {
+		t.Fatalf("Err: %v", err)
+	}
+	val := obj.([]*api.AgentMember)
+	if len(val) != 1 {
+		t.Fatalf("bad members: %v", obj)
+	}
+	if val[0].Name != a.Config.NodeName {
+		t.Fatalf("bad members: %v", obj)
+	}
+	// The local node's own health score is reported; memberlist has no
+	// concept of a per-member score for any other node.
+	if val[0].HealthScore != 0 {
+		t.Fatalf("expected a healthy local health score, got %d", val[0].HealthScore)
+	}
+
+	req, _ = http.NewRequest("GET", "/v1/agent/members?filter=Name+==+%22not-a-real-node%22", nil)
+	obj, err = a.srv.AgentMembers(nil, req)
+	if err != nil {
+		t.Fatalf("Err: %v", err)
+	}
+	val = obj.([]*api.AgentMember)
+	if len(val) != 0 {
+		t.Fatalf("bad members: %v", obj)
+	}
+}
+
 func TestAgent_Join(t *testing.T) {
 	t.Parallel()
 	a1 := NewTestAgent(t, "")
@@ -1760,7 +1797,7 @@ func TestAgent_JoinLANNotify(t *testing.T) {
 	defer a2.Shutdown()
 
 	notif := &mockNotifier{}
-	a1.joinLANNotifier = notif
+	a1.sdNotifier = notif
 
 	addr := fmt.Sprintf("127.0.0.1:%d", a2.Config.SerfPortLAN)
 	_, err := a1.JoinLAN([]string{addr})
@@ -2736,8 +2773,8 @@ func testAgent_RegisterService(t *testing.T, extraHCL string) {
 	if err != nil {
 		t.Fatalf("err: %v", err)
 	}
-	if obj != nil {
-		t.Fatalf("bad: %v", obj)
+	if obj == nil {
+		t.Fatalf("expected effective registration, got nil")
 	}
 
 	// Ensure the service
@@ -2777,6 +2814,77 @@ func testAgent_RegisterService(t *testing.T, extraHCL string) {
 	}
 }
 
+// TestAgent_RegisterService_ServiceDefaults verifies that Meta and check
+// interval/timeout defaults set in a service-defaults config entry are
+// applied to a service registered without its own values for those fields,
+// and never override values the registration already sets explicitly.
+func TestAgent_RegisterService_ServiceDefaults(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+	t.Parallel()
+
+	a := NewTestAgent(t, "enable_script_checks = true")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	entryReq := structs.ConfigEntryRequest{
+		Datacenter: "dc1",
+		Op:         structs.ConfigEntryUpsert,
+		Entry: &structs.ServiceConfigEntry{
+			Kind:          structs.ServiceDefaults,
+			Name:          "web",
+			CheckInterval: 10 * time.Second,
+			CheckTimeout:  2 * time.Second,
+			Meta: map[string]string{
+				"env": "prod",
+			},
+		},
+	}
+	var ignored bool
+	require.NoError(t, a.RPC("ConfigEntry.Apply", &entryReq, &ignored))
+
+	args := &structs.ServiceDefinition{
+		Name: "web",
+		Port: 8000,
+		Meta: map[string]string{"env": "qa"},
+		Checks: []*structs.CheckType{
+			{
+				ScriptArgs: []string{"true"},
+			},
+			{
+				ScriptArgs: []string{"true"},
+				Interval:   5 * time.Second,
+				Timeout:    1 * time.Second,
+			},
+		},
+	}
+	req, _ := http.NewRequest("PUT", "/v1/agent/service/register", jsonReader(args))
+
+	_, err := a.srv.AgentRegisterService(nil, req)
+	require.NoError(t, err)
+
+	sid := structs.NewServiceID("web", nil)
+	svc := a.State.Service(sid)
+	require.NotNil(t, svc)
+	// The registration's own value wins over the default.
+	require.Equal(t, "qa", svc.Meta["env"])
+
+	var withDefault, withOwnValues bool
+	for _, mon := range a.checkMonitors {
+		switch mon.Interval {
+		case 10 * time.Second:
+			withDefault = true
+			require.Equal(t, 2*time.Second, mon.Timeout)
+		case 5 * time.Second:
+			withOwnValues = true
+			require.Equal(t, 1*time.Second, mon.Timeout)
+		}
+	}
+	require.True(t, withDefault, "check without its own interval should get the service-defaults interval")
+	require.True(t, withOwnValues, "check with its own interval should keep it")
+}
+
 func TestAgent_RegisterService_ReRegister(t *testing.T) {
 	t.Run("normal", func(t *testing.T) {
 		t.Parallel()
@@ -3040,7 +3148,7 @@ func testAgent_RegisterService_TranslateKeys(t *testing.T, extraHCL string) {
 			rr := httptest.NewRecorder()
 			obj, err := a.srv.AgentRegisterService(rr, req)
 			require.NoError(t, err)
-			require.Nil(t, obj)
+			require.NotNil(t, obj)
 			require.Equal(t, 200, rr.Code, "body: %s", rr.Body)
 
 			svc := &structs.NodeService{
@@ -3283,7 +3391,7 @@ func testAgent_RegisterService_UnmanagedConnectProxy(t *testing.T, extraHCL stri
 	resp := httptest.NewRecorder()
 	obj, err := a.srv.AgentRegisterService(resp, req)
 	require.NoError(t, err)
-	require.Nil(t, obj)
+	require.NotNil(t, obj)
 
 	// Ensure the service
 	sid := structs.NewServiceID("connect-proxy", nil)
@@ -3799,7 +3907,7 @@ func testAgent_RegisterServiceDeregisterService_Sidecar(t *testing.T, extraHCL s
 				return
 			}
 			require.NoError(err)
-			assert.Nil(obj)
+			assert.NotNil(obj)
 			require.Equal(200, resp.Code, "request failed with body: %s",
 				resp.Body.String())
 
@@ -3948,7 +4056,7 @@ func testAgent_RegisterService_ConnectNative(t *testing.T, extraHCL string) {
 	resp := httptest.NewRecorder()
 	obj, err := a.srv.AgentRegisterService(resp, req)
 	assert.Nil(err)
-	assert.Nil(obj)
+	assert.NotNil(obj)
 
 	// Ensure the service
 	svc := a.State.Service(structs.NewServiceID("web", nil))
@@ -4078,6 +4186,58 @@ func TestAgent_DeregisterService(t *testing.T) {
 	assert.Nil(t, a.State.Check(structs.NewCheckID("test", nil)), "have test check")
 }
 
+// TestAgent_DeregisterService_MinHealthyInstances verifies that the
+// deregister and maintenance-mode endpoints reject requests that would drop
+// a service below its service-defaults MinHealthyInstances guard, unless
+// force=true is passed.
+func TestAgent_DeregisterService_MinHealthyInstances(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	entryReq := structs.ConfigEntryRequest{
+		Datacenter: "dc1",
+		Op:         structs.ConfigEntryUpsert,
+		Entry: &structs.ServiceConfigEntry{
+			Kind:                structs.ServiceDefaults,
+			Name:                "web",
+			MinHealthyInstances: 1,
+		},
+	}
+	var ignored bool
+	require.NoError(t, a.RPC("ConfigEntry.Apply", &entryReq, &ignored))
+
+	service := &structs.NodeService{
+		ID:      "web",
+		Service: "web",
+	}
+	require.NoError(t, a.AddService(service, nil, false, "", ConfigSourceLocal))
+
+	// Deregistering the sole instance would drop below the minimum. Retry
+	// since the service's registration needs to reach the catalog via
+	// anti-entropy before the health lookup behind the guard can see it.
+	retry.Run(t, func(r *retry.R) {
+		req, _ := http.NewRequest("PUT", "/v1/agent/service/deregister/web", nil)
+		resp := httptest.NewRecorder()
+		_, err := a.srv.AgentDeregisterService(resp, req)
+		require.NoError(r, err)
+		require.Equal(r, http.StatusConflict, resp.Code)
+	})
+	require.NotNil(t, a.State.Service(structs.NewServiceID("web", nil)), "service should still be registered")
+
+	// force=true bypasses the guard.
+	req, _ := http.NewRequest("PUT", "/v1/agent/service/deregister/web?force=true", nil)
+	resp := httptest.NewRecorder()
+	_, err := a.srv.AgentDeregisterService(resp, req)
+	require.NoError(t, err)
+	require.Nil(t, a.State.Service(structs.NewServiceID("web", nil)), "service should be deregistered")
+}
+
 func TestAgent_DeregisterService_ACLDeny(t *testing.T) {
 	t.Parallel()
 	a := NewTestAgent(t, TestACLConfig())
@@ -4257,6 +4417,140 @@ func TestAgent_ServiceMaintenance_ACLDeny(t *testing.T) {
 	})
 }
 
+func TestAgent_ServiceDrain_BadRequest(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	t.Run("no service id", func(t *testing.T) {
+		req, _ := http.NewRequest("PUT", "/v1/agent/service/drain/", nil)
+		resp := httptest.NewRecorder()
+		if _, err := a.srv.AgentServiceDrain(resp, req); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if resp.Code != 400 {
+			t.Fatalf("expected 400, got %d", resp.Code)
+		}
+	})
+
+	t.Run("unknown service id", func(t *testing.T) {
+		req, _ := http.NewRequest("PUT", "/v1/agent/service/drain/nope", nil)
+		resp := httptest.NewRecorder()
+		if _, err := a.srv.AgentServiceDrain(resp, req); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if resp.Code != 404 {
+			t.Fatalf("expected 404, got %d", resp.Code)
+		}
+	})
+
+	t.Run("bad duration", func(t *testing.T) {
+		service := &structs.NodeService{ID: "test", Service: "test"}
+		if err := a.AddService(service, nil, false, "", ConfigSourceLocal); err != nil {
+			t.Fatalf("err: %v", err)
+		}
+		req, _ := http.NewRequest("PUT", "/v1/agent/service/drain/test?duration=nope", nil)
+		resp := httptest.NewRecorder()
+		if _, err := a.srv.AgentServiceDrain(resp, req); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if resp.Code != 400 {
+			t.Fatalf("expected 400, got %d", resp.Code)
+		}
+	})
+}
+
+func TestAgent_ServiceDrain(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	service := &structs.NodeService{
+		ID:      "test",
+		Service: "test",
+	}
+	if err := a.AddService(service, nil, false, "", ConfigSourceLocal); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	req, _ := http.NewRequest("PUT", "/v1/agent/service/drain/test", nil)
+	resp := httptest.NewRecorder()
+	if _, err := a.srv.AgentServiceDrain(resp, req); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if resp.Code != 200 {
+		t.Fatalf("expected 200, got %d", resp.Code)
+	}
+
+	sid := structs.NewServiceID("test", nil)
+	got := a.State.Service(sid)
+	if got == nil || !got.Draining {
+		t.Fatalf("expected service to be marked draining, got %#v", got)
+	}
+}
+
+func TestAgent_ServiceDrain_AutoDeregister(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	service := &structs.NodeService{
+		ID:      "test",
+		Service: "test",
+	}
+	if err := a.AddService(service, nil, false, "", ConfigSourceLocal); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	req, _ := http.NewRequest("PUT", "/v1/agent/service/drain/test?duration=50ms", nil)
+	resp := httptest.NewRecorder()
+	if _, err := a.srv.AgentServiceDrain(resp, req); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if resp.Code != 200 {
+		t.Fatalf("expected 200, got %d", resp.Code)
+	}
+
+	sid := structs.NewServiceID("test", nil)
+	retry.Run(t, func(r *retry.R) {
+		if a.State.ServiceExists(sid) {
+			r.Fatalf("service should have been deregistered after drain duration elapsed")
+		}
+	})
+}
+
+func TestAgent_ServiceDrain_ACLDeny(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, TestACLConfig())
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	service := &structs.NodeService{
+		ID:      "test",
+		Service: "test",
+	}
+	if err := a.AddService(service, nil, false, "", ConfigSourceLocal); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	t.Run("no token", func(t *testing.T) {
+		req, _ := http.NewRequest("PUT", "/v1/agent/service/drain/test", nil)
+		if _, err := a.srv.AgentServiceDrain(nil, req); !acl.IsErrPermissionDenied(err) {
+			t.Fatalf("err: %v", err)
+		}
+	})
+
+	t.Run("root token", func(t *testing.T) {
+		req, _ := http.NewRequest("PUT", "/v1/agent/service/drain/test?token=root", nil)
+		if _, err := a.srv.AgentServiceDrain(nil, req); err != nil {
+			t.Fatalf("err: %v", err)
+		}
+	})
+}
+
 func TestAgent_NodeMaintenance_BadRequest(t *testing.T) {
 	t.Parallel()
 	a := NewTestAgent(t, "")
@@ -4614,6 +4908,121 @@ func TestAgent_Monitor_ACLDeny(t *testing.T) {
 	// here.
 }
 
+func TestAgent_Subscribe(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, `rpc { enable_streaming = true }`)
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	t.Run("unknown topic", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/v1/agent/subscribe?topic=kv-prefix", nil)
+		resp := httptest.NewRecorder()
+		_, err := a.srv.AgentSubscribe(resp, req)
+		if _, ok := err.(BadRequestError); !ok {
+			t.Fatalf("expected BadRequestError, got %#v", err)
+		}
+	})
+
+	t.Run("streams service health events", func(t *testing.T) {
+		retry.Run(t, func(r *retry.R) {
+			req, _ := http.NewRequest("GET", "/v1/agent/subscribe?topic=service-health&key=subscribe-test", nil)
+			cancelCtx, cancelFunc := context.WithCancel(context.Background())
+			req = req.WithContext(cancelCtx)
+
+			resp := httptest.NewRecorder()
+			errCh := make(chan error)
+			go func() {
+				_, err := a.srv.AgentSubscribe(resp, req)
+				errCh <- err
+			}()
+
+			args := &structs.ServiceDefinition{
+				Name: "subscribe-test",
+				Port: 8000,
+			}
+			registerReq, _ := http.NewRequest("PUT", "/v1/agent/service/register", jsonReader(args))
+			if _, err := a.srv.AgentRegisterService(nil, registerReq); err != nil {
+				t.Fatalf("err: %v", err)
+			}
+
+			require.Eventually(t, func() bool {
+				return len(resp.Body.Bytes()) > 0
+			}, 3*time.Second, 100*time.Millisecond)
+
+			cancelFunc()
+			err := <-errCh
+			require.NoError(t, err)
+
+			got := resp.Body.String()
+			want := `"Key":"subscribe-test"`
+			if !strings.Contains(got, want) {
+				r.Fatalf("got %q and did not find %q", got, want)
+			}
+		})
+	})
+}
+
+func TestAgent_Subscribe_RequiresServer(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, `server = false
+	bootstrap = false
+	rpc { enable_streaming = true }`)
+	defer a.Shutdown()
+
+	req, _ := http.NewRequest("GET", "/v1/agent/subscribe?topic=service-health", nil)
+	resp := httptest.NewRecorder()
+	_, err := a.srv.AgentSubscribe(resp, req)
+	if _, ok := err.(BadRequestError); !ok {
+		t.Fatalf("expected BadRequestError, got %#v", err)
+	}
+}
+
+func TestAgent_Subscribe_ACLDeny(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, TestACLConfig()+`
+	rpc { enable_streaming = true }`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	req, _ := http.NewRequest("GET", "/v1/agent/subscribe?topic=service-health", nil)
+	if _, err := a.srv.AgentSubscribe(nil, req); !acl.IsErrPermissionDenied(err) {
+		t.Fatalf("err: %v", err)
+	}
+}
+
+func TestAgent_ConvergenceStatus(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	req, _ := http.NewRequest("GET", "/v1/agent/convergence/999999999", nil)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.AgentConvergenceStatus(resp, req)
+	require.NoError(t, err)
+
+	out, ok := obj.(AgentConvergenceStatusResponse)
+	require.True(t, ok)
+	require.EqualValues(t, 999999999, out.Index)
+	require.False(t, out.Seen)
+
+	req, _ = http.NewRequest("GET", "/v1/agent/convergence/0", nil)
+	resp = httptest.NewRecorder()
+	obj, err = a.srv.AgentConvergenceStatus(resp, req)
+	require.NoError(t, err)
+
+	out, ok = obj.(AgentConvergenceStatusResponse)
+	require.True(t, ok)
+	require.True(t, out.Seen)
+
+	req, _ = http.NewRequest("GET", "/v1/agent/convergence/not-a-number", nil)
+	resp = httptest.NewRecorder()
+	_, err = a.srv.AgentConvergenceStatus(resp, req)
+	if _, ok := err.(BadRequestError); !ok {
+		t.Fatalf("expected BadRequestError, got %#v", err)
+	}
+}
+
 func TestAgent_TokenTriggersFullSync(t *testing.T) {
 	t.Parallel()
 
@@ -5898,6 +6307,90 @@ func TestAgentConnectAuthorize_deny(t *testing.T) {
 	assert.Contains(obj.Reason, "Matched")
 }
 
+// Test intentions sourced from an auth method login rather than a Consul
+// service identity.
+func TestAgentConnectAuthorize_authMethodSource(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	target := "db"
+
+	// Create an intention sourced from logins through "okta", narrowed to
+	// identities bound to the "payments" team.
+	{
+		entry := &structs.ServiceIntentionsConfigEntry{
+			Kind: structs.ServiceIntentions,
+			Name: target,
+			Sources: []*structs.SourceIntention{
+				{
+					Type:             structs.IntentionSourceAuthMethod,
+					SourceAuthMethod: "okta",
+					SourceSelector:   `team == "payments"`,
+					Action:           structs.IntentionActionAllow,
+				},
+			},
+		}
+		req := structs.ConfigEntryRequest{
+			Datacenter: "dc1",
+			Op:         structs.ConfigEntryUpsert,
+			Entry:      entry,
+		}
+		var ignored bool
+		require.Nil(a.RPC("ConfigEntry.Apply", &req, &ignored))
+	}
+
+	// A login through the right auth method with a matching selector is
+	// allowed.
+	args := &structs.ConnectAuthorizeRequest{
+		Target:             target,
+		SourceAuthMethod:   "okta",
+		SourceSelectorVars: map[string]string{"team": "payments"},
+	}
+	req, _ := http.NewRequest("POST", "/v1/agent/connect/authorize", jsonReader(args))
+	resp := httptest.NewRecorder()
+	respRaw, err := a.srv.AgentConnectAuthorize(resp, req)
+	require.Nil(err)
+	require.Equal(200, resp.Code)
+
+	obj := respRaw.(*connectAuthorizeResp)
+	require.True(obj.Authorized)
+	require.Contains(obj.Reason, "Matched")
+
+	// The same auth method but a non-matching selector falls through to the
+	// default deny behavior, not the intention above.
+	args = &structs.ConnectAuthorizeRequest{
+		Target:             target,
+		SourceAuthMethod:   "okta",
+		SourceSelectorVars: map[string]string{"team": "other"},
+	}
+	req, _ = http.NewRequest("POST", "/v1/agent/connect/authorize", jsonReader(args))
+	resp = httptest.NewRecorder()
+	respRaw, err = a.srv.AgentConnectAuthorize(resp, req)
+	require.Nil(err)
+	require.Equal(200, resp.Code)
+
+	obj = respRaw.(*connectAuthorizeResp)
+	require.True(obj.Authorized)
+	require.Contains(obj.Reason, "disabled")
+
+	// Specifying both a client cert and an auth method source is rejected.
+	args = &structs.ConnectAuthorizeRequest{
+		Target:           target,
+		ClientCertURI:    connect.TestSpiffeIDService(t, "web").URI().String(),
+		SourceAuthMethod: "okta",
+	}
+	req, _ = http.NewRequest("POST", "/v1/agent/connect/authorize", jsonReader(args))
+	resp = httptest.NewRecorder()
+	respRaw, err = a.srv.AgentConnectAuthorize(resp, req)
+	require.Error(err)
+	require.Nil(respRaw)
+	require.Contains(err.Error(), "mutually exclusive")
+}
+
 // Test when there is an intention allowing service with a different trust
 // domain. We allow this because migration between trust domains shouldn't cause
 // an outage even if we have stale info about current trusted domains. It's safe
@@ -6151,6 +6644,118 @@ func TestAgent_Host(t *testing.T) {
 	assert.Empty(obj.Errors)
 }
 
+func TestAgent_DebugGossip(t *testing.T) {
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	req, _ := http.NewRequest("GET", "/v1/agent/debug/gossip", nil)
+	resp := httptest.NewRecorder()
+	respRaw, err := a.srv.AgentDebugGossip(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	obj := respRaw.(struct {
+		LAN GossipPoolStats
+		WAN *GossipPoolStats
+	})
+	require.Equal(t, 1, obj.LAN.Members)
+	require.NotNil(t, obj.WAN, "server agent also joins the WAN gossip pool")
+	require.Equal(t, 1, obj.WAN.Members)
+}
+
+func TestAgent_Ready(t *testing.T) {
+	t.Parallel()
+
+	a := NewTestAgent(t, ``)
+	defer a.Shutdown()
+
+	req, _ := http.NewRequest("GET", "/v1/agent/ready", nil)
+	resp := httptest.NewRecorder()
+	respRaw, err := a.srv.AgentReady(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	out, ok := respRaw.(AgentReadyResponse)
+	require.True(t, ok)
+	require.True(t, out.Ready)
+	require.Equal(t, "complete", out.Stage)
+}
+
+func TestAgent_Ready_WaitForLeader(t *testing.T) {
+	t.Parallel()
+
+	srv := NewTestAgent(t, ``)
+	defer srv.Shutdown()
+	testrpc.WaitForLeader(t, srv.RPC, "dc1")
+
+	client := NewTestAgent(t, `
+		server = false
+		bootstrap = false
+		wait_for_leader = true
+	`)
+	defer client.Shutdown()
+
+	req, _ := http.NewRequest("GET", "/v1/agent/ready", nil)
+	resp := httptest.NewRecorder()
+	respRaw, err := client.srv.AgentReady(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	require.False(t, respRaw.(AgentReadyResponse).Ready)
+
+	_, err = client.JoinLAN([]string{
+		fmt.Sprintf("127.0.0.1:%d", srv.Config.SerfPortLAN),
+	})
+	require.NoError(t, err)
+
+	retry.Run(t, func(r *retry.R) {
+		resp := httptest.NewRecorder()
+		respRaw, err := client.srv.AgentReady(resp, req)
+		require.NoError(r, err)
+		if !respRaw.(AgentReadyResponse).Ready {
+			r.Fatal("client agent is not ready yet")
+		}
+	})
+}
+
+func TestAgent_Ready_ReadyChecks(t *testing.T) {
+	t.Parallel()
+
+	a := NewTestAgent(t, `
+		ready_check_serf = true
+		ready_check_server = true
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	req, _ := http.NewRequest("GET", "/v1/agent/ready", nil)
+	resp := httptest.NewRecorder()
+	respRaw, err := a.srv.AgentReady(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	out, ok := respRaw.(AgentReadyResponse)
+	require.True(t, ok)
+	require.True(t, out.Ready)
+	require.True(t, out.Checks["serf"])
+	require.True(t, out.Checks["server"])
+}
+
+func TestAgent_Live(t *testing.T) {
+	t.Parallel()
+
+	a := NewTestAgent(t, ``)
+	defer a.Shutdown()
+
+	req, _ := http.NewRequest("GET", "/v1/agent/live", nil)
+	resp := httptest.NewRecorder()
+	_, err := a.srv.AgentLive(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Code)
+}
+
 func TestAgent_HostBadACL(t *testing.T) {
 	t.Parallel()
 	assert := assert.New(t)
@@ -6174,6 +6779,21 @@ func TestAgent_HostBadACL(t *testing.T) {
 	assert.Nil(respRaw)
 }
 
+func TestAgent_XDSConfigStatus(t *testing.T) {
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	req, _ := http.NewRequest("GET", "/v1/agent/xds/config-status", nil)
+	respRaw, err := a.srv.AgentXDSConfigStatus(nil, req)
+	require.NoError(t, err)
+
+	// No proxies connected yet.
+	require.Equal(t, []xds.ProxyConfigStatus{}, respRaw)
+}
+
 // Thie tests that a proxy with an ExposeConfig is returned as expected.
 func TestAgent_Services_ExposeConfig(t *testing.T) {
 	t.Parallel()
@@ -263,6 +263,25 @@ func (c *Cache) ReloadOptions(options Options) bool {
 	return modified
 }
 
+// HighestIndex returns the highest Index across all valid entries currently
+// held in the cache. This is used to answer "has this agent's cache seen a
+// result at least this fresh" convergence checks; it's deliberately coarse
+// (it doesn't know which specific request a target index came from) since
+// callers doing a convergence check don't know the specific cache key either
+// -- they just want to know whether this agent is caught up at all.
+func (c *Cache) HighestIndex() uint64 {
+	c.entriesLock.RLock()
+	defer c.entriesLock.RUnlock()
+
+	var highest uint64
+	for _, entry := range c.entries {
+		if entry.Valid && entry.Index > highest {
+			highest = entry.Index
+		}
+	}
+	return highest
+}
+
 // Get loads the data for the given type and request. If data satisfying the
 // minimum index is present in the cache, it is returned immediately. Otherwise,
 // this will block until the data is available or the request timeout is
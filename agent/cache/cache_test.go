@@ -52,6 +52,29 @@ func TestCacheGet_noIndex(t *testing.T) {
 	typ.AssertExpectations(t)
 }
 
+func TestCache_HighestIndex(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	typ := TestType(t)
+	defer typ.AssertExpectations(t)
+	c := New(Options{})
+	c.RegisterType("t", typ)
+
+	require.EqualValues(0, c.HighestIndex())
+
+	typ.Static(FetchResult{Value: 1, Index: 5}, nil).Times(1)
+	_, _, err := c.Get(context.Background(), "t", TestRequest(t, RequestInfo{Key: "a"}))
+	require.NoError(err)
+	require.EqualValues(5, c.HighestIndex())
+
+	typ.Static(FetchResult{Value: 2, Index: 3}, nil).Times(1)
+	_, _, err = c.Get(context.Background(), "t", TestRequest(t, RequestInfo{Key: "b"}))
+	require.NoError(err)
+	require.EqualValues(5, c.HighestIndex())
+}
+
 // Test a basic Get with no index and a failed fetch.
 func TestCacheGet_initError(t *testing.T) {
 	t.Parallel()
@@ -32,7 +32,7 @@ func (s *HTTPHandlers) CatalogRegister(resp http.ResponseWriter, req *http.Reque
 	s.parseToken(req, &args.Token)
 
 	// Forward to the servers
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := s.agent.RPC("Catalog.Register", &args, &out); err != nil {
 		metrics.IncrCounterWithLabels([]string{"client", "rpc", "error", "catalog_register"}, 1,
 			[]metrics.Label{{Name: "node", Value: s.nodeName()}})
@@ -40,6 +40,7 @@ func (s *HTTPHandlers) CatalogRegister(resp http.ResponseWriter, req *http.Reque
 	}
 	metrics.IncrCounterWithLabels([]string{"client", "api", "success", "catalog_register"}, 1,
 		[]metrics.Label{{Name: "node", Value: s.nodeName()}})
+	setIndex(resp, out.Index)
 	return true, nil
 }
 
@@ -64,7 +65,7 @@ func (s *HTTPHandlers) CatalogDeregister(resp http.ResponseWriter, req *http.Req
 	s.parseToken(req, &args.Token)
 
 	// Forward to the servers
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := s.agent.RPC("Catalog.Deregister", &args, &out); err != nil {
 		metrics.IncrCounterWithLabels([]string{"client", "rpc", "error", "catalog_deregister"}, 1,
 			[]metrics.Label{{Name: "node", Value: s.nodeName()}})
@@ -72,6 +73,7 @@ func (s *HTTPHandlers) CatalogDeregister(resp http.ResponseWriter, req *http.Req
 	}
 	metrics.IncrCounterWithLabels([]string{"client", "api", "success", "catalog_deregister"}, 1,
 		[]metrics.Label{{Name: "node", Value: s.nodeName()}})
+	setIndex(resp, out.Index)
 	return true, nil
 }
 
@@ -138,7 +140,7 @@ RETRY_ONCE:
 	}
 	out.ConsistencyLevel = args.QueryOptions.ConsistencyLevel()
 
-	s.agent.TranslateAddresses(args.Datacenter, out.Nodes, TranslateAddressAcceptAny)
+	s.agent.TranslateAddresses(args.Datacenter, out.Nodes, TranslateAddressAcceptAny, s.remoteIP(req))
 
 	// Use empty list instead of nil
 	if out.Nodes == nil {
@@ -284,7 +286,7 @@ func (s *HTTPHandlers) catalogServiceNodes(resp http.ResponseWriter, req *http.R
 	}
 
 	out.ConsistencyLevel = args.QueryOptions.ConsistencyLevel()
-	s.agent.TranslateAddresses(args.Datacenter, out.ServiceNodes, TranslateAddressAcceptAny)
+	s.agent.TranslateAddresses(args.Datacenter, out.ServiceNodes, TranslateAddressAcceptAny, s.remoteIP(req))
 
 	// Use empty list instead of nil
 	if out.ServiceNodes == nil {
@@ -340,7 +342,7 @@ RETRY_ONCE:
 	}
 	out.ConsistencyLevel = args.QueryOptions.ConsistencyLevel()
 	if out.NodeServices != nil {
-		s.agent.TranslateAddresses(args.Datacenter, out.NodeServices, TranslateAddressAcceptAny)
+		s.agent.TranslateAddresses(args.Datacenter, out.NodeServices, TranslateAddressAcceptAny, s.remoteIP(req))
 	}
 
 	// TODO: The NodeServices object in IndexedNodeServices is a pointer to
@@ -402,7 +404,7 @@ RETRY_ONCE:
 		goto RETRY_ONCE
 	}
 	out.ConsistencyLevel = args.QueryOptions.ConsistencyLevel()
-	s.agent.TranslateAddresses(args.Datacenter, &out.NodeServices, TranslateAddressAcceptAny)
+	s.agent.TranslateAddresses(args.Datacenter, &out.NodeServices, TranslateAddressAcceptAny, s.remoteIP(req))
 
 	// Use empty list instead of nil
 	for _, s := range out.NodeServices.Services {
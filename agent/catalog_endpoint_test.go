@@ -42,6 +42,26 @@ func TestCatalogRegister_Service_InvalidAddress(t *testing.T) {
 	}
 }
 
+func TestCatalogRegister(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	args := &structs.RegisterRequest{Node: "foo", Address: "127.0.0.1"}
+	req, _ := http.NewRequest("PUT", "/v1/catalog/register", jsonReader(args))
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.CatalogRegister(resp, req)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	res := obj.(bool)
+	if res != true {
+		t.Fatalf("bad: %v", res)
+	}
+	assertIndex(t, resp)
+}
+
 func TestCatalogDeregister(t *testing.T) {
 	t.Parallel()
 	a := NewTestAgent(t, "")
@@ -50,7 +70,8 @@ func TestCatalogDeregister(t *testing.T) {
 	// Register node
 	args := &structs.DeregisterRequest{Node: "foo"}
 	req, _ := http.NewRequest("PUT", "/v1/catalog/deregister", jsonReader(args))
-	obj, err := a.srv.CatalogDeregister(nil, req)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.CatalogDeregister(resp, req)
 	if err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -59,6 +80,7 @@ func TestCatalogDeregister(t *testing.T) {
 	if res != true {
 		t.Fatalf("bad: %v", res)
 	}
+	assertIndex(t, resp)
 }
 
 func TestCatalogDatacenters(t *testing.T) {
@@ -93,7 +115,7 @@ func TestCatalogNodes(t *testing.T) {
 		Address:    "127.0.0.1",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -130,7 +152,7 @@ func TestCatalogNodes_MetaFilter(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -171,7 +193,7 @@ func TestCatalogNodes_Filter(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 	req, _ := http.NewRequest("GET", "/v1/catalog/nodes?filter="+url.QueryEscape("Meta.somekey == somevalue"), nil)
@@ -236,7 +258,7 @@ func TestCatalogNodes_WanTranslation(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a2.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -314,7 +336,7 @@ func TestCatalogNodes_Blocking(t *testing.T) {
 			Node:       "foo",
 			Address:    "127.0.0.1",
 		}
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Errorf("err: %v", err)
 		}
@@ -375,7 +397,7 @@ func TestCatalogNodes_DistanceSort(t *testing.T) {
 		Node:       "foo",
 		Address:    "127.0.0.1",
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -464,7 +486,7 @@ func TestCatalogServices(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -502,7 +524,7 @@ func TestCatalogServices_NodeMetaFilter(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -552,7 +574,7 @@ func TestCatalogRegister_checkRegistration(t *testing.T) {
 		Check: &check,
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -614,7 +636,7 @@ func TestCatalogServiceNodes(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -726,7 +748,7 @@ func TestCatalogServiceNodes_NodeMetaFilter(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -779,7 +801,7 @@ func TestCatalogServiceNodes_Filter(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 	// Register a second service for the node
@@ -855,7 +877,7 @@ func TestCatalogServiceNodes_WanTranslation(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.NoError(t, a2.RPC("Catalog.Register", args, &out))
 	}
 
@@ -907,7 +929,7 @@ func TestCatalogServiceNodes_DistanceSort(t *testing.T) {
 			Tags:    []string{"a"},
 		},
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -992,7 +1014,7 @@ func TestCatalogServiceNodes_ConnectProxy(t *testing.T) {
 
 	// Register
 	args := structs.TestRegisterRequestProxy(t)
-	var out struct{}
+	var out structs.WriteIndexResponse
 	assert.Nil(a.RPC("Catalog.Register", args, &out))
 
 	req, _ := http.NewRequest("GET", fmt.Sprintf(
@@ -1021,7 +1043,7 @@ func TestCatalogConnectServiceNodes_good(t *testing.T) {
 	// Register
 	args := structs.TestRegisterRequestProxy(t)
 	args.Service.Address = "127.0.0.55"
-	var out struct{}
+	var out structs.WriteIndexResponse
 	assert.Nil(a.RPC("Catalog.Register", args, &out))
 
 	req, _ := http.NewRequest("GET", fmt.Sprintf(
@@ -1048,7 +1070,7 @@ func TestCatalogConnectServiceNodes_Filter(t *testing.T) {
 	// Register
 	args := structs.TestRegisterRequestProxy(t)
 	args.Service.Address = "127.0.0.55"
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 	args = structs.TestRegisterRequestProxy(t)
@@ -1093,7 +1115,7 @@ func TestCatalogNodeServices(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -1136,7 +1158,7 @@ func TestCatalogNodeServiceList(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -1186,7 +1208,7 @@ func TestCatalogNodeServices_Filter(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 	// Register a connect proxy
@@ -1218,7 +1240,7 @@ func TestCatalogNodeServices_ConnectProxy(t *testing.T) {
 
 	// Register
 	args := structs.TestRegisterRequestProxy(t)
-	var out struct{}
+	var out structs.WriteIndexResponse
 	assert.Nil(a.RPC("Catalog.Register", args, &out))
 
 	req, _ := http.NewRequest("GET", fmt.Sprintf(
@@ -1282,7 +1304,7 @@ func TestCatalogNodeServices_WanTranslation(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.NoError(t, a2.RPC("Catalog.Register", args, &out))
 	}
 
@@ -1337,7 +1359,7 @@ func TestCatalog_GatewayServices_Terminating(t *testing.T) {
 		Status:    api.HealthPassing,
 		ServiceID: args.Service.Service,
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	assert.NoError(t, a.RPC("Catalog.Register", &args, &out))
 
 	// Associate the gateway and api/redis services
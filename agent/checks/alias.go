@@ -20,14 +20,24 @@ const (
 // this check is critical. If the service has no critical but warnings,
 // then this check is warning, and if a service has only passing checks, then
 // this check is passing.
+// Supports failures_before_critical and success_before_passing.
 type CheckAlias struct {
 	Node      string            // Node name of the service. If empty, assumed to be this node.
 	ServiceID structs.ServiceID // ID (not name) of the service to alias
 
-	CheckID structs.CheckID             // ID of this check
-	RPC     RPC                         // Used to query remote server if necessary
-	RPCReq  structs.NodeSpecificRequest // Base request
-	Notify  AliasNotifier               // For updating the check state
+	// ServiceName, if set, aliases the aggregated health of every instance
+	// of the named service cluster-wide instead of a single instance on a
+	// single node. The check passes as soon as any instance is passing,
+	// which is useful for representing the health of a dependency that is
+	// backed by multiple remote instances rather than one fixed node.
+	// Mutually exclusive with Node/ServiceID.
+	ServiceName string
+
+	CheckID       structs.CheckID             // ID of this check
+	RPC           RPC                         // Used to query remote server if necessary
+	RPCReq        structs.NodeSpecificRequest // Base request
+	Notify        AliasNotifier               // For updating the check state
+	StatusHandler *StatusHandler              // Applies success/failure thresholds to the aliased status
 
 	stop     bool
 	stopCh   chan struct{}
@@ -78,6 +88,13 @@ func (c *CheckAlias) Stop() {
 func (c *CheckAlias) run(stopCh chan struct{}) {
 	defer c.stopWg.Done()
 
+	// If we're aliasing the aggregated health of a named service across
+	// the cluster, use a blocking query against all of its instances.
+	if c.ServiceName != "" {
+		c.runServiceQuery(stopCh)
+		return
+	}
+
 	// If we have a specific node set, then use a blocking query
 	if c.Node != "" {
 		c.runQuery(stopCh)
@@ -239,6 +256,106 @@ func (c *CheckAlias) runQuery(stopCh chan struct{}) {
 	}
 }
 
+// runServiceQuery is used when the alias targets a service name rather than
+// a single instance. It blocks on the aggregated health of every instance of
+// that service and considers the alias passing as soon as any instance is
+// passing.
+func (c *CheckAlias) runServiceQuery(stopCh chan struct{}) {
+	args := structs.ServiceSpecificRequest{
+		ServiceName:    c.ServiceName,
+		EnterpriseMeta: c.EnterpriseMeta,
+	}
+	args.AllowStale = true
+	args.MaxQueryTime = 1 * time.Minute
+	// We are late at maximum of 15s compared to leader
+	args.MaxStaleDuration = 15 * time.Second
+
+	var attempt uint
+	for {
+		// Check if we're stopped. We fallthrough and block otherwise,
+		// which has a maximum time set above so we'll always check for
+		// stop within a reasonable amount of time.
+		select {
+		case <-stopCh:
+			return
+		default:
+		}
+
+		// Backoff if we have to
+		if attempt > checkAliasBackoffMin {
+			shift := attempt - checkAliasBackoffMin
+			if shift > 31 {
+				shift = 31 // so we don't overflow to 0
+			}
+			waitTime := (1 << shift) * time.Second
+			if waitTime > checkAliasBackoffMaxWait {
+				waitTime = checkAliasBackoffMaxWait
+			}
+			time.Sleep(waitTime)
+		}
+
+		var out structs.IndexedCheckServiceNodes
+		if err := c.RPC.RPC("Health.ServiceNodes", &args, &out); err != nil {
+			attempt++
+			if attempt > 1 {
+				c.Notify.UpdateCheck(c.CheckID, api.HealthCritical,
+					fmt.Sprintf("Failure checking aliased service %q: %s", c.ServiceName, err))
+			}
+
+			continue
+		}
+
+		attempt = 0 // Reset the attempts so we don't backoff the next
+
+		// Set our index for the next request
+		args.MinQueryIndex = out.Index
+
+		// We want to ensure that we're always blocking on subsequent requests
+		// to avoid hot loops. Index 1 is always safe since the min raft index
+		// is at least 5. Note this shouldn't happen but protecting against this
+		// case is safer than a 100% CPU loop.
+		if args.MinQueryIndex < 1 {
+			args.MinQueryIndex = 1
+		}
+		c.processServiceNodes(out.Nodes)
+	}
+}
+
+// processServiceNodes aggregates the health of every instance of the
+// aliased service and updates our check to passing if any instance is
+// passing, warning if none are passing but at least one is warning, and
+// critical otherwise.
+func (c *CheckAlias) processServiceNodes(nodes structs.CheckServiceNodes) {
+	if len(nodes) == 0 {
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical,
+			fmt.Sprintf("No instances of service %q found", c.ServiceName))
+		return
+	}
+
+	health := api.HealthCritical
+	for _, node := range nodes {
+		switch node.Checks.AggregatedStatus() {
+		case api.HealthPassing:
+			health = api.HealthPassing
+		case api.HealthWarning:
+			if health != api.HealthPassing {
+				health = api.HealthWarning
+			}
+		}
+	}
+
+	var msg string
+	switch health {
+	case api.HealthPassing:
+		msg = fmt.Sprintf("At least one instance of service %q is passing", c.ServiceName)
+	case api.HealthWarning:
+		msg = fmt.Sprintf("No passing instances of service %q, at least one is warning", c.ServiceName)
+	default:
+		msg = fmt.Sprintf("No passing or warning instances of service %q", c.ServiceName)
+	}
+	c.StatusHandler.updateCheck(c.CheckID, health, msg)
+}
+
 // processChecks is a common helper for taking a set of health checks and
 // using them to update our alias. This is abstracted since the checks can
 // come from both the remote server as well as local state.
@@ -282,5 +399,5 @@ func (c *CheckAlias) processChecks(checks []*structs.HealthCheck, CheckIfService
 			health = api.HealthCritical
 		}
 	}
-	c.Notify.UpdateCheck(c.CheckID, health, msg)
+	c.StatusHandler.updateCheck(c.CheckID, health, msg)
 }
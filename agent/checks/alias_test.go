@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/consul/agent/mock"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil"
 	"github.com/hashicorp/consul/sdk/testutil/retry"
 	"github.com/hashicorp/consul/types"
 	//"github.com/stretchr/testify/require"
@@ -23,11 +24,12 @@ func TestCheckAlias_remoteErrBackoff(t *testing.T) {
 	chkID := structs.NewCheckID(types.CheckID("foo"), nil)
 	rpc := &mockRPC{}
 	chk := &CheckAlias{
-		Node:      "remote",
-		ServiceID: structs.ServiceID{ID: "web"},
-		CheckID:   chkID,
-		Notify:    notify,
-		RPC:       rpc,
+		Node:          "remote",
+		ServiceID:     structs.ServiceID{ID: "web"},
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
 	}
 
 	rpc.AddReply("Health.NodeChecks", fmt.Errorf("failure"))
@@ -55,11 +57,12 @@ func TestCheckAlias_remoteNoChecks(t *testing.T) {
 	chkID := structs.NewCheckID(types.CheckID("foo"), nil)
 	rpc := &mockRPC{}
 	chk := &CheckAlias{
-		Node:      "remote",
-		ServiceID: structs.ServiceID{ID: "web"},
-		CheckID:   chkID,
-		Notify:    notify,
-		RPC:       rpc,
+		Node:          "remote",
+		ServiceID:     structs.ServiceID{ID: "web"},
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
 	}
 
 	rpc.AddReply("Health.NodeChecks", structs.IndexedHealthChecks{})
@@ -81,11 +84,12 @@ func TestCheckAlias_remoteNodeFailure(t *testing.T) {
 	chkID := structs.NewCheckID(types.CheckID("foo"), nil)
 	rpc := &mockRPC{}
 	chk := &CheckAlias{
-		Node:      "remote",
-		ServiceID: structs.ServiceID{ID: "web"},
-		CheckID:   chkID,
-		Notify:    notify,
-		RPC:       rpc,
+		Node:          "remote",
+		ServiceID:     structs.ServiceID{ID: "web"},
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
 	}
 
 	rpc.AddReply("Health.NodeChecks", structs.IndexedHealthChecks{
@@ -130,11 +134,12 @@ func TestCheckAlias_remotePassing(t *testing.T) {
 	chkID := structs.NewCheckID("foo", nil)
 	rpc := &mockRPC{}
 	chk := &CheckAlias{
-		Node:      "remote",
-		ServiceID: structs.ServiceID{ID: "web"},
-		CheckID:   chkID,
-		Notify:    notify,
-		RPC:       rpc,
+		Node:          "remote",
+		ServiceID:     structs.ServiceID{ID: "web"},
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
 	}
 
 	rpc.AddReply("Health.NodeChecks", structs.IndexedHealthChecks{
@@ -179,11 +184,12 @@ func TestCheckAlias_remotePassingWithoutChecksButWithService(t *testing.T) {
 	chkID := structs.NewCheckID("foo", nil)
 	rpc := &mockRPC{}
 	chk := &CheckAlias{
-		Node:      "remote",
-		ServiceID: structs.ServiceID{ID: "web"},
-		CheckID:   chkID,
-		Notify:    notify,
-		RPC:       rpc,
+		Node:          "remote",
+		ServiceID:     structs.ServiceID{ID: "web"},
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
 	}
 
 	rpc.AddReply("Health.NodeChecks", structs.IndexedHealthChecks{
@@ -236,11 +242,12 @@ func TestCheckAlias_remotePassingWithoutChecksAndWithoutService(t *testing.T) {
 	chkID := structs.NewCheckID("foo", nil)
 	rpc := &mockRPC{}
 	chk := &CheckAlias{
-		Node:      "remote",
-		ServiceID: structs.ServiceID{ID: "web"},
-		CheckID:   chkID,
-		Notify:    notify,
-		RPC:       rpc,
+		Node:          "remote",
+		ServiceID:     structs.ServiceID{ID: "web"},
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
 	}
 
 	rpc.AddReply("Health.NodeChecks", structs.IndexedHealthChecks{
@@ -289,11 +296,12 @@ func TestCheckAlias_remoteCritical(t *testing.T) {
 	chkID := structs.NewCheckID("foo", nil)
 	rpc := &mockRPC{}
 	chk := &CheckAlias{
-		Node:      "remote",
-		ServiceID: structs.ServiceID{ID: "web"},
-		CheckID:   chkID,
-		Notify:    notify,
-		RPC:       rpc,
+		Node:          "remote",
+		ServiceID:     structs.ServiceID{ID: "web"},
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
 	}
 
 	rpc.AddReply("Health.NodeChecks", structs.IndexedHealthChecks{
@@ -344,11 +352,12 @@ func TestCheckAlias_remoteWarning(t *testing.T) {
 	chkID := structs.NewCheckID("foo", nil)
 	rpc := &mockRPC{}
 	chk := &CheckAlias{
-		Node:      "remote",
-		ServiceID: structs.NewServiceID("web", nil),
-		CheckID:   chkID,
-		Notify:    notify,
-		RPC:       rpc,
+		Node:          "remote",
+		ServiceID:     structs.NewServiceID("web", nil),
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
 	}
 
 	rpc.AddReply("Health.NodeChecks", structs.IndexedHealthChecks{
@@ -399,10 +408,11 @@ func TestCheckAlias_remoteNodeOnlyPassing(t *testing.T) {
 	chkID := structs.NewCheckID(types.CheckID("foo"), nil)
 	rpc := &mockRPC{}
 	chk := &CheckAlias{
-		Node:    "remote",
-		CheckID: chkID,
-		Notify:  notify,
-		RPC:     rpc,
+		Node:          "remote",
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
 	}
 
 	rpc.AddReply("Health.NodeChecks", structs.IndexedHealthChecks{
@@ -446,10 +456,11 @@ func TestCheckAlias_remoteNodeOnlyCritical(t *testing.T) {
 	chkID := structs.NewCheckID(types.CheckID("foo"), nil)
 	rpc := &mockRPC{}
 	chk := &CheckAlias{
-		Node:    "remote",
-		CheckID: chkID,
-		Notify:  notify,
-		RPC:     rpc,
+		Node:          "remote",
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
 	}
 
 	rpc.AddReply("Health.NodeChecks", structs.IndexedHealthChecks{
@@ -485,6 +496,116 @@ func TestCheckAlias_remoteNodeOnlyCritical(t *testing.T) {
 	})
 }
 
+// A service alias is passing as soon as any instance of the aliased
+// service is passing, even if other instances are critical.
+func TestCheckAlias_serviceAnyPassing(t *testing.T) {
+	t.Parallel()
+
+	notify := newMockAliasNotify()
+	chkID := structs.NewCheckID(types.CheckID("foo"), nil)
+	rpc := &mockRPC{}
+	chk := &CheckAlias{
+		ServiceName:   "web",
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
+	}
+
+	rpc.AddReply("Health.ServiceNodes", structs.IndexedCheckServiceNodes{
+		Nodes: structs.CheckServiceNodes{
+			{
+				Node: &structs.Node{Node: "A"},
+				Checks: structs.HealthChecks{
+					{Node: "A", ServiceID: "web", Status: api.HealthCritical},
+				},
+			},
+			{
+				Node: &structs.Node{Node: "B"},
+				Checks: structs.HealthChecks{
+					{Node: "B", ServiceID: "web", Status: api.HealthPassing},
+				},
+			},
+		},
+	})
+
+	chk.Start()
+	defer chk.Stop()
+	retry.Run(t, func(r *retry.R) {
+		if got, want := notify.State(chkID), api.HealthPassing; got != want {
+			r.Fatalf("got state %q want %q", got, want)
+		}
+	})
+}
+
+// If no instance of the aliased service is passing, but at least one is
+// warning, the alias is warning.
+func TestCheckAlias_serviceWarning(t *testing.T) {
+	t.Parallel()
+
+	notify := newMockAliasNotify()
+	chkID := structs.NewCheckID(types.CheckID("foo"), nil)
+	rpc := &mockRPC{}
+	chk := &CheckAlias{
+		ServiceName:   "web",
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
+	}
+
+	rpc.AddReply("Health.ServiceNodes", structs.IndexedCheckServiceNodes{
+		Nodes: structs.CheckServiceNodes{
+			{
+				Node: &structs.Node{Node: "A"},
+				Checks: structs.HealthChecks{
+					{Node: "A", ServiceID: "web", Status: api.HealthCritical},
+				},
+			},
+			{
+				Node: &structs.Node{Node: "B"},
+				Checks: structs.HealthChecks{
+					{Node: "B", ServiceID: "web", Status: api.HealthWarning},
+				},
+			},
+		},
+	})
+
+	chk.Start()
+	defer chk.Stop()
+	retry.Run(t, func(r *retry.R) {
+		if got, want := notify.State(chkID), api.HealthWarning; got != want {
+			r.Fatalf("got state %q want %q", got, want)
+		}
+	})
+}
+
+// If the aliased service has no instances at all, the alias is critical.
+func TestCheckAlias_serviceNoInstances(t *testing.T) {
+	t.Parallel()
+
+	notify := newMockAliasNotify()
+	chkID := structs.NewCheckID(types.CheckID("foo"), nil)
+	rpc := &mockRPC{}
+	chk := &CheckAlias{
+		ServiceName:   "web",
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
+	}
+
+	rpc.AddReply("Health.ServiceNodes", structs.IndexedCheckServiceNodes{})
+
+	chk.Start()
+	defer chk.Stop()
+	retry.Run(t, func(r *retry.R) {
+		if got, want := notify.State(chkID), api.HealthCritical; got != want {
+			r.Fatalf("got state %q want %q", got, want)
+		}
+	})
+}
+
 type mockAliasNotify struct {
 	*mock.Notify
 }
@@ -570,10 +691,11 @@ func TestCheckAlias_localInitialStatus(t *testing.T) {
 	chkID := structs.NewCheckID(types.CheckID("foo"), nil)
 	rpc := &mockRPC{}
 	chk := &CheckAlias{
-		ServiceID: structs.ServiceID{ID: "web"},
-		CheckID:   chkID,
-		Notify:    notify,
-		RPC:       rpc,
+		ServiceID:     structs.ServiceID{ID: "web"},
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
 	}
 
 	chk.Start()
@@ -597,10 +719,11 @@ func TestCheckAlias_localInitialStatusShouldFailBecauseNoService(t *testing.T) {
 	chkID := structs.NewCheckID(types.CheckID("foo"), nil)
 	rpc := &mockRPC{}
 	chk := &CheckAlias{
-		ServiceID: structs.ServiceID{ID: "web"},
-		CheckID:   chkID,
-		Notify:    notify,
-		RPC:       rpc,
+		ServiceID:     structs.ServiceID{ID: "web"},
+		CheckID:       chkID,
+		Notify:        notify,
+		RPC:           rpc,
+		StatusHandler: NewStatusHandler(notify, testutil.Logger(t), 0, 0),
 	}
 
 	chk.Start()
@@ -18,10 +18,12 @@ import (
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/armon/circbuf"
+	"github.com/armon/go-metrics"
 	"github.com/hashicorp/consul/agent/exec"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/lib"
 	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/net/http2"
 )
 
 const (
@@ -105,7 +107,9 @@ func (c *CheckMonitor) run() {
 	for {
 		select {
 		case <-next:
+			start := time.Now()
 			c.check()
+			c.StatusHandler.ObserveLatency(start)
 			next = time.After(c.Interval)
 		case <-c.stopCh:
 			return
@@ -228,12 +232,14 @@ func (c *CheckMonitor) check() {
 // and enables clients to set the status of a check
 // but upon the TTL expiring, the check status is
 // automatically set to critical.
+// Supports failures_before_critical and success_before_passing.
 type CheckTTL struct {
-	Notify    CheckNotifier
-	CheckID   structs.CheckID
-	ServiceID structs.ServiceID
-	TTL       time.Duration
-	Logger    hclog.Logger
+	Notify        CheckNotifier
+	CheckID       structs.CheckID
+	ServiceID     structs.ServiceID
+	TTL           time.Duration
+	Logger        hclog.Logger
+	StatusHandler *StatusHandler
 
 	timer *time.Timer
 
@@ -313,7 +319,7 @@ func (c *CheckTTL) SetStatus(status, output string) string {
 		output = fmt.Sprintf("%s ... (captured %d of %d bytes)",
 			output[:c.OutputMaxSize], c.OutputMaxSize, total)
 	}
-	c.Notify.UpdateCheck(c.CheckID, status, output)
+	c.StatusHandler.updateCheck(c.CheckID, status, output)
 	// Store the last output so we can retain it if the TTL expires.
 	c.lastOutputLock.Lock()
 	c.lastOutput = output
@@ -331,20 +337,23 @@ func (c *CheckTTL) SetStatus(status, output string) string {
 // or if the request returns an error
 // Supports failures_before_critical and success_before_passing.
 type CheckHTTP struct {
-	CheckID         structs.CheckID
-	ServiceID       structs.ServiceID
-	HTTP            string
-	Header          map[string][]string
-	Method          string
-	Body            string
-	Interval        time.Duration
-	Timeout         time.Duration
-	Logger          hclog.Logger
-	TLSClientConfig *tls.Config
-	OutputMaxSize   int
-	StatusHandler   *StatusHandler
+	CheckID             structs.CheckID
+	ServiceID           structs.ServiceID
+	HTTP                string
+	Header              map[string][]string
+	Method              string
+	Body                string
+	HTTP2               bool
+	HTTPReuseConnection bool
+	Interval            time.Duration
+	Timeout             time.Duration
+	Logger              hclog.Logger
+	TLSClientConfig     *tls.Config
+	OutputMaxSize       int
+	StatusHandler       *StatusHandler
 
 	httpClient *http.Client
+	http2Trans *http2.Transport
 	stop       bool
 	stopCh     chan struct{}
 	stopLock   sync.Mutex
@@ -357,15 +366,17 @@ type CheckHTTP struct {
 
 func (c *CheckHTTP) CheckType() structs.CheckType {
 	return structs.CheckType{
-		CheckID:       c.CheckID.ID,
-		HTTP:          c.HTTP,
-		Method:        c.Method,
-		Body:          c.Body,
-		Header:        c.Header,
-		Interval:      c.Interval,
-		ProxyHTTP:     c.ProxyHTTP,
-		Timeout:       c.Timeout,
-		OutputMaxSize: c.OutputMaxSize,
+		CheckID:             c.CheckID.ID,
+		HTTP:                c.HTTP,
+		Method:              c.Method,
+		Body:                c.Body,
+		Header:              c.Header,
+		HTTP2:               c.HTTP2,
+		HTTPReuseConnection: c.HTTPReuseConnection,
+		Interval:            c.Interval,
+		ProxyHTTP:           c.ProxyHTTP,
+		Timeout:             c.Timeout,
+		OutputMaxSize:       c.OutputMaxSize,
 	}
 }
 
@@ -376,18 +387,35 @@ func (c *CheckHTTP) Start() {
 	defer c.stopLock.Unlock()
 
 	if c.httpClient == nil {
-		// Create the transport. We disable HTTP Keep-Alive's to prevent
-		// failing checks due to the keepalive interval.
-		trans := cleanhttp.DefaultTransport()
-		trans.DisableKeepAlives = true
-
-		// Take on the supplied TLS client config.
-		trans.TLSClientConfig = c.TLSClientConfig
+		var transport http.RoundTripper
+		if c.HTTP2 {
+			// http2.Transport speaks HTTP/2 over TLS, and h2c (HTTP/2
+			// without TLS) when AllowHTTP is set and DialTLS dials a plain
+			// TCP connection instead of negotiating TLS.
+			c.http2Trans = &http2.Transport{
+				TLSClientConfig: c.TLSClientConfig,
+				AllowHTTP:       true,
+				DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+					return net.Dial(network, addr)
+				},
+			}
+			transport = c.http2Trans
+		} else {
+			// Create the transport. We disable HTTP Keep-Alive's unless the
+			// check has opted into connection reuse, to prevent failing
+			// checks due to the keepalive interval.
+			trans := cleanhttp.DefaultTransport()
+			trans.DisableKeepAlives = !c.HTTPReuseConnection
+
+			// Take on the supplied TLS client config.
+			trans.TLSClientConfig = c.TLSClientConfig
+			transport = trans
+		}
 
 		// Create the HTTP client.
 		c.httpClient = &http.Client{
 			Timeout:   10 * time.Second,
-			Transport: trans,
+			Transport: transport,
 		}
 		if c.Timeout > 0 {
 			c.httpClient.Timeout = c.Timeout
@@ -426,7 +454,9 @@ func (c *CheckHTTP) run() {
 	for {
 		select {
 		case <-next:
+			start := time.Now()
 			c.check()
+			c.StatusHandler.ObserveLatency(start)
 			next = time.After(c.Interval)
 		case <-c.stopCh:
 			return
@@ -478,6 +508,12 @@ func (c *CheckHTTP) check() {
 	}
 	defer resp.Body.Close()
 
+	// http2.Transport pools connections regardless of HTTPReuseConnection,
+	// so close the idle connection after each check when reuse is disabled.
+	if c.http2Trans != nil && !c.HTTPReuseConnection {
+		defer c.http2Trans.CloseIdleConnections()
+	}
+
 	// Read the response into a circular buffer to limit the size
 	output, _ := circbuf.NewBuffer(int64(c.OutputMaxSize))
 	if _, err := io.Copy(output, resp.Body); err != nil {
@@ -564,7 +600,9 @@ func (c *CheckTCP) run() {
 	for {
 		select {
 		case <-next:
+			start := time.Now()
 			c.check()
+			c.StatusHandler.ObserveLatency(start)
 			next = time.After(c.Interval)
 		case <-c.stopCh:
 			return
@@ -587,22 +625,131 @@ func (c *CheckTCP) check() {
 	c.StatusHandler.updateCheck(c.CheckID, api.HealthPassing, fmt.Sprintf("TCP connect %s: Success", c.TCP))
 }
 
+// checkUDPPayload is written to the remote address to elicit a response
+// confirming that something is listening on the other end.
+const checkUDPPayload = "Consul Health Check"
+
+// CheckUDP is used to periodically send a payload to a given address over
+// UDP and wait for a response to determine the health of a given check.
+// The check is passing if a response is received before the timeout elapses.
+// The check is critical if the send fails or no response is received in time.
+// Supports failures_before_critical and success_before_passing.
+type CheckUDP struct {
+	CheckID       structs.CheckID
+	ServiceID     structs.ServiceID
+	UDP           string
+	Interval      time.Duration
+	Timeout       time.Duration
+	Logger        hclog.Logger
+	StatusHandler *StatusHandler
+
+	dialer   *net.Dialer
+	stop     bool
+	stopCh   chan struct{}
+	stopLock sync.Mutex
+}
+
+// Start is used to start a UDP check.
+// The check runs until stop is called
+func (c *CheckUDP) Start() {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+
+	if c.dialer == nil {
+		// Create the socket dialer
+		c.dialer = &net.Dialer{
+			Timeout: 10 * time.Second,
+		}
+		if c.Timeout > 0 {
+			c.dialer.Timeout = c.Timeout
+		}
+	}
+
+	c.stop = false
+	c.stopCh = make(chan struct{})
+	go c.run()
+}
+
+// Stop is used to stop a UDP check.
+func (c *CheckUDP) Stop() {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+	if !c.stop {
+		c.stop = true
+		close(c.stopCh)
+	}
+}
+
+// run is invoked by a goroutine to run until Stop() is called
+func (c *CheckUDP) run() {
+	// Get the randomized initial pause time
+	initialPauseTime := lib.RandomStagger(c.Interval)
+	next := time.After(initialPauseTime)
+	for {
+		select {
+		case <-next:
+			start := time.Now()
+			c.check()
+			c.StatusHandler.ObserveLatency(start)
+			next = time.After(c.Interval)
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
+// check is invoked periodically to perform the UDP check
+func (c *CheckUDP) check() {
+	conn, err := c.dialer.Dial(`udp`, c.UDP)
+	if err != nil {
+		c.Logger.Warn("Check socket connection failed",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(c.dialer.Timeout))
+	if _, err := conn.Write([]byte(checkUDPPayload)); err != nil {
+		c.Logger.Warn("Check socket write failed",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+
+	buf := make([]byte, 1)
+	if _, err := conn.Read(buf); err != nil {
+		c.Logger.Warn("Check socket read failed",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+	c.StatusHandler.updateCheck(c.CheckID, api.HealthPassing, fmt.Sprintf("UDP connect %s: Success", c.UDP))
+}
+
 // CheckDocker is used to periodically invoke a script to
 // determine the health of an application running inside a
 // Docker Container. We assume that the script is compatible
 // with nagios plugins and expects the output in the same format.
 // Supports failures_before_critical and success_before_passing.
 type CheckDocker struct {
-	CheckID           structs.CheckID
-	ServiceID         structs.ServiceID
-	Script            string
-	ScriptArgs        []string
-	DockerContainerID string
-	Shell             string
-	Interval          time.Duration
-	Logger            hclog.Logger
-	Client            *DockerClient
-	StatusHandler     *StatusHandler
+	CheckID              structs.CheckID
+	ServiceID            structs.ServiceID
+	Script               string
+	ScriptArgs           []string
+	DockerContainerID    string
+	DockerContainerLabel string
+	Shell                string
+	Interval             time.Duration
+	Logger               hclog.Logger
+	Client               *DockerClient
+	StatusHandler        *StatusHandler
 
 	stop chan struct{}
 }
@@ -640,7 +787,9 @@ func (c *CheckDocker) run() {
 	for {
 		select {
 		case <-next:
+			start := time.Now()
 			c.check()
+			c.StatusHandler.ObserveLatency(start)
 			next = time.After(c.Interval)
 		case <-c.stop:
 			return
@@ -681,17 +830,26 @@ func (c *CheckDocker) doCheck() (string, *circbuf.Buffer, error) {
 		cmd = []string{c.Shell, "-c", c.Script}
 	}
 
-	execID, err := c.Client.CreateExec(c.DockerContainerID, cmd)
+	containerID := c.DockerContainerID
+	if c.DockerContainerLabel != "" {
+		id, err := c.Client.ContainerIDForLabel(c.DockerContainerLabel)
+		if err != nil {
+			return api.HealthCritical, nil, err
+		}
+		containerID = id
+	}
+
+	execID, err := c.Client.CreateExec(containerID, cmd)
 	if err != nil {
 		return api.HealthCritical, nil, err
 	}
 
-	buf, err := c.Client.StartExec(c.DockerContainerID, execID)
+	buf, err := c.Client.StartExec(containerID, execID)
 	if err != nil {
 		return api.HealthCritical, nil, err
 	}
 
-	exitCode, err := c.Client.InspectExec(c.DockerContainerID, execID)
+	exitCode, err := c.Client.InspectExec(containerID, execID)
 	if err != nil {
 		return api.HealthCritical, nil, err
 	}
@@ -770,7 +928,9 @@ func (c *CheckGRPC) run() {
 	for {
 		select {
 		case <-next:
+			start := time.Now()
 			c.check()
+			c.StatusHandler.ObserveLatency(start)
 			next = time.After(c.Interval)
 		case <-c.stopCh:
 			return
@@ -803,7 +963,10 @@ func (c *CheckGRPC) Stop() {
 
 // StatusHandler keep tracks of successive error/success counts and ensures
 // that status can be set to critical/passing only once the successive number of event
-// reaches the given threshold.
+// reaches the given threshold. While the threshold hasn't been reached, the
+// check's last stable status is republished with the consecutive count
+// appended to its output, so a flapping check is visible to operators without
+// its status actually thrashing in the catalog.
 type StatusHandler struct {
 	inner                  CheckNotifier
 	logger                 hclog.Logger
@@ -811,6 +974,11 @@ type StatusHandler struct {
 	successCounter         int
 	failuresBeforeCritical int
 	failuresCounter        int
+	lastStatus             string
+
+	metricsEnabled bool
+	checkName      string
+	serviceName    string
 }
 
 // NewStatusHandler set counters values to threshold in order to immediatly update status after first check.
@@ -825,16 +993,65 @@ func NewStatusHandler(inner CheckNotifier, logger hclog.Logger, successBeforePas
 	}
 }
 
+// EnableMetrics turns on emission of per-check and per-service metrics for
+// check latency, state transitions, and consecutive failures, labeled with
+// the given check and service names. cardinality, if non-nil, bounds the
+// number of distinct service names used as labels to avoid unbounded metric
+// cardinality on agents managing many differently-named services.
+func (s *StatusHandler) EnableMetrics(checkName, serviceName string, cardinality *MetricsCardinality) {
+	s.metricsEnabled = true
+	s.checkName = checkName
+	s.serviceName = cardinality.Label(serviceName)
+}
+
+// metricLabels returns the labels to use for this check's metrics, or nil
+// if metrics emission is not enabled.
+func (s *StatusHandler) metricLabels() []metrics.Label {
+	if !s.metricsEnabled {
+		return nil
+	}
+	return []metrics.Label{
+		{Name: "check", Value: s.checkName},
+		{Name: "service", Value: s.serviceName},
+	}
+}
+
+// ObserveLatency emits the check-latency metric for the time elapsed since
+// start, if metrics emission is enabled.
+func (s *StatusHandler) ObserveLatency(start time.Time) {
+	if labels := s.metricLabels(); labels != nil {
+		metrics.MeasureSinceWithLabels([]string{"check", "latency"}, start, labels)
+	}
+}
+
+func (s *StatusHandler) emitConsecutiveFailures() {
+	if labels := s.metricLabels(); labels != nil {
+		metrics.SetGaugeWithLabels([]string{"check", "consecutive_failures"}, float32(s.failuresCounter), labels)
+	}
+}
+
+func (s *StatusHandler) emitStateTransition(status string) {
+	labels := s.metricLabels()
+	if labels == nil || status == s.lastStatus {
+		return
+	}
+	metrics.IncrCounterWithLabels([]string{"check", "state_transition"}, 1,
+		append(labels, metrics.Label{Name: "status", Value: status}))
+}
+
 func (s *StatusHandler) updateCheck(checkID structs.CheckID, status, output string) {
 
 	if status == api.HealthPassing || status == api.HealthWarning {
 		s.successCounter++
 		s.failuresCounter = 0
+		s.emitConsecutiveFailures()
 		if s.successCounter >= s.successBeforePassing {
 			s.logger.Debug("Check status updated",
 				"check", checkID.String(),
 				"status", status,
 			)
+			s.emitStateTransition(status)
+			s.lastStatus = status
 			s.inner.UpdateCheck(checkID, status, output)
 			return
 		}
@@ -844,11 +1061,16 @@ func (s *StatusHandler) updateCheck(checkID structs.CheckID, status, output stri
 			"success_count", s.successCounter,
 			"success_threshold", s.successBeforePassing,
 		)
+		s.inner.UpdateCheck(checkID, s.lastStatus, fmt.Sprintf(
+			"%s (%d/%d consecutive successes)", output, s.successCounter, s.successBeforePassing))
 	} else {
 		s.failuresCounter++
 		s.successCounter = 0
+		s.emitConsecutiveFailures()
 		if s.failuresCounter >= s.failuresBeforeCritical {
 			s.logger.Warn("Check is now critical", "check", checkID.String())
+			s.emitStateTransition(status)
+			s.lastStatus = status
 			s.inner.UpdateCheck(checkID, status, output)
 			return
 		}
@@ -858,5 +1080,7 @@ func (s *StatusHandler) updateCheck(checkID structs.CheckID, status, output stri
 			"failure_count", s.failuresCounter,
 			"failure_threshold", s.failuresBeforeCritical,
 		)
+		s.inner.UpdateCheck(checkID, s.lastStatus, fmt.Sprintf(
+			"%s (%d/%d consecutive failures)", output, s.failuresCounter, s.failuresBeforeCritical))
 	}
 }
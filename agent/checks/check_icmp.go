@@ -0,0 +1,165 @@
+package checks
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/lib"
+)
+
+// CheckICMP is used to periodically send an ICMP echo request to a given
+// address to determine the health of a given check.
+// The check is passing if an echo reply is received before the timeout elapses.
+// The check is critical if the echo reply is not received in time, or the
+// request could not be sent.
+// Supports failures_before_critical and success_before_passing.
+//
+// This uses the unprivileged, datagram-oriented ICMP endpoints provided by
+// golang.org/x/net/icmp rather than a raw socket, so it does not require
+// the agent to run with elevated privileges.
+type CheckICMP struct {
+	CheckID       structs.CheckID
+	ServiceID     structs.ServiceID
+	ICMP          string
+	Interval      time.Duration
+	Timeout       time.Duration
+	Logger        hclog.Logger
+	StatusHandler *StatusHandler
+
+	stop     bool
+	stopCh   chan struct{}
+	stopLock sync.Mutex
+}
+
+// Start is used to start an ICMP check.
+// The check runs until stop is called
+func (c *CheckICMP) Start() {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+	c.stop = false
+	c.stopCh = make(chan struct{})
+	go c.run()
+}
+
+// Stop is used to stop an ICMP check.
+func (c *CheckICMP) Stop() {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+	if !c.stop {
+		c.stop = true
+		close(c.stopCh)
+	}
+}
+
+// run is invoked by a goroutine to run until Stop() is called
+func (c *CheckICMP) run() {
+	// Get the randomized initial pause time
+	initialPauseTime := lib.RandomStagger(c.Interval)
+	next := time.After(initialPauseTime)
+	for {
+		select {
+		case <-next:
+			start := time.Now()
+			c.check()
+			c.StatusHandler.ObserveLatency(start)
+			next = time.After(c.Interval)
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
+// check is invoked periodically to perform the ICMP check
+func (c *CheckICMP) check() {
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	conn, err := icmp.ListenPacket("udp4", "")
+	if err != nil {
+		c.Logger.Warn("Check socket listen failed",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+	defer conn.Close()
+
+	dst, err := net.ResolveIPAddr("ip4", c.ICMP)
+	if err != nil {
+		c.Logger.Warn("Check address resolution failed",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+
+	msg := icmp.Message{
+		Type: ipv4.ICMPTypeEcho,
+		Code: 0,
+		Body: &icmp.Echo{
+			ID:   os.Getpid() & 0xffff,
+			Seq:  1,
+			Data: []byte("Consul Health Check"),
+		},
+	}
+	wb, err := msg.Marshal(nil)
+	if err != nil {
+		c.Logger.Warn("Check message encode failed",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+
+	if _, err := conn.WriteTo(wb, &net.UDPAddr{IP: dst.IP}); err != nil {
+		c.Logger.Warn("Check echo request failed",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+
+	conn.SetReadDeadline(time.Now().Add(timeout))
+	rb := make([]byte, 1500)
+	n, _, err := conn.ReadFrom(rb)
+	if err != nil {
+		c.Logger.Warn("Check echo reply not received",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+
+	rm, err := icmp.ParseMessage(1, rb[:n])
+	if err != nil {
+		c.Logger.Warn("Check echo reply decode failed",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+	if rm.Type != ipv4.ICMPTypeEchoReply {
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical,
+			fmt.Sprintf("ICMP ping %s: unexpected reply type %v", c.ICMP, rm.Type))
+		return
+	}
+
+	c.StatusHandler.updateCheck(c.CheckID, api.HealthPassing, fmt.Sprintf("ICMP ping %s: Success", c.ICMP))
+}
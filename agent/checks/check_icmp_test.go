@@ -0,0 +1,69 @@
+package checks
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/icmp"
+
+	"github.com/hashicorp/consul/agent/mock"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+)
+
+func expectICMPStatus(t *testing.T, target string, status string) {
+	notif := mock.NewNotify()
+	logger := testutil.Logger(t)
+	statusHandler := NewStatusHandler(notif, logger, 0, 0)
+	cid := structs.NewCheckID("foo", nil)
+
+	check := &CheckICMP{
+		CheckID:       cid,
+		ICMP:          target,
+		Interval:      10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+		Logger:        logger,
+		StatusHandler: statusHandler,
+	}
+	check.Start()
+	defer check.Stop()
+	retry.Run(t, func(r *retry.R) {
+		if got, want := notif.Updates(cid), 2; got < want {
+			r.Fatalf("got %d updates want at least %d", got, want)
+		}
+		if got, want := notif.State(cid), status; got != want {
+			r.Fatalf("got state %q want %q", got, want)
+		}
+	})
+}
+
+func TestCheckICMPPassing(t *testing.T) {
+	t.Parallel()
+
+	// Unprivileged ICMP sockets aren't available in every environment (they
+	// require CAP_NET_RAW or a configured ping_group_range), so skip rather
+	// than fail when the platform won't allow it.
+	conn, err := icmp.ListenPacket("udp4", "")
+	if err != nil {
+		t.Skipf("unprivileged ICMP sockets not available: %s", err)
+	}
+	conn.Close()
+
+	expectICMPStatus(t, "127.0.0.1", api.HealthPassing)
+}
+
+func TestCheckICMPCritical(t *testing.T) {
+	t.Parallel()
+
+	conn, err := icmp.ListenPacket("udp4", "")
+	if err != nil {
+		t.Skipf("unprivileged ICMP sockets not available: %s", err)
+	}
+	conn.Close()
+
+	// TEST-NET-1 (RFC 5737) is reserved for documentation and should not
+	// respond to an echo request.
+	expectICMPStatus(t, "192.0.2.1", api.HealthCritical)
+}
@@ -13,6 +13,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/armon/go-metrics"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
 	"github.com/hashicorp/consul/agent/mock"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
@@ -208,10 +212,11 @@ func TestCheckTTL(t *testing.T) {
 	cid := structs.NewCheckID("foo", nil)
 
 	check := &CheckTTL{
-		Notify:  notif,
-		CheckID: cid,
-		TTL:     200 * time.Millisecond,
-		Logger:  logger,
+		Notify:        notif,
+		CheckID:       cid,
+		TTL:           200 * time.Millisecond,
+		Logger:        logger,
+		StatusHandler: NewStatusHandler(notif, logger, 0, 0),
 	}
 	check.Start()
 	defer check.Stop()
@@ -361,6 +366,44 @@ func TestCheckHTTP(t *testing.T) {
 	}
 }
 
+func TestCheckHTTP_H2C(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 {
+			w.WriteHeader(999)
+			return
+		}
+		w.WriteHeader(200)
+	}), &http2.Server{}))
+	defer server.Close()
+
+	notif := mock.NewNotify()
+	logger := testutil.Logger(t)
+	statusHandler := NewStatusHandler(notif, logger, 0, 0)
+	cid := structs.NewCheckID("foo", nil)
+
+	check := &CheckHTTP{
+		CheckID:       cid,
+		HTTP:          server.URL,
+		HTTP2:         true,
+		Interval:      10 * time.Millisecond,
+		Logger:        logger,
+		StatusHandler: statusHandler,
+	}
+	check.Start()
+	defer check.Stop()
+
+	retry.Run(t, func(r *retry.R) {
+		if got, want := notif.Updates(cid), 2; got < want {
+			r.Fatalf("got %d updates want at least %d", got, want)
+		}
+		if got, want := notif.State(cid), api.HealthPassing; got != want {
+			r.Fatalf("got state %q want %q", got, want)
+		}
+	})
+}
+
 func TestCheckHTTP_Proxied(t *testing.T) {
 	t.Parallel()
 
@@ -822,34 +865,39 @@ func TestStatusHandlerUpdateStatusAfterConsecutiveChecksThresholdIsReached(t *te
 	// Set the initial status to passing after a single success
 	statusHandler.updateCheck(cid, api.HealthPassing, "bar")
 
-	// Status should become critical after 3 failed checks only
+	// Status stays passing while under the failure threshold, but the
+	// republished output keeps the consecutive failure count visible.
 	statusHandler.updateCheck(cid, api.HealthCritical, "bar")
 	statusHandler.updateCheck(cid, api.HealthCritical, "bar")
 
 	retry.Run(t, func(r *retry.R) {
-		require.Equal(r, 1, notif.Updates(cid))
+		require.Equal(r, 3, notif.Updates(cid))
 		require.Equal(r, api.HealthPassing, notif.State(cid))
+		require.Contains(r, notif.Output(cid), "2/3 consecutive failures")
 	})
 
+	// Status should become critical after 3 failed checks only
 	statusHandler.updateCheck(cid, api.HealthCritical, "bar")
 
 	retry.Run(t, func(r *retry.R) {
-		require.Equal(r, 2, notif.Updates(cid))
+		require.Equal(r, 4, notif.Updates(cid))
 		require.Equal(r, api.HealthCritical, notif.State(cid))
 	})
 
-	// Status should be passing after 2 passing check
+	// Status should remain critical after a single passing check
 	statusHandler.updateCheck(cid, api.HealthPassing, "bar")
 
 	retry.Run(t, func(r *retry.R) {
-		require.Equal(r, 2, notif.Updates(cid))
+		require.Equal(r, 5, notif.Updates(cid))
 		require.Equal(r, api.HealthCritical, notif.State(cid))
+		require.Contains(r, notif.Output(cid), "1/2 consecutive successes")
 	})
 
+	// Status should be passing after 2 passing check
 	statusHandler.updateCheck(cid, api.HealthPassing, "bar")
 
 	retry.Run(t, func(r *retry.R) {
-		require.Equal(r, 3, notif.Updates(cid))
+		require.Equal(r, 6, notif.Updates(cid))
 		require.Equal(r, api.HealthPassing, notif.State(cid))
 	})
 }
@@ -873,7 +921,7 @@ func TestStatusHandlerResetCountersOnNonIdenticalsConsecutiveChecks(t *testing.T
 	statusHandler.updateCheck(cid, api.HealthCritical, "bar")
 
 	retry.Run(t, func(r *retry.R) {
-		require.Equal(r, 1, notif.Updates(cid))
+		require.Equal(r, 5, notif.Updates(cid))
 		require.Equal(r, api.HealthPassing, notif.State(cid))
 	})
 
@@ -881,7 +929,7 @@ func TestStatusHandlerResetCountersOnNonIdenticalsConsecutiveChecks(t *testing.T
 	statusHandler.updateCheck(cid, api.HealthCritical, "bar")
 
 	retry.Run(t, func(r *retry.R) {
-		require.Equal(r, 2, notif.Updates(cid))
+		require.Equal(r, 6, notif.Updates(cid))
 		require.Equal(r, api.HealthCritical, notif.State(cid))
 	})
 
@@ -891,7 +939,7 @@ func TestStatusHandlerResetCountersOnNonIdenticalsConsecutiveChecks(t *testing.T
 	statusHandler.updateCheck(cid, api.HealthPassing, "bar")
 
 	retry.Run(t, func(r *retry.R) {
-		require.Equal(r, 2, notif.Updates(cid))
+		require.Equal(r, 9, notif.Updates(cid))
 		require.Equal(r, api.HealthCritical, notif.State(cid))
 	})
 
@@ -899,11 +947,39 @@ func TestStatusHandlerResetCountersOnNonIdenticalsConsecutiveChecks(t *testing.T
 	statusHandler.updateCheck(cid, api.HealthPassing, "bar")
 
 	retry.Run(t, func(r *retry.R) {
-		require.Equal(r, 3, notif.Updates(cid))
+		require.Equal(r, 10, notif.Updates(cid))
 		require.Equal(r, api.HealthPassing, notif.State(cid))
 	})
 }
 
+func TestStatusHandlerMetricsDisabledByDefault(t *testing.T) {
+	t.Parallel()
+	statusHandler := NewStatusHandler(mock.NewNotify(), testutil.Logger(t), 0, 0)
+	if labels := statusHandler.metricLabels(); labels != nil {
+		t.Fatalf("got labels %v want nil", labels)
+	}
+}
+
+func TestStatusHandlerEnableMetrics(t *testing.T) {
+	t.Parallel()
+	cid := structs.NewCheckID("foo", nil)
+	notif := mock.NewNotify()
+	statusHandler := NewStatusHandler(notif, testutil.Logger(t), 0, 0)
+	statusHandler.EnableMetrics("foo", "web", NewMetricsCardinality(1))
+
+	labels := statusHandler.metricLabels()
+	require.ElementsMatch(t, []metrics.Label{
+		{Name: "check", Value: "foo"},
+		{Name: "service", Value: "web"},
+	}, labels)
+
+	// Exercised for their side effects: none of these should panic once
+	// metrics emission is enabled.
+	statusHandler.ObserveLatency(time.Now())
+	statusHandler.updateCheck(cid, api.HealthPassing, "bar")
+	statusHandler.updateCheck(cid, api.HealthCritical, "bar")
+}
+
 func TestCheckTCPCritical(t *testing.T) {
 	t.Parallel()
 	var (
@@ -936,6 +1012,62 @@ func TestCheckTCPPassing(t *testing.T) {
 	tcpServer.Close()
 }
 
+func mockUDPServer(t *testing.T) *net.UDPConn {
+	conn, err := net.ListenUDP(`udp`, &net.UDPAddr{IP: net.ParseIP("127.0.0.1")})
+	require.NoError(t, err)
+
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, addr, err := conn.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			conn.WriteToUDP(buf[:n], addr)
+		}
+	}()
+
+	return conn
+}
+
+func expectUDPStatus(t *testing.T, udp string, status string) {
+	notif := mock.NewNotify()
+	logger := testutil.Logger(t)
+	statusHandler := NewStatusHandler(notif, logger, 0, 0)
+	cid := structs.NewCheckID("foo", nil)
+
+	check := &CheckUDP{
+		CheckID:       cid,
+		UDP:           udp,
+		Interval:      10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+		Logger:        logger,
+		StatusHandler: statusHandler,
+	}
+	check.Start()
+	defer check.Stop()
+	retry.Run(t, func(r *retry.R) {
+		if got, want := notif.Updates(cid), 2; got < want {
+			r.Fatalf("got %d updates want at least %d", got, want)
+		}
+		if got, want := notif.State(cid), status; got != want {
+			r.Fatalf("got state %q want %q", got, want)
+		}
+	})
+}
+
+func TestCheckUDPCritical(t *testing.T) {
+	t.Parallel()
+	expectUDPStatus(t, `127.0.0.1:0`, api.HealthCritical)
+}
+
+func TestCheckUDPPassing(t *testing.T) {
+	t.Parallel()
+	udpServer := mockUDPServer(t)
+	defer udpServer.Close()
+	expectUDPStatus(t, udpServer.LocalAddr().String(), api.HealthPassing)
+}
+
 func TestCheck_Docker(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -1224,3 +1356,74 @@ func TestCheck_Docker(t *testing.T) {
 		})
 	}
 }
+
+// TestCheck_Docker_Label verifies that a check configured with
+// DockerContainerLabel resolves the current container ID from the Docker
+// API on every check, rather than relying on a fixed container ID that
+// would go stale across restarts.
+func TestCheck_Docker_Label(t *testing.T) {
+	const label = "consul.check=web"
+	containerID := "123"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/containers/json"):
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `[{"Id":%q}]`, containerID)
+		case r.Method == "POST" && r.URL.Path == fmt.Sprintf("/containers/%s/exec", containerID):
+			w.WriteHeader(201)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"Id":"456"}`)
+		case r.Method == "POST" && r.URL.Path == "/exec/456/start":
+			w.WriteHeader(200)
+			fmt.Fprint(w, "OK")
+		case r.Method == "GET" && r.URL.Path == "/exec/456/json":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"ExitCode":0}`)
+		default:
+			t.Fatalf("bad url %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewDockerClient(srv.URL, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notif, upd := mock.NewNotifyChan()
+	logger := testutil.Logger(t)
+	statusHandler := NewStatusHandler(notif, logger, 0, 0)
+	id := structs.NewCheckID("chk", nil)
+
+	check := &CheckDocker{
+		CheckID:              id,
+		ScriptArgs:           []string{"/health.sh"},
+		DockerContainerLabel: label,
+		Interval:             25 * time.Millisecond,
+		Client:               c,
+		StatusHandler:        statusHandler,
+	}
+	check.Start()
+	defer check.Stop()
+
+	<-upd // wait for update
+
+	if got, want := notif.Output(id), "OK"; got != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+	if got, want := notif.State(id), api.HealthPassing; got != want {
+		t.Fatalf("got status %q want %q", got, want)
+	}
+
+	// now the container restarts with a new ID; the next check should
+	// resolve the new ID from the label rather than fail.
+	containerID = "789"
+	<-upd
+
+	if got, want := notif.State(id), api.HealthPassing; got != want {
+		t.Fatalf("got status %q want %q", got, want)
+	}
+}
@@ -124,6 +124,35 @@ func (c *DockerClient) call(method, uri string, v interface{}) (*circbuf.Buffer,
 	return b, resp.StatusCode, err
 }
 
+// ContainerIDForLabel returns the ID of the running container carrying the
+// given label (in "key=value" form). Resolving the container by label rather
+// than a fixed ID lets the check keep working across container restarts and
+// redeployments, where the ID changes but the label does not.
+func (c *DockerClient) ContainerIDForLabel(label string) (string, error) {
+	filters, err := json.Marshal(map[string][]string{"label": {label}})
+	if err != nil {
+		return "", err
+	}
+
+	uri := fmt.Sprintf("/containers/json?filters=%s", url.QueryEscape(string(filters)))
+	b, code, err := c.call("GET", uri, nil)
+	switch {
+	case err != nil:
+		return "", fmt.Errorf("list containers failed for label %s: %v", label, err)
+	case code == 200:
+		var resp []struct{ Id string }
+		if err := json.NewDecoder(bytes.NewReader(b.Bytes())).Decode(&resp); err != nil {
+			return "", fmt.Errorf("list containers response for label %s cannot be parsed: %s", label, err)
+		}
+		if len(resp) == 0 {
+			return "", fmt.Errorf("no running container found for label %s", label)
+		}
+		return resp[0].Id, nil
+	default:
+		return "", fmt.Errorf("list containers failed for label %s with status %d: %s", label, code, b)
+	}
+}
+
 func (c *DockerClient) CreateExec(containerID string, cmd []string) (string, error) {
 	data := struct {
 		AttachStdin  bool
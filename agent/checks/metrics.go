@@ -0,0 +1,74 @@
+package checks
+
+import "sync"
+
+// MetricsCardinality bounds the number of distinct names (or name pairs, via
+// LabelPair) used as metric labels. Agents can manage checks, services, and
+// intentions for many differently-named entities, so labeling metrics by
+// name unconditionally would give each one its own unbounded set of metric
+// series. Once the cap is reached, new names are labeled with a shared
+// "other" name instead of their own so series stay bounded.
+type MetricsCardinality struct {
+	max int
+
+	mu   sync.Mutex
+	seen map[string]struct{}
+}
+
+// NewMetricsCardinality creates a cardinality tracker that allows up to max
+// distinct service names as metric labels.
+func NewMetricsCardinality(max int) *MetricsCardinality {
+	return &MetricsCardinality{
+		max:  max,
+		seen: make(map[string]struct{}),
+	}
+}
+
+// Label returns serviceName if it is within the cardinality cap, or "other"
+// if the cap has already been reached by distinct names. A nil receiver or
+// an empty serviceName are returned unchanged.
+func (c *MetricsCardinality) Label(serviceName string) string {
+	if c == nil || serviceName == "" {
+		return serviceName
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.label(serviceName)
+}
+
+// LabelPair is like Label, but treats (first, second) as a single unit
+// against the cardinality cap: either both are returned unchanged, or both
+// are returned as "other". Use this when a name on its own isn't unique
+// enough to bound cardinality, such as a source/destination service pair.
+func (c *MetricsCardinality) LabelPair(first, second string) (string, string) {
+	if c == nil {
+		return first, second
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := first + "\x00" + second
+	if c.label(key) == "other" {
+		return "other", "other"
+	}
+	return first, second
+}
+
+// label implements the cardinality cap for Label and LabelPair. Callers
+// must hold c.mu.
+func (c *MetricsCardinality) label(name string) string {
+	if name == "" {
+		return name
+	}
+	if _, ok := c.seen[name]; ok {
+		return name
+	}
+	if len(c.seen) >= c.max {
+		return "other"
+	}
+	c.seen[name] = struct{}{}
+	return name
+}
@@ -0,0 +1,66 @@
+package checks
+
+import "testing"
+
+func TestMetricsCardinality(t *testing.T) {
+	c := NewMetricsCardinality(2)
+
+	if got, want := c.Label("web"), "web"; got != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+	if got, want := c.Label("db"), "db"; got != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+	// A service seen before the cap was reached keeps its own label.
+	if got, want := c.Label("web"), "web"; got != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+	// The cap has been reached, so a new name collapses to "other".
+	if got, want := c.Label("cache"), "other"; got != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+}
+
+func TestMetricsCardinality_emptyName(t *testing.T) {
+	c := NewMetricsCardinality(1)
+	if got, want := c.Label(""), ""; got != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+}
+
+func TestMetricsCardinality_nilReceiver(t *testing.T) {
+	var c *MetricsCardinality
+	if got, want := c.Label("web"), "web"; got != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+	gotFirst, gotSecond := c.LabelPair("web", "db")
+	if gotFirst != "web" || gotSecond != "db" {
+		t.Fatalf("got (%q, %q) want (%q, %q)", gotFirst, gotSecond, "web", "db")
+	}
+}
+
+func TestMetricsCardinality_labelPair(t *testing.T) {
+	c := NewMetricsCardinality(2)
+
+	first, second := c.LabelPair("web", "db")
+	if first != "web" || second != "db" {
+		t.Fatalf("got (%q, %q) want (%q, %q)", first, second, "web", "db")
+	}
+
+	// The same pair seen again before the cap is reached keeps its own labels.
+	first, second = c.LabelPair("web", "db")
+	if first != "web" || second != "db" {
+		t.Fatalf("got (%q, %q) want (%q, %q)", first, second, "web", "db")
+	}
+
+	first, second = c.LabelPair("api", "cache")
+	if first != "api" || second != "cache" {
+		t.Fatalf("got (%q, %q) want (%q, %q)", first, second, "api", "cache")
+	}
+
+	// The cap has been reached, so a new pair collapses to ("other", "other").
+	first, second = c.LabelPair("web", "cache")
+	if first != "other" || second != "other" {
+		t.Fatalf("got (%q, %q) want (\"other\", \"other\")", first, second)
+	}
+}
@@ -74,9 +74,9 @@ func Load(opts BuilderOpts, extraHead Source, overrides ...Source) (*RuntimeConf
 //
 // The sources are merged in the following order:
 //
-//  * default configuration
-//  * config files in alphabetical order
-//  * command line arguments
+//   - default configuration
+//   - config files in alphabetical order
+//   - command line arguments
 //
 // The config sources are merged sequentially and later values
 // overwrite previously set values. Slice values are merged by
@@ -395,18 +395,28 @@ func (b *Builder) Build() (rt RuntimeConfig, err error) {
 		services = append(services, b.serviceVal(c.Service))
 	}
 
+	eventPayloadSchemas := make(map[string][]string)
+	for _, schema := range c.EventPayloadSchemas {
+		name := b.stringVal(schema.Name)
+		if name == "" {
+			b.err = multierror.Append(b.err, fmt.Errorf("event_payload_schemas: name cannot be blank"))
+			continue
+		}
+		eventPayloadSchemas[name] = schema.RequiredFields
+	}
+
 	// ----------------------------------------------------------------
 	// addresses
 	//
 
 	// determine port values and replace values <= 0 and > 65535 with -1
-	dnsPort := b.portVal("ports.dns", c.Ports.DNS)
-	httpPort := b.portVal("ports.http", c.Ports.HTTP)
-	httpsPort := b.portVal("ports.https", c.Ports.HTTPS)
-	serverPort := b.portVal("ports.server", c.Ports.Server)
-	grpcPort := b.portVal("ports.grpc", c.Ports.GRPC)
-	serfPortLAN := b.portVal("ports.serf_lan", c.Ports.SerfLAN)
-	serfPortWAN := b.portVal("ports.serf_wan", c.Ports.SerfWAN)
+	dnsPort := b.offsetPort(c.PortOffset, "ports.dns", b.portVal("ports.dns", c.Ports.DNS))
+	httpPort := b.offsetPort(c.PortOffset, "ports.http", b.portVal("ports.http", c.Ports.HTTP))
+	httpsPort := b.offsetPort(c.PortOffset, "ports.https", b.portVal("ports.https", c.Ports.HTTPS))
+	serverPort := b.offsetPort(c.PortOffset, "ports.server", b.portVal("ports.server", c.Ports.Server))
+	grpcPort := b.offsetPort(c.PortOffset, "ports.grpc", b.portVal("ports.grpc", c.Ports.GRPC))
+	serfPortLAN := b.offsetPort(c.PortOffset, "ports.serf_lan", b.portVal("ports.serf_lan", c.Ports.SerfLAN))
+	serfPortWAN := b.offsetPort(c.PortOffset, "ports.serf_wan", b.portVal("ports.serf_wan", c.Ports.SerfWAN))
 	proxyMinPort := b.portVal("ports.proxy_min_port", c.Ports.ProxyMinPort)
 	proxyMaxPort := b.portVal("ports.proxy_max_port", c.Ports.ProxyMaxPort)
 	sidecarMinPort := b.portVal("ports.sidecar_min_port", c.Ports.SidecarMinPort)
@@ -577,8 +587,49 @@ func (b *Builder) Build() (rt RuntimeConfig, err error) {
 		}
 	}
 
+	dnsRecursorRules := map[string][]string{}
+	for _, rule := range c.DNS.RecursorRules {
+		domain := strings.ToLower(b.stringVal(rule.Domain))
+		if domain == "" {
+			b.err = multierror.Append(b.err, fmt.Errorf("dns_config.recursor_rules: domain cannot be blank"))
+			continue
+		}
+		var recursors []string
+		for _, r := range rule.Recursors {
+			if strings.HasPrefix(r, "unix://") {
+				return RuntimeConfig{}, fmt.Errorf("DNS Recursors cannot be unix sockets: %s", r)
+			}
+			recursors = append(recursors, r)
+		}
+		dnsRecursorRules[domain] = recursors
+	}
+
+	var translateAddressRules []TranslateAddressRule
+	for _, rule := range c.TranslateAddressRules {
+		cidrStr := strings.TrimSpace(b.stringVal(rule.SourceCIDR))
+		if cidrStr == "" {
+			b.err = multierror.Append(b.err, fmt.Errorf("translate_address_rules: source_cidr cannot be blank"))
+			continue
+		}
+		_, cidr, err := net.ParseCIDR(cidrStr)
+		if err != nil {
+			b.err = multierror.Append(b.err, fmt.Errorf("translate_address_rules: invalid source_cidr: %s", cidrStr))
+			continue
+		}
+		taggedAddress := b.stringVal(rule.TaggedAddress)
+		if taggedAddress == "" {
+			b.err = multierror.Append(b.err, fmt.Errorf("translate_address_rules: tagged_address cannot be blank"))
+			continue
+		}
+		translateAddressRules = append(translateAddressRules, TranslateAddressRule{
+			SourceCIDR:    cidr,
+			TaggedAddress: taggedAddress,
+		})
+	}
+
 	datacenter := strings.ToLower(b.stringVal(c.Datacenter))
 	altDomain := b.stringVal(c.DNSAltDomain)
+	nodeName := b.nodeName(c.NodeName)
 
 	// Create the default set of tagged addresses.
 	if c.TaggedAddresses == nil {
@@ -694,6 +745,11 @@ func (b *Builder) Build() (rt RuntimeConfig, err error) {
 	if connectMeshGatewayWANFederationEnabled && !connectEnabled {
 		return RuntimeConfig{}, fmt.Errorf("'connect.enable_mesh_gateway_wan_federation=true' requires 'connect.enabled=true'")
 	}
+
+	serfWANGossipTLSEnabled := b.boolVal(c.SerfWANGossipTLSEnabled)
+	if serfWANGossipTLSEnabled && connectMeshGatewayWANFederationEnabled {
+		return RuntimeConfig{}, fmt.Errorf("'serf_wan_gossip_tls_enabled=true' cannot be used with 'connect.enable_mesh_gateway_wan_federation=true'")
+	}
 	if connectCAConfig != nil {
 		// nolint: staticcheck // CA config should be changed to use HookTranslateKeys
 		lib.TranslateKeys(connectCAConfig, map[string]string{
@@ -823,6 +879,11 @@ func (b *Builder) Build() (rt RuntimeConfig, err error) {
 	// build runtime config
 	//
 	dataDir := b.stringVal(c.DataDir)
+	edgeMemoryMode := b.boolVal(c.EdgeMemoryMode)
+	cacheEntryFetchMaxBurstDefault := cache.DefaultEntryFetchMaxBurst
+	if edgeMemoryMode {
+		cacheEntryFetchMaxBurstDefault = 1
+	}
 	rt = RuntimeConfig{
 		// non-user configurable values
 		ACLDisabledTTL:             b.durationVal("acl.disabled_ttl", c.ACL.DisabledTTL),
@@ -871,6 +932,11 @@ func (b *Builder) Build() (rt RuntimeConfig, err error) {
 		ACLPolicyTTL:           b.durationVal("acl.policy_ttl", c.ACL.PolicyTTL),
 		ACLRoleTTL:             b.durationVal("acl.role_ttl", c.ACL.RoleTTL),
 		ACLTokenReplication:    b.boolValWithDefault(c.ACL.TokenReplication, b.boolValWithDefault(c.EnableACLReplication, enableTokenReplication)),
+		ACLEnforceTokenScoping: b.boolVal(c.ACL.EnforceTokenScoping),
+		ACLTokenReapingRateLimit: rate.Limit(
+			b.float64ValWithDefault(c.ACL.TokenReapingRateLimit, float64(aclTokenReapingRateLimitDefault)),
+		),
+		ACLTokenReapingBurst: b.intValWithDefault(c.ACL.TokenReapingBurst, aclTokenReapingBurstDefault),
 
 		ACLTokens: token.Config{
 			DataDir:             dataDir,
@@ -905,6 +971,7 @@ func (b *Builder) Build() (rt RuntimeConfig, err error) {
 		DNSPort:               dnsPort,
 		DNSRecursorTimeout:    b.durationVal("recursor_timeout", c.DNS.RecursorTimeout),
 		DNSRecursors:          dnsRecursors,
+		DNSRecursorRules:      dnsRecursorRules,
 		DNSServiceTTL:         dnsServiceTTL,
 		DNSSOA:                soa,
 		DNSUDPAnswerLimit:     b.intVal(c.DNS.UDPAnswerLimit),
@@ -948,6 +1015,13 @@ func (b *Builder) Build() (rt RuntimeConfig, err error) {
 			MetricsPrefix:                      b.stringVal(c.Telemetry.MetricsPrefix),
 			StatsdAddr:                         b.stringVal(c.Telemetry.StatsdAddr),
 			StatsiteAddr:                       b.stringVal(c.Telemetry.StatsiteAddr),
+			EnableCheckMetrics:                 b.boolVal(c.Telemetry.EnableCheckMetrics),
+			CheckMetricsMaxServices:            b.intValWithDefault(c.Telemetry.CheckMetricsMaxServices, 512),
+			EnableIntentionMetrics:             b.boolVal(c.Telemetry.EnableIntentionMetrics),
+			IntentionMetricsMaxElements:        b.intValWithDefault(c.Telemetry.IntentionMetricsMaxElements, 512),
+			OTLPEndpoint:                       b.stringVal(c.Telemetry.OTLPEndpoint),
+			OTLPInsecure:                       b.boolVal(c.Telemetry.OTLPInsecure),
+			OTLPResourceAttributes:             c.Telemetry.OTLPResourceAttributes,
 		},
 
 		// Agent
@@ -962,7 +1036,7 @@ func (b *Builder) Build() (rt RuntimeConfig, err error) {
 				b.float64ValWithDefault(c.Cache.EntryFetchRate, float64(cache.DefaultEntryFetchRate)),
 			),
 			EntryFetchMaxBurst: b.intValWithDefault(
-				c.Cache.EntryFetchMaxBurst, cache.DefaultEntryFetchMaxBurst,
+				c.Cache.EntryFetchMaxBurst, cacheEntryFetchMaxBurstDefault,
 			),
 		},
 		CAFile:                                 b.stringVal(c.CAFile),
@@ -1000,6 +1074,7 @@ func (b *Builder) Build() (rt RuntimeConfig, err error) {
 		DisableUpdateCheck:                     b.boolVal(c.DisableUpdateCheck),
 		DiscardCheckOutput:                     b.boolVal(c.DiscardCheckOutput),
 		DiscoveryMaxStale:                      b.durationVal("discovery_max_stale", c.DiscoveryMaxStale),
+		EdgeMemoryMode:                         edgeMemoryMode,
 		EnableAgentTLSForChecks:                b.boolVal(c.EnableAgentTLSForChecks),
 		EnableCentralServiceConfig:             b.boolVal(c.EnableCentralServiceConfig),
 		EnableDebug:                            b.boolVal(c.EnableDebug),
@@ -1008,6 +1083,7 @@ func (b *Builder) Build() (rt RuntimeConfig, err error) {
 		EncryptKey:                             b.stringVal(c.EncryptKey),
 		EncryptVerifyIncoming:                  b.boolVal(c.EncryptVerifyIncoming),
 		EncryptVerifyOutgoing:                  b.boolVal(c.EncryptVerifyOutgoing),
+		EventPayloadSchemas:                    eventPayloadSchemas,
 		GRPCPort:                               grpcPort,
 		GRPCAddrs:                              grpcAddrs,
 		HTTPMaxConnsPerClient:                  b.intVal(c.Limits.HTTPMaxConnsPerClient),
@@ -1022,79 +1098,99 @@ func (b *Builder) Build() (rt RuntimeConfig, err error) {
 			LogFilePath:       b.stringVal(c.LogFile),
 			EnableSyslog:      b.boolVal(c.EnableSyslog),
 			SyslogFacility:    b.stringVal(c.SyslogFacility),
+			EnableEventLog:    b.boolVal(c.EnableEventLog),
 			LogRotateDuration: b.durationVal("log_rotate_duration", c.LogRotateDuration),
 			LogRotateBytes:    b.intVal(c.LogRotateBytes),
 			LogRotateMaxFiles: b.intVal(c.LogRotateMaxFiles),
 		},
-		MaxQueryTime:                b.durationVal("max_query_time", c.MaxQueryTime),
-		NodeID:                      types.NodeID(b.stringVal(c.NodeID)),
-		NodeMeta:                    c.NodeMeta,
-		NodeName:                    b.nodeName(c.NodeName),
-		NonVotingServer:             b.boolVal(c.NonVotingServer),
-		PidFile:                     b.stringVal(c.PidFile),
-		PrimaryDatacenter:           primaryDatacenter,
-		PrimaryGateways:             b.expandAllOptionalAddrs("primary_gateways", c.PrimaryGateways),
-		PrimaryGatewaysInterval:     b.durationVal("primary_gateways_interval", c.PrimaryGatewaysInterval),
-		RPCAdvertiseAddr:            rpcAdvertiseAddr,
-		RPCBindAddr:                 rpcBindAddr,
-		RPCHandshakeTimeout:         b.durationVal("limits.rpc_handshake_timeout", c.Limits.RPCHandshakeTimeout),
-		RPCHoldTimeout:              b.durationVal("performance.rpc_hold_timeout", c.Performance.RPCHoldTimeout),
-		RPCMaxBurst:                 b.intVal(c.Limits.RPCMaxBurst),
-		RPCMaxConnsPerClient:        b.intVal(c.Limits.RPCMaxConnsPerClient),
-		RPCProtocol:                 b.intVal(c.RPCProtocol),
-		RPCRateLimit:                rate.Limit(b.float64Val(c.Limits.RPCRate)),
-		RPCConfig:                   consul.RPCConfig{EnableStreaming: b.boolVal(c.RPC.EnableStreaming)},
-		RaftProtocol:                b.intVal(c.RaftProtocol),
-		RaftSnapshotThreshold:       b.intVal(c.RaftSnapshotThreshold),
-		RaftSnapshotInterval:        b.durationVal("raft_snapshot_interval", c.RaftSnapshotInterval),
-		RaftTrailingLogs:            b.intVal(c.RaftTrailingLogs),
-		ReconnectTimeoutLAN:         b.durationVal("reconnect_timeout", c.ReconnectTimeoutLAN),
-		ReconnectTimeoutWAN:         b.durationVal("reconnect_timeout_wan", c.ReconnectTimeoutWAN),
-		RejoinAfterLeave:            b.boolVal(c.RejoinAfterLeave),
-		RetryJoinIntervalLAN:        b.durationVal("retry_interval", c.RetryJoinIntervalLAN),
-		RetryJoinIntervalWAN:        b.durationVal("retry_interval_wan", c.RetryJoinIntervalWAN),
-		RetryJoinLAN:                b.expandAllOptionalAddrs("retry_join", c.RetryJoinLAN),
-		RetryJoinMaxAttemptsLAN:     b.intVal(c.RetryJoinMaxAttemptsLAN),
-		RetryJoinMaxAttemptsWAN:     b.intVal(c.RetryJoinMaxAttemptsWAN),
-		RetryJoinWAN:                b.expandAllOptionalAddrs("retry_join_wan", c.RetryJoinWAN),
-		SegmentName:                 b.stringVal(c.SegmentName),
-		Segments:                    segments,
-		SerfAdvertiseAddrLAN:        serfAdvertiseAddrLAN,
-		SerfAdvertiseAddrWAN:        serfAdvertiseAddrWAN,
-		SerfAllowedCIDRsLAN:         serfAllowedCIDRSLAN,
-		SerfAllowedCIDRsWAN:         serfAllowedCIDRSWAN,
-		SerfBindAddrLAN:             serfBindAddrLAN,
-		SerfBindAddrWAN:             serfBindAddrWAN,
-		SerfPortLAN:                 serfPortLAN,
-		SerfPortWAN:                 serfPortWAN,
-		ServerMode:                  b.boolVal(c.ServerMode),
-		ServerName:                  b.stringVal(c.ServerName),
-		ServerPort:                  serverPort,
-		Services:                    services,
-		SessionTTLMin:               b.durationVal("session_ttl_min", c.SessionTTLMin),
-		SkipLeaveOnInt:              skipLeaveOnInt,
-		StartJoinAddrsLAN:           b.expandAllOptionalAddrs("start_join", c.StartJoinAddrsLAN),
-		StartJoinAddrsWAN:           b.expandAllOptionalAddrs("start_join_wan", c.StartJoinAddrsWAN),
-		TLSCipherSuites:             b.tlsCipherSuites("tls_cipher_suites", c.TLSCipherSuites),
-		TLSMinVersion:               b.stringVal(c.TLSMinVersion),
-		TLSPreferServerCipherSuites: b.boolVal(c.TLSPreferServerCipherSuites),
-		TaggedAddresses:             c.TaggedAddresses,
-		TranslateWANAddrs:           b.boolVal(c.TranslateWANAddrs),
-		TxnMaxReqLen:                b.uint64Val(c.Limits.TxnMaxReqLen),
-		UIConfig:                    b.uiConfigVal(c.UIConfig),
-		UnixSocketGroup:             b.stringVal(c.UnixSocket.Group),
-		UnixSocketMode:              b.stringVal(c.UnixSocket.Mode),
-		UnixSocketUser:              b.stringVal(c.UnixSocket.User),
-		VerifyIncoming:              b.boolVal(c.VerifyIncoming),
-		VerifyIncomingHTTPS:         b.boolVal(c.VerifyIncomingHTTPS),
-		VerifyIncomingRPC:           b.boolVal(c.VerifyIncomingRPC),
-		VerifyOutgoing:              verifyOutgoing,
-		VerifyServerHostname:        verifyServerName,
-		Watches:                     c.Watches,
+		MaxQueryTime:                   b.durationVal("max_query_time", c.MaxQueryTime),
+		NodeID:                         types.NodeID(b.stringVal(c.NodeID)),
+		NodeMeta:                       c.NodeMeta,
+		NodeName:                       nodeName,
+		NonVotingServer:                b.boolVal(c.NonVotingServer),
+		PidFile:                        b.stringVal(c.PidFile),
+		PrimaryDatacenter:              primaryDatacenter,
+		PrimaryGateways:                b.expandAllOptionalAddrs("primary_gateways", c.PrimaryGateways),
+		PrimaryGatewaysInterval:        b.durationVal("primary_gateways_interval", c.PrimaryGatewaysInterval),
+		RPCAdvertiseAddr:               rpcAdvertiseAddr,
+		RPCBindAddr:                    rpcBindAddr,
+		RPCHandshakeTimeout:            b.durationVal("limits.rpc_handshake_timeout", c.Limits.RPCHandshakeTimeout),
+		RPCHoldTimeout:                 b.durationVal("performance.rpc_hold_timeout", c.Performance.RPCHoldTimeout),
+		RPCMaxBurst:                    b.intVal(c.Limits.RPCMaxBurst),
+		RPCMaxConnsPerClient:           b.intVal(c.Limits.RPCMaxConnsPerClient),
+		RPCMaxBlockingQueriesPerClient: b.intVal(c.Limits.RPCMaxBlockingQueriesPerClient),
+		SessionsPerNodeLimit:           b.intVal(c.Limits.SessionsPerNodeLimit),
+		RPCProtocol:                    b.intVal(c.RPCProtocol),
+		RPCRateLimit:                   rate.Limit(b.float64Val(c.Limits.RPCRate)),
+		RPCConfig:                      consul.RPCConfig{EnableStreaming: b.boolVal(c.RPC.EnableStreaming)},
+		RaftProtocol:                   b.intVal(c.RaftProtocol),
+		RaftSnapshotThreshold:          b.intVal(c.RaftSnapshotThreshold),
+		RaftSnapshotInterval:           b.durationVal("raft_snapshot_interval", c.RaftSnapshotInterval),
+		RaftTrailingLogs:               b.intVal(c.RaftTrailingLogs),
+		ReconnectTimeoutLAN:            b.durationVal("reconnect_timeout", c.ReconnectTimeoutLAN),
+		ReconnectTimeoutWAN:            b.durationVal("reconnect_timeout_wan", c.ReconnectTimeoutWAN),
+		RejoinAfterLeave:               b.boolVal(c.RejoinAfterLeave),
+		RetryJoinIntervalLAN:           b.durationVal("retry_interval", c.RetryJoinIntervalLAN),
+		RetryJoinIntervalWAN:           b.durationVal("retry_interval_wan", c.RetryJoinIntervalWAN),
+		RetryJoinLAN:                   b.expandAllOptionalAddrs("retry_join", c.RetryJoinLAN),
+		RetryJoinMaxAttemptsLAN:        b.intVal(c.RetryJoinMaxAttemptsLAN),
+		RetryJoinMaxAttemptsWAN:        b.intVal(c.RetryJoinMaxAttemptsWAN),
+		RetryJoinWAN:                   b.expandAllOptionalAddrs("retry_join_wan", c.RetryJoinWAN),
+		SegmentName:                    b.stringVal(c.SegmentName),
+		Segments:                       segments,
+		SerfAdvertiseAddrLAN:           serfAdvertiseAddrLAN,
+		SerfAdvertiseAddrWAN:           serfAdvertiseAddrWAN,
+		SerfAllowedCIDRsLAN:            serfAllowedCIDRSLAN,
+		SerfAllowedCIDRsWAN:            serfAllowedCIDRSWAN,
+		SerfBindAddrLAN:                serfBindAddrLAN,
+		SerfBindAddrWAN:                serfBindAddrWAN,
+		SerfWANGossipTLSEnabled:        serfWANGossipTLSEnabled,
+		SerfPortLAN:                    serfPortLAN,
+		SerfPortWAN:                    serfPortWAN,
+		ServerMode:                     b.boolVal(c.ServerMode),
+		ServerName:                     b.stringVal(c.ServerName),
+		ServerPort:                     serverPort,
+		Services:                       services,
+		SessionTTLMin:                  b.durationVal("session_ttl_min", c.SessionTTLMin),
+		SkipLeaveOnInt:                 skipLeaveOnInt,
+		StartJoinAddrsLAN:              b.expandAllOptionalAddrs("start_join", c.StartJoinAddrsLAN),
+		StartJoinAddrsWAN:              b.expandAllOptionalAddrs("start_join_wan", c.StartJoinAddrsWAN),
+		TLSCipherSuites:                b.tlsCipherSuites("tls_cipher_suites", c.TLSCipherSuites),
+		TLSMinVersion:                  b.stringVal(c.TLSMinVersion),
+		TLSPreferServerCipherSuites:    b.boolVal(c.TLSPreferServerCipherSuites),
+		TaggedAddresses:                c.TaggedAddresses,
+		TranslateAddressRules:          translateAddressRules,
+		TranslateWANAddrs:              b.boolVal(c.TranslateWANAddrs),
+		TxnMaxReqLen:                   b.uint64Val(c.Limits.TxnMaxReqLen),
+		UIConfig:                       b.uiConfigVal(c.UIConfig),
+		UnixSocketGroup:                b.stringVal(c.UnixSocket.Group),
+		UnixSocketMode:                 b.stringVal(c.UnixSocket.Mode),
+		UnixSocketUser:                 b.stringVal(c.UnixSocket.User),
+		VerifyIncoming:                 b.boolVal(c.VerifyIncoming),
+		VerifyIncomingHTTPS:            b.boolVal(c.VerifyIncomingHTTPS),
+		VerifyIncomingRPC:              b.boolVal(c.VerifyIncomingRPC),
+		VerifyOutgoing:                 verifyOutgoing,
+		VerifyServerHostname:           verifyServerName,
+		Watches:                        c.Watches,
+		WaitForLeader:                  b.boolVal(c.WaitForLeader),
+		WaitForACL:                     b.boolVal(c.WaitForACL),
+		ReadyCheckSerf:                 b.boolVal(c.ReadyCheckSerf),
+		ReadyCheckServer:               b.boolVal(c.ReadyCheckServer),
+		ReadyCheckACL:                  b.boolVal(c.ReadyCheckACL),
+		ReadyCheckXDS:                  b.boolVal(c.ReadyCheckXDS),
 	}
 
 	rt.CacheUseStreamingBackend = b.boolVal(c.Cache.UseStreamingBackend)
 
+	if rt.EdgeMemoryMode && rt.UIConfig.Enabled {
+		rt.UIConfig.Enabled = false
+		b.warn("edge_memory_mode disables the UI; ignoring ui_config.enabled")
+	}
+
+	if rt.EdgeMemoryMode && rt.CheckOutputMaxSize == 4096 {
+		rt.CheckOutputMaxSize = 1024
+	}
+
 	if rt.Cache.EntryFetchMaxBurst <= 0 {
 		return RuntimeConfig{}, fmt.Errorf("cache.entry_fetch_max_burst must be strictly positive, was: %v", rt.Cache.EntryFetchMaxBurst)
 	}
@@ -1300,6 +1396,9 @@ func (b *Builder) Validate(rt RuntimeConfig) error {
 			return fmt.Errorf("'retry_join_wan' is incompatible with 'connect.enable_mesh_gateway_wan_federation = true'")
 		}
 	}
+	if rt.SerfWANGossipTLSEnabled && !rt.ServerMode {
+		return fmt.Errorf("'serf_wan_gossip_tls_enabled = true' requires 'server = true'")
+	}
 	if len(rt.PrimaryGateways) > 0 {
 		if !rt.ServerMode {
 			return fmt.Errorf("'primary_gateways' requires 'server = true'")
@@ -1508,15 +1607,21 @@ func (b *Builder) checkVal(v *CheckDefinition) *structs.CheckDefinition {
 		Header:                         v.Header,
 		Method:                         b.stringVal(v.Method),
 		Body:                           b.stringVal(v.Body),
+		HTTP2:                          b.boolVal(v.HTTP2),
+		HTTPReuseConnection:            b.boolVal(v.HTTPReuseConnection),
 		TCP:                            b.stringVal(v.TCP),
+		UDP:                            b.stringVal(v.UDP),
+		ICMP:                           b.stringVal(v.ICMP),
 		Interval:                       b.durationVal(fmt.Sprintf("check[%s].interval", id), v.Interval),
 		DockerContainerID:              b.stringVal(v.DockerContainerID),
+		DockerContainerLabel:           b.stringVal(v.DockerContainerLabel),
 		Shell:                          b.stringVal(v.Shell),
 		GRPC:                           b.stringVal(v.GRPC),
 		GRPCUseTLS:                     b.boolVal(v.GRPCUseTLS),
 		TLSSkipVerify:                  b.boolVal(v.TLSSkipVerify),
 		AliasNode:                      b.stringVal(v.AliasNode),
 		AliasService:                   b.stringVal(v.AliasService),
+		AliasServiceName:               b.stringVal(v.AliasServiceName),
 		Timeout:                        b.durationVal(fmt.Sprintf("check[%s].timeout", id), v.Timeout),
 		TTL:                            b.durationVal(fmt.Sprintf("check[%s].ttl", id), v.TTL),
 		SuccessBeforePassing:           b.intVal(v.SuccessBeforePassing),
@@ -1821,6 +1926,22 @@ func (b *Builder) portVal(name string, v *int) int {
 	return *v
 }
 
+// offsetPort adds offset to port, if port is enabled (not -1). This lets
+// port_offset shift every standard port this agent listens on by the same
+// amount, so multiple agents can share a host's default port numbers
+// without a separate ports{} block for each one.
+func (b *Builder) offsetPort(offset *int, name string, port int) int {
+	if port == -1 || offset == nil || *offset == 0 {
+		return port
+	}
+	offsetPort := port + *offset
+	if offsetPort <= 0 || offsetPort > 65535 {
+		b.err = multierror.Append(b.err, fmt.Errorf("%s: invalid port after applying port_offset: %d", name, offsetPort))
+		return port
+	}
+	return offsetPort
+}
+
 func (b *Builder) stringValWithDefault(v *string, defaultVal string) string {
 	if v == nil {
 		return defaultVal
@@ -2316,6 +2437,15 @@ func UIPathBuilder(UIContentString string) string {
 
 const remoteScriptCheckSecurityWarning = "using enable-script-checks without ACLs and without allow_write_http_from is DANGEROUS, use enable-local-script-checks instead, see https://www.hashicorp.com/blog/protecting-consul-from-rce-risk-in-specific-configurations/"
 
+// aclTokenReapingRateLimitDefault and aclTokenReapingBurstDefault are the
+// defaults for acl.token_reaping_rate_limit and acl.token_reaping_burst, used
+// when those options are left unset. They match the rate at which Consul has
+// always reaped expired ACL tokens in batches.
+const (
+	aclTokenReapingRateLimitDefault = 1.0
+	aclTokenReapingBurstDefault     = 5
+)
+
 // validateRemoteScriptsChecks returns an error if EnableRemoteScriptChecks is
 // enabled without other security features, which mitigate the risk of executing
 // remote scripts.
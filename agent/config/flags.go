@@ -111,8 +111,15 @@ func AddFlags(fs *flag.FlagSet, f *BuilderOpts) {
 	add(&f.Config.Ports.SerfWAN, "serf-wan-port", "Sets the Serf WAN port to listen on.")
 	add(&f.Config.ServerMode, "server", "Switches agent to server mode.")
 	add(&f.Config.EnableSyslog, "syslog", "Enables logging to syslog.")
+	add(&f.Config.EnableEventLog, "event-log", "Enables logging to the Windows Event Log. Ignored on non-Windows platforms.")
 	add(&f.Config.UIConfig.Enabled, "ui", "Enables the built-in static web UI server.")
 	add(&f.Config.UIConfig.ContentPath, "ui-content-path", "Sets the external UI path to a string. Defaults to: /ui/ ")
 	add(&f.Config.UIConfig.Dir, "ui-dir", "Path to directory containing the web UI resources.")
+	add(&f.Config.WaitForLeader, "wait-for-leader", "Client agents only: delay reporting ready, and starting local checks and proxies, until a cluster leader has been found.")
+	add(&f.Config.WaitForACL, "wait-for-acl", "Client agents only: delay reporting ready, and starting local checks and proxies, until the agent's default ACL token can be resolved. Ignored when ACLs are disabled.")
+	add(&f.Config.ReadyCheckSerf, "ready-check-serf", "Include this agent's Serf membership status in the /v1/agent/ready criteria.")
+	add(&f.Config.ReadyCheckServer, "ready-check-server", "Include server connectivity in the /v1/agent/ready criteria.")
+	add(&f.Config.ReadyCheckACL, "ready-check-acl", "Include resolvability of the agent's default ACL token in the /v1/agent/ready criteria. Ignored when ACLs are disabled.")
+	add(&f.Config.ReadyCheckXDS, "ready-check-xds", "Include the xDS gRPC listener's status in the /v1/agent/ready criteria.")
 	add(&f.HCL, "hcl", "hcl config fragment. Can be specified multiple times.")
 }
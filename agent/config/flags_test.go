@@ -90,6 +90,23 @@ func TestAddFlags_WithParse(t *testing.T) {
 				"foo.local", "bar.local",
 			}}},
 		},
+		{
+			args:     []string{`-wait-for-leader`},
+			expected: BuilderOpts{Config: Config{WaitForLeader: pBool(true)}},
+		},
+		{
+			args:     []string{`-wait-for-acl`},
+			expected: BuilderOpts{Config: Config{WaitForACL: pBool(true)}},
+		},
+		{
+			args:     []string{`-ready-check-serf`, `-ready-check-server`, `-ready-check-acl`, `-ready-check-xds`},
+			expected: BuilderOpts{Config: Config{
+				ReadyCheckSerf:   pBool(true),
+				ReadyCheckServer: pBool(true),
+				ReadyCheckACL:    pBool(true),
+				ReadyCheckXDS:    pBool(true),
+			}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -31,6 +31,14 @@ type RuntimeSOAConfig struct {
 // RuntimeConfig specifies the configuration the consul agent actually
 // uses. Is is derived from one or more Config structures which can come
 // from files, flags and/or environment variables.
+// TranslateAddressRule is the parsed form of an AddressTranslationRule:
+// the querying client's source CIDR paired with the name of the tagged
+// address to return to clients matching it.
+type TranslateAddressRule struct {
+	SourceCIDR    *net.IPNet
+	TaggedAddress string
+}
+
 type RuntimeConfig struct {
 	// non-user configurable values
 	AEInterval time.Duration
@@ -120,6 +128,17 @@ type RuntimeConfig struct {
 	// hcl: acl.token_replication = boolean
 	ACLTokenReplication bool
 
+	// ACLEnforceTokenScoping, when set, prevents a token from writing a
+	// token or policy that would grant permissions beyond its own: the
+	// new token/policy's synthesized Authorizer must be a subset of the
+	// Authorizer of the token performing the write. This closes the
+	// privilege-escalation path where acl:write is otherwise equivalent
+	// to global management, at the cost of disallowing otherwise-valid
+	// writes by tokens that aren't management tokens.
+	//
+	// hcl: acl.enforce_token_scoping = boolean
+	ACLEnforceTokenScoping bool
+
 	// ACLTokenTTL is used to control the time-to-live of cached ACL tokens. This has
 	// a major impact on performance. By default, it is set to 30 seconds.
 	//
@@ -138,6 +157,20 @@ type RuntimeConfig struct {
 	// hcl: acl.role_ttl = "duration"
 	ACLRoleTTL time.Duration
 
+	// ACLTokenReapingRateLimit and ACLTokenReapingBurst control how fast the
+	// leader deletes expired ACL tokens in batches of aclBatchDeleteSize.
+	// The defaults comfortably keep up with normal churn, but a workload
+	// that mints and expires many short-lived tokens per hour (e.g. CI
+	// issuing a token per job) can build up a larger backlog of expired
+	// tokens between batches; raising these lets that backlog drain faster
+	// without reaping so aggressively that it starves other Raft writes.
+	//
+	// hcl: acl.token_reaping_rate_limit = float64
+	ACLTokenReapingRateLimit rate.Limit
+
+	// hcl: acl.token_reaping_burst = int
+	ACLTokenReapingBurst int
+
 	// AutopilotCleanupDeadServers enables the automatic cleanup of dead servers when new ones
 	// are added to the peer list. Defaults to true.
 	//
@@ -306,6 +339,17 @@ type RuntimeConfig struct {
 	// flag: -recursor string [-recursor string]
 	DNSRecursors []string
 
+	// DNSRecursorRules maps a domain to the list of upstream recursors
+	// used for queries under that domain, overriding DNSRecursors for
+	// matching queries. Keys are lowercased FQDNs; the most specific
+	// (longest) matching domain wins, falling back to DNSRecursors when
+	// nothing matches. This lets a host point resolv.conf solely at the
+	// Consul agent and still split different non-consul domains across
+	// different upstream resolvers.
+	//
+	// hcl: dns_config { recursor_rules = [{ domain="corp.internal" recursors=["10.0.0.1"] }] }
+	DNSRecursorRules map[string][]string
+
 	// DNSUseCache whether or not to use cache for dns queries
 	//
 	// hcl: dns_config { use_cache = (true|false) }
@@ -362,6 +406,15 @@ type RuntimeConfig struct {
 	// hcl: discovery_max_stale = "duration"
 	DiscoveryMaxStale time.Duration
 
+	// EdgeMemoryMode applies a constrained profile intended for client
+	// agents on low-memory (<128MB) edge/IoT hosts: it disables the UI,
+	// lowers the default check output size limit, shrinks the gossip
+	// queue depth and per-packet buffer, and caps the agent cache's
+	// fetch burst size, unless those are explicitly overridden elsewhere.
+	//
+	// hcl: edge_memory_mode = (true|false)
+	EdgeMemoryMode bool
+
 	// Node name is the name we use to advertise. Defaults to hostname.
 	//
 	// NodeName is exposed via /v1/agent/self from here and
@@ -714,6 +767,14 @@ type RuntimeConfig struct {
 	// hcl: encrypt_verify_outgoing = (true|false)
 	EncryptVerifyOutgoing bool
 
+	// EventPayloadSchemas, keyed by event name, constrain the payload
+	// accepted by /v1/event/fire/<name> to JSON objects containing a set
+	// of required top-level fields. Event names with no entry are not
+	// validated.
+	//
+	// hcl: event_payload_schemas = [{ name=foo required_fields=["bar"] }]
+	EventPayloadSchemas map[string][]string
+
 	// GRPCPort is the port the gRPC server listens on. Currently this only
 	// exposes the xDS and ext_authz APIs for Envoy and it is disabled by default.
 	//
@@ -930,6 +991,23 @@ type RuntimeConfig struct {
 	// hcl: limits{ rpc_max_conns_per_client = 100 }
 	RPCMaxConnsPerClient int
 
+	// RPCMaxBlockingQueriesPerClient limits the number of concurrent
+	// blocking queries the RPC server will hold open for any single ACL
+	// token (or the anonymous token, if the request didn't provide one).
+	// A client that exceeds it gets ErrTooManyBlockingQueries back
+	// instead of a new watch. Zero or less disables the limit.
+	//
+	// hcl: limits{ rpc_max_blocking_queries_per_client = 5000 }
+	RPCMaxBlockingQueriesPerClient int
+
+	// SessionsPerNodeLimit limits how many sessions may be held open
+	// against a single node at once. A client that exceeds it gets an
+	// error back instead of a new session. Zero or less disables the
+	// limit.
+	//
+	// hcl: limits{ sessions_per_node_limit = 100 }
+	SessionsPerNodeLimit int
+
 	// RPCProtocol is the Consul protocol version to use.
 	//
 	// hcl: protocol = int
@@ -1128,6 +1206,16 @@ type RuntimeConfig struct {
 	// flag: -serf-wan string
 	SerfBindAddrWAN *net.TCPAddr
 
+	// SerfWANGossipTLSEnabled wraps WAN Serf traffic in a TLS connection
+	// authenticated with the agent's own TLS certificates instead of relying
+	// solely on the shared gossip encryption keyring. This only applies to
+	// server agents and is mutually exclusive with
+	// ConnectMeshGatewayWANFederationEnabled, which already secures WAN
+	// gossip routed through mesh gateways this way.
+	//
+	// hcl: serf_wan_gossip_tls_enabled = (true|false)
+	SerfWANGossipTLSEnabled bool
+
 	// SerfPortLAN is the port used for the LAN Gossip pool for both client and server.
 	// The default is 8301.
 	//
@@ -1404,6 +1492,18 @@ type RuntimeConfig struct {
 	// hcl: tagged_addresses = map[string]string
 	TaggedAddresses map[string]string
 
+	// TranslateAddressRules maps a querying client's source CIDR to the
+	// name of the tagged address that should be returned to it, letting
+	// operators express NAT topologies (e.g. several peered VPCs with
+	// overlapping ranges) that a single LAN/WAN split can't. Rules are
+	// tried in order; the first matching CIDR wins and falls back to the
+	// TranslateWANAddrs behavior when the node/service has no tagged
+	// address under that name. An empty list preserves the pre-existing
+	// LAN/WAN-only behavior.
+	//
+	// hcl: translate_address_rules = [{ source_cidr="10.1.0.0/16" tagged_address="peer-a" }, ...]
+	TranslateAddressRules []TranslateAddressRule
+
 	// TranslateWANAddrs controls whether or not Consul should prefer
 	// the "wan" tagged address when doing lookups in remote datacenters.
 	// See TaggedAddresses below for more details.
@@ -1505,6 +1605,54 @@ type RuntimeConfig struct {
 	//
 	Watches []map[string]interface{}
 
+	// WaitForLeader delays a client agent reporting itself ready, and
+	// starting its local checks and proxies, until it has joined the LAN
+	// gossip pool and learned of a cluster leader. It's ignored on
+	// servers, which already gate readiness on Raft leadership. Combine
+	// with WaitForACL to also wait for the agent's default token to be
+	// resolvable.
+	//
+	// hcl: wait_for_leader = (true|false)
+	WaitForLeader bool
+
+	// WaitForACL delays a client agent reporting itself ready, and
+	// starting its local checks and proxies, until its default ACL token
+	// can be resolved against the servers. It's a no-op when ACLs are
+	// disabled.
+	//
+	// hcl: wait_for_acl = (true|false)
+	WaitForACL bool
+
+	// ReadyCheckSerf adds this agent's own Serf membership status to the
+	// criteria checked by GET /v1/agent/ready: if its local member isn't
+	// alive in the LAN gossip pool, the endpoint reports not ready even
+	// after the wait_for_leader/wait_for_acl startup gates have cleared.
+	//
+	// hcl: ready_check_serf = (true|false)
+	ReadyCheckSerf bool
+
+	// ReadyCheckServer adds server connectivity to the criteria checked by
+	// GET /v1/agent/ready. On a client agent this means the agent's RPC
+	// router currently knows of at least one server; on a server agent it
+	// always passes, since the server is itself part of the quorum.
+	//
+	// hcl: ready_check_server = (true|false)
+	ReadyCheckServer bool
+
+	// ReadyCheckACL adds resolvability of this agent's default ACL token
+	// to the criteria checked by GET /v1/agent/ready. It's a no-op when
+	// ACLs are disabled.
+	//
+	// hcl: ready_check_acl = (true|false)
+	ReadyCheckACL bool
+
+	// ReadyCheckXDS adds the xDS gRPC listener to the criteria checked by
+	// GET /v1/agent/ready: if this agent hasn't started serving xDS,
+	// connected proxies can't receive configuration from it.
+	//
+	// hcl: ready_check_xds = (true|false)
+	ReadyCheckXDS bool
+
 	EnterpriseRuntimeConfig
 }
 
@@ -846,6 +846,17 @@ func TestBuilder_BuildAndValidate_ConfigFlagsAndEdgecases(t *testing.T) {
 				rt.DataDir = dataDir
 			},
 		},
+		{
+			desc: "-event-log",
+			args: []string{
+				`-event-log`,
+				`-data-dir=` + dataDir,
+			},
+			patch: func(rt *RuntimeConfig) {
+				rt.Logging.EnableEventLog = true
+				rt.DataDir = dataDir
+			},
+		},
 		{
 			desc: "-ui",
 			args: []string{
@@ -880,6 +891,60 @@ func TestBuilder_BuildAndValidate_ConfigFlagsAndEdgecases(t *testing.T) {
 				rt.DataDir = dataDir
 			},
 		},
+		{
+			desc: "-wait-for-leader",
+			args: []string{
+				`-wait-for-leader`,
+				`-data-dir=` + dataDir,
+			},
+			patch: func(rt *RuntimeConfig) {
+				rt.WaitForLeader = true
+				rt.DataDir = dataDir
+			},
+		},
+		{
+			desc: "-wait-for-acl",
+			args: []string{
+				`-wait-for-acl`,
+				`-data-dir=` + dataDir,
+			},
+			patch: func(rt *RuntimeConfig) {
+				rt.WaitForACL = true
+				rt.DataDir = dataDir
+			},
+		},
+		{
+			desc: "-ready-check-serf -ready-check-server -ready-check-acl -ready-check-xds",
+			args: []string{
+				`-ready-check-serf`,
+				`-ready-check-server`,
+				`-ready-check-acl`,
+				`-ready-check-xds`,
+				`-data-dir=` + dataDir,
+			},
+			patch: func(rt *RuntimeConfig) {
+				rt.ReadyCheckSerf = true
+				rt.ReadyCheckServer = true
+				rt.ReadyCheckACL = true
+				rt.ReadyCheckXDS = true
+				rt.DataDir = dataDir
+			},
+		},
+		{
+			desc: "edge_memory_mode",
+			args: []string{
+				`-data-dir=` + dataDir,
+			},
+			json: []string{`{ "edge_memory_mode": true }`},
+			hcl:  []string{`edge_memory_mode = true`},
+			patch: func(rt *RuntimeConfig) {
+				rt.EdgeMemoryMode = true
+				rt.CheckOutputMaxSize = 1024
+				rt.Cache.EntryFetchMaxBurst = 1
+				rt.UIConfig.Enabled = false
+				rt.DataDir = dataDir
+			},
+		},
 
 		// ------------------------------------------------------------
 		// ports and addresses
@@ -1028,6 +1093,42 @@ func TestBuilder_BuildAndValidate_ConfigFlagsAndEdgecases(t *testing.T) {
 			},
 		},
 
+		{
+			desc: "client addr, ports > 0, and port_offset",
+			args: []string{`-data-dir=` + dataDir},
+			json: []string{`{
+					"client_addr":"0.0.0.0",
+					"port_offset": 100,
+					"ports":{ "dns": 1, "http": 2, "https": 3, "grpc": 4 }
+				}`},
+			hcl: []string{`
+					client_addr = "0.0.0.0"
+					port_offset = 100
+					ports { dns = 1 http = 2 https = 3 grpc = 4 }
+				`},
+			patch: func(rt *RuntimeConfig) {
+				rt.ClientAddrs = []*net.IPAddr{ipAddr("0.0.0.0")}
+				rt.DNSPort = 101
+				rt.DNSAddrs = []net.Addr{tcpAddr("0.0.0.0:101"), udpAddr("0.0.0.0:101")}
+				rt.HTTPPort = 102
+				rt.HTTPAddrs = []net.Addr{tcpAddr("0.0.0.0:102")}
+				rt.HTTPSPort = 103
+				rt.HTTPSAddrs = []net.Addr{tcpAddr("0.0.0.0:103")}
+				rt.GRPCPort = 104
+				rt.GRPCAddrs = []net.Addr{tcpAddr("0.0.0.0:104")}
+				rt.DataDir = dataDir
+				rt.RPCBindAddr = tcpAddr("0.0.0.0:8400")
+				rt.RPCAdvertiseAddr = tcpAddr("10.0.0.1:8400")
+				rt.SerfBindAddrLAN = tcpAddr("0.0.0.0:8401")
+				rt.SerfBindAddrWAN = tcpAddr("0.0.0.0:8402")
+				rt.SerfAdvertiseAddrLAN = tcpAddr("10.0.0.1:8401")
+				rt.SerfAdvertiseAddrWAN = tcpAddr("10.0.0.1:8402")
+				rt.SerfPortLAN = 8401
+				rt.SerfPortWAN = 8402
+				rt.ServerPort = 8400
+			},
+		},
+
 		{
 			desc: "client addr, addresses and ports == 0",
 			args: []string{`-data-dir=` + dataDir},
@@ -3170,6 +3271,44 @@ func TestBuilder_BuildAndValidate_ConfigFlagsAndEdgecases(t *testing.T) {
 			`},
 			err: "'connect.enable_mesh_gateway_wan_federation = true' requires that 'node_name' not contain '/' characters",
 		},
+		{
+			desc: "serf_wan_gossip_tls_enabled requires server mode",
+			args: []string{
+				`-data-dir=` + dataDir,
+			},
+			json: []string{`{
+			  "server": false,
+			  "serf_wan_gossip_tls_enabled": true
+			}`},
+			hcl: []string{`
+			  server = false
+			  serf_wan_gossip_tls_enabled = true
+			`},
+			err: "'serf_wan_gossip_tls_enabled = true' requires 'server = true'",
+		},
+		{
+			desc: "serf_wan_gossip_tls_enabled is incompatible with connect.enable_mesh_gateway_wan_federation",
+			args: []string{
+				`-data-dir=` + dataDir,
+			},
+			json: []string{`{
+			  "server": true,
+			  "serf_wan_gossip_tls_enabled": true,
+			  "connect": {
+				"enabled": true,
+				"enable_mesh_gateway_wan_federation": true
+			  }
+			}`},
+			hcl: []string{`
+			  server = true
+			  serf_wan_gossip_tls_enabled = true
+			  connect {
+			    enabled = true
+			    enable_mesh_gateway_wan_federation = true
+			  }
+			`},
+			err: "'serf_wan_gossip_tls_enabled=true' cannot be used with 'connect.enable_mesh_gateway_wan_federation=true'",
+		},
 		{
 			desc: "primary_gateways requires server mode",
 			args: []string{
@@ -4784,14 +4923,13 @@ func TestNewBuilder_InvalidConfigFormat(t *testing.T) {
 // To aid populating the fields the following bash functions can be used
 // to generate random strings and ints:
 //
-//   random-int() { echo $RANDOM }
-//   random-string() { base64 /dev/urandom | tr -d '/+' | fold -w ${1:-32} | head -n 1 }
+//	random-int() { echo $RANDOM }
+//	random-string() { base64 /dev/urandom | tr -d '/+' | fold -w ${1:-32} | head -n 1 }
 //
 // To generate a random string of length 8 run the following command in
 // a terminal:
 //
-//   random-string 8
-//
+//	random-string 8
 func TestFullConfig(t *testing.T) {
 	dataDir := testutil.TempDir(t, "consul")
 
@@ -4821,9 +4959,12 @@ func TestFullConfig(t *testing.T) {
 				"default_policy" : "72c2e7a0",
 				"enable_key_list_policy": true,
 				"enable_token_persistence": true,
+				"enforce_token_scoping": true,
 				"policy_ttl": "1123s",
 				"role_ttl": "9876s",
 				"token_ttl": "3321s",
+				"token_reaping_rate_limit": 0.556,
+				"token_reaping_burst": 1890,
 				"enable_token_replication" : true,
 				"msp_disable_bootstrap": true,
 				"tokens" : {
@@ -5048,6 +5189,12 @@ func TestFullConfig(t *testing.T) {
 				"max_stale": "29685s",
 				"node_ttl": "7084s",
 				"only_passing": true,
+				"recursor_rules": [
+					{
+						"domain": "NNBH6yOU",
+						"recursors": [ "63.38.39.59" ]
+					}
+				],
 				"recursor_timeout": "4427s",
 				"service_ttl": {
 					"*": "32030s"
@@ -5064,9 +5211,16 @@ func TestFullConfig(t *testing.T) {
 			"enable_script_checks": true,
 			"enable_local_script_checks": true,
 			"enable_syslog": true,
+			"enable_event_log": true,
 			"encrypt": "A4wELWqH",
 			"encrypt_verify_incoming": true,
 			"encrypt_verify_outgoing": true,
+			"event_payload_schemas": [
+				{
+					"name": "MyEvent",
+					"required_fields": [ "mDrxpFUb" ]
+				}
+			],
 			"http_config": {
 				"block_endpoints": [ "RBvAFcGD", "fWOWFznh" ],
 				"allow_write_http_from": [ "127.0.0.1/8", "22.33.44.55/32", "0.0.0.0/0" ],
@@ -5085,6 +5239,8 @@ func TestFullConfig(t *testing.T) {
 				"rpc_rate": 12029.43,
 				"rpc_max_burst": 44848,
 				"rpc_max_conns_per_client": 2954,
+				"rpc_max_blocking_queries_per_client": 4200,
+				"sessions_per_node_limit": 8200,
 				"kv_max_value_size": 1234567800000000,
 				"txn_max_req_len": 5678000000000000
 			},
@@ -5153,6 +5309,7 @@ func TestFullConfig(t *testing.T) {
 			],
 			"serf_lan": "99.43.63.15",
 			"serf_wan": "67.88.33.19",
+			"serf_wan_gossip_tls_enabled": true,
 			"server": true,
 			"server_name": "Oerr9n1G",
 			"service": {
@@ -5433,13 +5590,20 @@ func TestFullConfig(t *testing.T) {
 				"disable_hostname": true,
 				"dogstatsd_addr": "0wSndumK",
 				"dogstatsd_tags": [ "3N81zSUB","Xtj8AnXZ" ],
+				"enable_check_metrics": true,
+				"check_metrics_max_services": 23005,
+				"enable_intention_metrics": true,
+				"intention_metrics_max_elements": 13579,
 				"filter_default": true,
 				"prefix_filter": [ "+oJotS8XJ","-cazlEhGn" ],
 				"metrics_prefix": "ftO6DySn",
 				"prometheus_retention_time": "15s",
 				"statsd_address": "drce87cy",
 				"statsite_address": "HpFwKB8R",
-				"disable_compat_1.9": true
+				"disable_compat_1.9": true,
+				"otlp_endpoint": "otelcol.example.com:4318",
+				"otlp_insecure": true,
+				"otlp_resource_attributes": [ "cloud.region=us-east-1" ]
 			},
 			"tls_cipher_suites": "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256",
 			"tls_min_version": "pAOWafkR",
@@ -5506,9 +5670,12 @@ func TestFullConfig(t *testing.T) {
 				default_policy = "72c2e7a0"
 				enable_key_list_policy = true
 				enable_token_persistence = true
+				enforce_token_scoping = true
 				policy_ttl = "1123s"
 				role_ttl = "9876s"
 				token_ttl = "3321s"
+				token_reaping_rate_limit = 0.556
+				token_reaping_burst = 1890
 				enable_token_replication = true
 				msp_disable_bootstrap = true
 				tokens = {
@@ -5734,6 +5901,12 @@ func TestFullConfig(t *testing.T) {
 				max_stale = "29685s"
 				node_ttl = "7084s"
 				only_passing = true
+				recursor_rules = [
+					{
+						domain = "NNBH6yOU"
+						recursors = [ "63.38.39.59" ]
+					}
+				]
 				recursor_timeout = "4427s"
 				service_ttl = {
 					"*" = "32030s"
@@ -5750,9 +5923,16 @@ func TestFullConfig(t *testing.T) {
 			enable_script_checks = true
 			enable_local_script_checks = true
 			enable_syslog = true
+			enable_event_log = true
 			encrypt = "A4wELWqH"
 			encrypt_verify_incoming = true
 			encrypt_verify_outgoing = true
+			event_payload_schemas = [
+				{
+					name = "MyEvent"
+					required_fields = [ "mDrxpFUb" ]
+				}
+			]
 			http_config {
 				block_endpoints = [ "RBvAFcGD", "fWOWFznh" ]
 				allow_write_http_from = [ "127.0.0.1/8", "22.33.44.55/32", "0.0.0.0/0" ]
@@ -5771,6 +5951,8 @@ func TestFullConfig(t *testing.T) {
 				rpc_rate = 12029.43
 				rpc_max_burst = 44848
 				rpc_max_conns_per_client = 2954
+				rpc_max_blocking_queries_per_client = 4200
+				sessions_per_node_limit = 8200
 				kv_max_value_size = 1234567800000000
 				txn_max_req_len = 5678000000000000
 			}
@@ -5843,6 +6025,7 @@ func TestFullConfig(t *testing.T) {
 			]
 			serf_lan = "99.43.63.15"
 			serf_wan = "67.88.33.19"
+			serf_wan_gossip_tls_enabled = true
 			server = true
 			server_name = "Oerr9n1G"
 			service = {
@@ -6122,6 +6305,10 @@ func TestFullConfig(t *testing.T) {
 				disable_hostname = true
 				dogstatsd_addr = "0wSndumK"
 				dogstatsd_tags = [ "3N81zSUB","Xtj8AnXZ" ]
+				enable_check_metrics = true
+				check_metrics_max_services = 23005
+				enable_intention_metrics = true
+				intention_metrics_max_elements = 13579
 				filter_default = true
 				prefix_filter = [ "+oJotS8XJ","-cazlEhGn" ]
 				metrics_prefix = "ftO6DySn"
@@ -6129,6 +6316,9 @@ func TestFullConfig(t *testing.T) {
 				statsd_address = "drce87cy"
 				statsite_address = "HpFwKB8R"
 				disable_compat_1.9 = true
+				otlp_endpoint = "otelcol.example.com:4318"
+				otlp_insecure = true
+				otlp_resource_attributes = [ "cloud.region=us-east-1" ]
 			}
 			tls_cipher_suites = "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256"
 			tls_min_version = "pAOWafkR"
@@ -6316,10 +6506,13 @@ func TestFullConfig(t *testing.T) {
 		ACLDefaultPolicy:                 "72c2e7a0",
 		ACLDownPolicy:                    "03eb2aee",
 		ACLEnableKeyListPolicy:           true,
+		ACLEnforceTokenScoping:           true,
 		ACLMasterToken:                   "8a19ac27",
 		ACLTokenTTL:                      3321 * time.Second,
 		ACLPolicyTTL:                     1123 * time.Second,
 		ACLRoleTTL:                       9876 * time.Second,
+		ACLTokenReapingRateLimit:         0.556,
+		ACLTokenReapingBurst:             1890,
 		ACLTokenReplication:              true,
 		AdvertiseAddrLAN:                 ipAddr("17.99.29.16"),
 		AdvertiseAddrWAN:                 ipAddr("78.63.37.19"),
@@ -6500,89 +6693,98 @@ func TestFullConfig(t *testing.T) {
 		DNSNodeTTL:                             7084 * time.Second,
 		DNSOnlyPassing:                         true,
 		DNSPort:                                7001,
-		DNSRecursorTimeout:                     4427 * time.Second,
-		DNSRecursors:                           []string{"63.38.39.58", "92.49.18.18"},
-		DNSSOA:                                 RuntimeSOAConfig{Refresh: 3600, Retry: 600, Expire: 86400, Minttl: 0},
-		DNSServiceTTL:                          map[string]time.Duration{"*": 32030 * time.Second},
-		DNSUDPAnswerLimit:                      29909,
-		DNSNodeMetaTXT:                         true,
-		DNSUseCache:                            true,
-		DNSCacheMaxAge:                         5 * time.Minute,
-		DataDir:                                dataDir,
-		Datacenter:                             "rzo029wg",
-		DefaultQueryTime:                       16743 * time.Second,
-		DevMode:                                true,
-		DisableAnonymousSignature:              true,
-		DisableCoordinates:                     true,
-		DisableHostNodeID:                      true,
-		DisableHTTPUnprintableCharFilter:       true,
-		DisableKeyringFile:                     true,
-		DisableRemoteExec:                      true,
-		DisableUpdateCheck:                     true,
-		DiscardCheckOutput:                     true,
-		DiscoveryMaxStale:                      5 * time.Second,
-		EnableAgentTLSForChecks:                true,
-		EnableCentralServiceConfig:             false,
-		EnableDebug:                            true,
-		EnableRemoteScriptChecks:               true,
-		EnableLocalScriptChecks:                true,
-		EncryptKey:                             "A4wELWqH",
-		EncryptVerifyIncoming:                  true,
-		EncryptVerifyOutgoing:                  true,
-		GRPCPort:                               4881,
-		GRPCAddrs:                              []net.Addr{tcpAddr("32.31.61.91:4881")},
-		HTTPAddrs:                              []net.Addr{tcpAddr("83.39.91.39:7999")},
-		HTTPBlockEndpoints:                     []string{"RBvAFcGD", "fWOWFznh"},
-		AllowWriteHTTPFrom:                     []*net.IPNet{cidr("127.0.0.0/8"), cidr("22.33.44.55/32"), cidr("0.0.0.0/0")},
-		HTTPPort:                               7999,
-		HTTPResponseHeaders:                    map[string]string{"M6TKa9NP": "xjuxjOzQ", "JRCrHZed": "rl0mTx81"},
-		HTTPSAddrs:                             []net.Addr{tcpAddr("95.17.17.19:15127")},
-		HTTPMaxConnsPerClient:                  100,
-		HTTPSHandshakeTimeout:                  2391 * time.Millisecond,
-		HTTPSPort:                              15127,
-		HTTPUseCache:                           false,
-		KeyFile:                                "IEkkwgIA",
-		KVMaxValueSize:                         1234567800000000,
-		LeaveDrainTime:                         8265 * time.Second,
-		LeaveOnTerm:                            true,
+		DNSRecursorRules: map[string][]string{
+			"nnbh6you": {"63.38.39.59"},
+		},
+		DNSRecursorTimeout:               4427 * time.Second,
+		DNSRecursors:                     []string{"63.38.39.58", "92.49.18.18"},
+		DNSSOA:                           RuntimeSOAConfig{Refresh: 3600, Retry: 600, Expire: 86400, Minttl: 0},
+		DNSServiceTTL:                    map[string]time.Duration{"*": 32030 * time.Second},
+		DNSUDPAnswerLimit:                29909,
+		DNSNodeMetaTXT:                   true,
+		DNSUseCache:                      true,
+		DNSCacheMaxAge:                   5 * time.Minute,
+		DataDir:                          dataDir,
+		Datacenter:                       "rzo029wg",
+		DefaultQueryTime:                 16743 * time.Second,
+		DevMode:                          true,
+		DisableAnonymousSignature:        true,
+		DisableCoordinates:               true,
+		DisableHostNodeID:                true,
+		DisableHTTPUnprintableCharFilter: true,
+		DisableKeyringFile:               true,
+		DisableRemoteExec:                true,
+		DisableUpdateCheck:               true,
+		DiscardCheckOutput:               true,
+		DiscoveryMaxStale:                5 * time.Second,
+		EnableAgentTLSForChecks:          true,
+		EnableCentralServiceConfig:       false,
+		EnableDebug:                      true,
+		EnableRemoteScriptChecks:         true,
+		EnableLocalScriptChecks:          true,
+		EncryptKey:                       "A4wELWqH",
+		EncryptVerifyIncoming:            true,
+		EncryptVerifyOutgoing:            true,
+		EventPayloadSchemas: map[string][]string{
+			"MyEvent": {"mDrxpFUb"},
+		},
+		GRPCPort:              4881,
+		GRPCAddrs:             []net.Addr{tcpAddr("32.31.61.91:4881")},
+		HTTPAddrs:             []net.Addr{tcpAddr("83.39.91.39:7999")},
+		HTTPBlockEndpoints:    []string{"RBvAFcGD", "fWOWFznh"},
+		AllowWriteHTTPFrom:    []*net.IPNet{cidr("127.0.0.0/8"), cidr("22.33.44.55/32"), cidr("0.0.0.0/0")},
+		HTTPPort:              7999,
+		HTTPResponseHeaders:   map[string]string{"M6TKa9NP": "xjuxjOzQ", "JRCrHZed": "rl0mTx81"},
+		HTTPSAddrs:            []net.Addr{tcpAddr("95.17.17.19:15127")},
+		HTTPMaxConnsPerClient: 100,
+		HTTPSHandshakeTimeout: 2391 * time.Millisecond,
+		HTTPSPort:             15127,
+		HTTPUseCache:          false,
+		KeyFile:               "IEkkwgIA",
+		KVMaxValueSize:        1234567800000000,
+		LeaveDrainTime:        8265 * time.Second,
+		LeaveOnTerm:           true,
 		Logging: logging.Config{
 			LogLevel:       "k1zo9Spt",
 			LogJSON:        true,
 			EnableSyslog:   true,
+			EnableEventLog: true,
 			SyslogFacility: "hHv79Uia",
 		},
-		MaxQueryTime:            18237 * time.Second,
-		NodeID:                  types.NodeID("AsUIlw99"),
-		NodeMeta:                map[string]string{"5mgGQMBk": "mJLtVMSG", "A7ynFMJB": "0Nx6RGab"},
-		NodeName:                "otlLxGaI",
-		NonVotingServer:         true,
-		PidFile:                 "43xN80Km",
-		PrimaryDatacenter:       "ejtmd43d",
-		PrimaryGateways:         []string{"aej8eeZo", "roh2KahS"},
-		PrimaryGatewaysInterval: 18866 * time.Second,
-		RPCAdvertiseAddr:        tcpAddr("17.99.29.16:3757"),
-		RPCBindAddr:             tcpAddr("16.99.34.17:3757"),
-		RPCHandshakeTimeout:     1932 * time.Millisecond,
-		RPCHoldTimeout:          15707 * time.Second,
-		RPCProtocol:             30793,
-		RPCRateLimit:            12029.43,
-		RPCMaxBurst:             44848,
-		RPCMaxConnsPerClient:    2954,
-		RaftProtocol:            19016,
-		RaftSnapshotThreshold:   16384,
-		RaftSnapshotInterval:    30 * time.Second,
-		RaftTrailingLogs:        83749,
-		ReconnectTimeoutLAN:     23739 * time.Second,
-		ReconnectTimeoutWAN:     26694 * time.Second,
-		RejoinAfterLeave:        true,
-		RetryJoinIntervalLAN:    8067 * time.Second,
-		RetryJoinIntervalWAN:    28866 * time.Second,
-		RetryJoinLAN:            []string{"pbsSFY7U", "l0qLtWij"},
-		RetryJoinMaxAttemptsLAN: 913,
-		RetryJoinMaxAttemptsWAN: 23160,
-		RetryJoinWAN:            []string{"PFsR02Ye", "rJdQIhER"},
-		RPCConfig:               consul.RPCConfig{EnableStreaming: true},
-		SegmentName:             "BC2NhTDi",
+		MaxQueryTime:                   18237 * time.Second,
+		NodeID:                         types.NodeID("AsUIlw99"),
+		NodeMeta:                       map[string]string{"5mgGQMBk": "mJLtVMSG", "A7ynFMJB": "0Nx6RGab"},
+		NodeName:                       "otlLxGaI",
+		NonVotingServer:                true,
+		PidFile:                        "43xN80Km",
+		PrimaryDatacenter:              "ejtmd43d",
+		PrimaryGateways:                []string{"aej8eeZo", "roh2KahS"},
+		PrimaryGatewaysInterval:        18866 * time.Second,
+		RPCAdvertiseAddr:               tcpAddr("17.99.29.16:3757"),
+		RPCBindAddr:                    tcpAddr("16.99.34.17:3757"),
+		RPCHandshakeTimeout:            1932 * time.Millisecond,
+		RPCHoldTimeout:                 15707 * time.Second,
+		RPCProtocol:                    30793,
+		RPCRateLimit:                   12029.43,
+		RPCMaxBurst:                    44848,
+		RPCMaxConnsPerClient:           2954,
+		RPCMaxBlockingQueriesPerClient: 4200,
+		SessionsPerNodeLimit:           8200,
+		RaftProtocol:                   19016,
+		RaftSnapshotThreshold:          16384,
+		RaftSnapshotInterval:           30 * time.Second,
+		RaftTrailingLogs:               83749,
+		ReconnectTimeoutLAN:            23739 * time.Second,
+		ReconnectTimeoutWAN:            26694 * time.Second,
+		RejoinAfterLeave:               true,
+		RetryJoinIntervalLAN:           8067 * time.Second,
+		RetryJoinIntervalWAN:           28866 * time.Second,
+		RetryJoinLAN:                   []string{"pbsSFY7U", "l0qLtWij"},
+		RetryJoinMaxAttemptsLAN:        913,
+		RetryJoinMaxAttemptsWAN:        23160,
+		RetryJoinWAN:                   []string{"PFsR02Ye", "rJdQIhER"},
+		RPCConfig:                      consul.RPCConfig{EnableStreaming: true},
+		SegmentName:                    "BC2NhTDi",
 		Segments: []structs.NetworkSegment{
 			{
 				Name:        "PExYMe2E",
@@ -6882,6 +7084,7 @@ func TestFullConfig(t *testing.T) {
 		SerfAdvertiseAddrWAN:     tcpAddr("78.63.37.19:8302"),
 		SerfBindAddrLAN:          tcpAddr("99.43.63.15:8301"),
 		SerfBindAddrWAN:          tcpAddr("67.88.33.19:8302"),
+		SerfWANGossipTLSEnabled:  true,
 		SerfAllowedCIDRsLAN:      []net.IPNet{},
 		SerfAllowedCIDRsWAN:      []net.IPNet{},
 		SessionTTLMin:            26627 * time.Second,
@@ -6906,6 +7109,10 @@ func TestFullConfig(t *testing.T) {
 			DisableHostname:                    true,
 			DogstatsdAddr:                      "0wSndumK",
 			DogstatsdTags:                      []string{"3N81zSUB", "Xtj8AnXZ"},
+			EnableCheckMetrics:                 true,
+			CheckMetricsMaxServices:            23005,
+			EnableIntentionMetrics:             true,
+			IntentionMetricsMaxElements:        13579,
 			FilterDefault:                      true,
 			AllowedPrefixes:                    []string{"oJotS8XJ"},
 			BlockedPrefixes:                    []string{"cazlEhGn"},
@@ -6913,6 +7120,9 @@ func TestFullConfig(t *testing.T) {
 			PrometheusRetentionTime:            15 * time.Second,
 			StatsdAddr:                         "drce87cy",
 			StatsiteAddr:                       "HpFwKB8R",
+			OTLPEndpoint:                       "otelcol.example.com:4318",
+			OTLPInsecure:                       true,
+			OTLPResourceAttributes:             []string{"cloud.region=us-east-1"},
 		},
 		TLSCipherSuites:             []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA, tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256},
 		TLSMinVersion:               "pAOWafkR",
@@ -7301,9 +7511,12 @@ func TestSanitize(t *testing.T) {
 		"ACLDisabledTTL": "0s",
 		"ACLDownPolicy": "",
 		"ACLEnableKeyListPolicy": false,
+		"ACLEnforceTokenScoping": false,
 		"ACLMasterToken": "hidden",
 		"ACLPolicyTTL": "0s",
 		"ACLRoleTTL": "0s",
+		"ACLTokenReapingBurst": 0,
+		"ACLTokenReapingRateLimit": 0,
 		"ACLTokenReplication": false,
 		"ACLTokenTTL": "0s",
 		"ACLsEnabled": false,
@@ -7336,14 +7549,18 @@ func TestSanitize(t *testing.T) {
 		"Checks": [{
 			"AliasNode": "",
 			"AliasService": "",
+			"AliasServiceName": "",
 			"DeregisterCriticalServiceAfter": "0s",
 			"DockerContainerID": "",
+			"DockerContainerLabel": "",
 			"EnterpriseMeta": ` + entMetaJSON + `,
 			"SuccessBeforePassing": 0,
 			"FailuresBeforeCritical": 0,
 			"GRPC": "",
 			"GRPCUseTLS": false,
 			"HTTP": "",
+			"HTTP2": false,
+			"HTTPReuseConnection": false,
 			"Header": {},
 			"ID": "",
 			"Interval": "0s",
@@ -7357,6 +7574,8 @@ func TestSanitize(t *testing.T) {
 			"Shell": "",
 			"Status": "",
 			"TCP": "",
+			"UDP": "",
+			"ICMP": "",
 			"TLSSkipVerify": false,
 			"TTL": "0s",
 			"Timeout": "0s",
@@ -7410,6 +7629,7 @@ func TestSanitize(t *testing.T) {
 		"DNSNodeTTL": "0s",
 		"DNSOnlyPassing": false,
 		"DNSPort": 0,
+		"DNSRecursorRules": {},
 		"DNSRecursorTimeout": "0s",
 		"DNSRecursors": [],
 		"DNSServiceTTL": {},
@@ -7435,6 +7655,7 @@ func TestSanitize(t *testing.T) {
 		"DisableUpdateCheck": false,
 		"DiscardCheckOutput": false,
 		"DiscoveryMaxStale": "0s",
+		"EdgeMemoryMode": false,
 		"EnableAgentTLSForChecks": false,
 		"EnableDebug": false,
 		"EnableCentralServiceConfig": false,
@@ -7444,6 +7665,7 @@ func TestSanitize(t *testing.T) {
 		"EncryptVerifyIncoming": false,
 		"EncryptVerifyOutgoing": false,
 		"EnterpriseRuntimeConfig": ` + entRuntimeConfigSanitize + `,
+		"EventPayloadSchemas": {},
 		"ExposeMaxPort": 0,
 		"ExposeMinPort": 0,
 		"GRPCAddrs": [],
@@ -7465,6 +7687,7 @@ func TestSanitize(t *testing.T) {
 		"LeaveDrainTime": "0s",
 		"LeaveOnTerm": false,
 		"Logging": {
+			"EnableEventLog": false,
 			"EnableSyslog": false,
 			"LogLevel": "",
 			"LogJSON": false,
@@ -7490,6 +7713,7 @@ func TestSanitize(t *testing.T) {
 		"RPCBindAddr": "",
 		"RPCHandshakeTimeout": "0s",
 		"RPCHoldTimeout": "0s",
+		"RPCMaxBlockingQueriesPerClient": 0,
 		"RPCMaxBurst": 0,
 		"RPCMaxConnsPerClient": 0,
 		"RPCProtocol": 0,
@@ -7501,6 +7725,10 @@ func TestSanitize(t *testing.T) {
 		"RaftSnapshotInterval": "0s",
 		"RaftSnapshotThreshold": 0,
 		"RaftTrailingLogs": 0,
+		"ReadyCheckACL": false,
+		"ReadyCheckSerf": false,
+		"ReadyCheckServer": false,
+		"ReadyCheckXDS": false,
 		"ReconnectTimeoutLAN": "0s",
 		"ReconnectTimeoutWAN": "0s",
 		"RejoinAfterLeave": false,
@@ -7527,6 +7755,7 @@ func TestSanitize(t *testing.T) {
 		"SerfBindAddrWAN": "",
 		"SerfPortLAN": 0,
 		"SerfPortWAN": 0,
+		"SerfWANGossipTLSEnabled": false,
 		"CacheUseStreamingBackend": false,
 		"ServerMode": false,
 		"ServerName": "",
@@ -7536,14 +7765,18 @@ func TestSanitize(t *testing.T) {
 			"Check": {
 				"AliasNode": "",
 				"AliasService": "",
+				"AliasServiceName": "",
 				"CheckID": "",
 				"DeregisterCriticalServiceAfter": "0s",
 				"DockerContainerID": "",
+				"DockerContainerLabel": "",
 				"SuccessBeforePassing": 0,
 				"FailuresBeforeCritical": 0,
 				"GRPC": "",
 				"GRPCUseTLS": false,
 				"HTTP": "",
+				"HTTP2": false,
+				"HTTPReuseConnection": false,
 				"Header": {},
 				"Interval": "0s",
 				"Method": "",
@@ -7557,18 +7790,22 @@ func TestSanitize(t *testing.T) {
 				"Shell": "",
 				"Status": "",
 				"TCP": "",
+				"UDP": "",
+				"ICMP": "",
 				"TLSSkipVerify": false,
 				"TTL": "0s",
 				"Timeout": "0s"
 			},
 			"Checks": [],
 			"Connect": null,
+			"DNSAddressPolicy": "",
 			"EnableTagOverride": false,
 			"EnterpriseMeta": ` + entMetaJSON + `,
 			"ID": "",
 			"Kind": "",
 			"Meta": {},
 			"Name": "foo",
+			"Owner": null,
 			"Port": 0,
 			"Proxy": null,
 			"TaggedAddresses": {},
@@ -7580,6 +7817,7 @@ func TestSanitize(t *testing.T) {
 			}
 		}],
 		"SessionTTLMin": "0s",
+		"SessionsPerNodeLimit": 0,
 		"SkipLeaveOnInt": false,
 		"StartJoinAddrsLAN": [],
 		"StartJoinAddrsWAN": [],
@@ -7592,6 +7830,7 @@ func TestSanitize(t *testing.T) {
 		"Telemetry": {
 			"AllowedPrefixes": [],
 			"BlockedPrefixes": [],
+			"CheckMetricsMaxServices": 0,
 			"CirconusAPIApp": "",
 			"CirconusAPIToken": "hidden",
 			"CirconusAPIURL": "",
@@ -7610,12 +7849,19 @@ func TestSanitize(t *testing.T) {
 			"DisableHostname": false,
 			"DogstatsdAddr": "",
 			"DogstatsdTags": [],
+			"EnableCheckMetrics": false,
+			"EnableIntentionMetrics": false,
 			"FilterDefault": false,
+			"IntentionMetricsMaxElements": 0,
 			"MetricsPrefix": "",
+			"OTLPEndpoint": "",
+			"OTLPInsecure": false,
+			"OTLPResourceAttributes": [],
 			"PrometheusRetentionTime": "0s",
 			"StatsdAddr": "",
 			"StatsiteAddr": ""
 		},
+		"TranslateAddressRules": [],
 		"TranslateWANAddrs": false,
 		"TxnMaxReqLen": 5678000000000000,
 		"UIConfig": {
@@ -7641,6 +7887,8 @@ func TestSanitize(t *testing.T) {
 		"VerifyServerHostname": false,
 		"Version": "",
 		"VersionPrerelease": "",
+		"WaitForACL": false,
+		"WaitForLeader": false,
 		"Watches": [],
 		"AllowWriteHTTPFrom": [
 			"127.0.0.0/8",
@@ -74,6 +74,36 @@ func (s *HTTPHandlers) configGet(resp http.ResponseWriter, req *http.Request) (i
 	}
 }
 
+// ConfigTagConformance reports the registered instances of a service whose
+// tags don't satisfy the tag schema configured on its service-defaults
+// entry, if any. It's meant to be checked before introducing or tightening
+// a schema so operators know what would start failing registration.
+func (s *HTTPHandlers) ConfigTagConformance(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	args := structs.ServiceTagConformanceRequest{}
+	if err := s.parseEntMetaForConfigEntryKind(structs.ServiceDefaults, req, &args.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	args.ServiceName = strings.TrimPrefix(req.URL.Path, "/v1/config/tag-conformance/")
+	if args.ServiceName == "" {
+		return nil, BadRequestError{Reason: "Missing service name"}
+	}
+
+	var reply structs.ServiceTagConformanceResponse
+	if err := s.agent.RPC("ConfigEntry.TagConformance", &args, &reply); err != nil {
+		return nil, err
+	}
+	setMeta(resp, &reply.QueryMeta)
+
+	if reply.NonConforming == nil {
+		reply.NonConforming = make([]structs.ServiceTagConformanceEntry, 0)
+	}
+	return reply.NonConforming, nil
+}
+
 // configDelete deletes the given config entry.
 func (s *HTTPHandlers) configDelete(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	var args structs.ConfigEntryRequest
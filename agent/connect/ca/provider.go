@@ -179,3 +179,11 @@ type NeedsLogger interface {
 type NeedsStop interface {
 	Stop()
 }
+
+// CRLGenerator is an optional interface that allows a CA provider to produce
+// a PEM-encoded CRL covering a set of revoked leaf certificate serial
+// numbers. Providers that can't sign a CRL on demand (e.g. because they
+// don't retain the root's private key) should not implement this.
+type CRLGenerator interface {
+	GenerateCRL(revokedSerials []string) (string, error)
+}
@@ -590,6 +590,53 @@ func (c *ConsulProvider) SupportsCrossSigning() (bool, error) {
 	return !c.config.DisableCrossSigning, nil
 }
 
+// GenerateCRL implements CRLGenerator. It returns a PEM-encoded CRL signed by
+// the root CA, listing the given revoked leaf certificate serial numbers, so
+// that it can be distributed to Connect proxies and rejected before the
+// certificates' TTLs expire.
+func (c *ConsulProvider) GenerateCRL(revokedSerials []string) (string, error) {
+	providerState, err := c.getState()
+	if err != nil {
+		return "", err
+	}
+	if providerState.PrivateKey == "" {
+		return "", fmt.Errorf("root key is not set, unable to generate CRL")
+	}
+
+	rootCert, err := connect.ParseCert(providerState.RootCert)
+	if err != nil {
+		return "", fmt.Errorf("error parsing root cert: %v", err)
+	}
+	signer, err := connect.ParseSigner(providerState.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("error parsing private key %q: %s", providerState.PrivateKey, err)
+	}
+
+	revoked := make([]pkix.RevokedCertificate, 0, len(revokedSerials))
+	for _, hexSerial := range revokedSerials {
+		serial, ok := new(big.Int).SetString(hexSerial, 16)
+		if !ok {
+			return "", fmt.Errorf("invalid serial number %q", hexSerial)
+		}
+		revoked = append(revoked, pkix.RevokedCertificate{
+			SerialNumber:   serial,
+			RevocationTime: time.Now(),
+		})
+	}
+
+	crlDER, err := rootCert.CreateCRL(rand.Reader, signer, revoked, time.Now(), time.Now().Add(24*time.Hour))
+	if err != nil {
+		return "", fmt.Errorf("error generating CRL: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := pem.Encode(&buf, &pem.Block{Type: "X509 CRL", Bytes: crlDER}); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
 // getState returns the current provider state from the state delegate, and returns
 // ErrNotInitialized if no entry is found.
 func (c *ConsulProvider) getState() (*structs.CAConsulProviderState, error) {
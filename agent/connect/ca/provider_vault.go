@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/logging"
@@ -71,16 +72,28 @@ func (v *VaultProvider) Configure(cfg ProviderConfig) error {
 		return err
 	}
 
-	client.SetToken(config.Token)
+	if config.Namespace != "" {
+		client.SetNamespace(config.Namespace)
+	}
+
 	v.config = config
 	v.client = client
 	v.isPrimary = cfg.IsPrimary
 	v.clusterID = cfg.ClusterID
 	v.spiffeID = connect.SpiffeIDSigningForCluster(&structs.CAConfiguration{ClusterID: v.clusterID})
 
+	if config.AuthMethod != nil {
+		if _, err := v.login(config.AuthMethod); err != nil {
+			return err
+		}
+	} else {
+		client.SetToken(config.Token)
+	}
+
 	// Look up the token to see if we can auto-renew its lease.
 	secret, err := client.Auth().Token().LookupSelf()
 	if err != nil {
+		metrics.IncrCounter([]string{"connect", "vault", "token", "lookup_failure"}, 1)
 		return err
 	}
 	var token struct {
@@ -91,12 +104,14 @@ func (v *VaultProvider) Configure(cfg ProviderConfig) error {
 		return err
 	}
 
+	metrics.SetGauge([]string{"connect", "vault", "token", "ttl"}, float32(token.TTL))
+
 	// Set up a renewer to renew the token automatically, if supported.
 	if token.Renewable {
 		lifetimeWatcher, err := client.NewLifetimeWatcher(&vaultapi.LifetimeWatcherInput{
 			Secret: &vaultapi.Secret{
 				Auth: &vaultapi.SecretAuth{
-					ClientToken:   config.Token,
+					ClientToken:   client.Token(),
 					Renewable:     token.Renewable,
 					LeaseDuration: secret.LeaseDuration,
 				},
@@ -116,10 +131,36 @@ func (v *VaultProvider) Configure(cfg ProviderConfig) error {
 	return nil
 }
 
+// login authenticates against the given auth method and sets the resulting
+// client token on the provider's Vault client.
+func (v *VaultProvider) login(method *structs.VaultAuthMethod) (*vaultapi.Secret, error) {
+	mountPath := method.MountPath
+	if mountPath == "" {
+		mountPath = "auth/" + method.Type
+	}
+
+	secret, err := v.client.Logical().Write(strings.TrimSuffix(mountPath, "/")+"/login", method.Params)
+	if err != nil {
+		metrics.IncrCounter([]string{"connect", "vault", "auth_method", "login_failure"}, 1)
+		return nil, fmt.Errorf("error login in to Vault via auth method %q: %v", method.Type, err)
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		metrics.IncrCounter([]string{"connect", "vault", "auth_method", "login_failure"}, 1)
+		return nil, fmt.Errorf("login response from Vault auth method %q did not return a client token", method.Type)
+	}
+
+	metrics.IncrCounter([]string{"connect", "vault", "auth_method", "login_success"}, 1)
+	v.client.SetToken(secret.Auth.ClientToken)
+	return secret, nil
+}
+
 // renewToken uses a vaultapi.Renewer to repeatedly renew our token's lease.
+// If the provider is configured with an AuthMethod and the token can no
+// longer be renewed, it re-authenticates to obtain a fresh token rather than
+// leaving the provider to fail signing requests with an expired token.
 func (v *VaultProvider) renewToken(ctx context.Context, watcher *vaultapi.LifetimeWatcher) {
 	go watcher.Start()
-	defer watcher.Stop()
+	defer func() { watcher.Stop() }()
 
 	for {
 		select {
@@ -129,13 +170,43 @@ func (v *VaultProvider) renewToken(ctx context.Context, watcher *vaultapi.Lifeti
 		case err := <-watcher.DoneCh():
 			if err != nil {
 				v.logger.Error("Error renewing token for Vault provider", "error", err)
+				metrics.IncrCounter([]string{"connect", "vault", "token", "renew_failure"}, 1)
 			}
 
-			// Watcher routine has finished, so start it again.
+			if v.config.AuthMethod == nil {
+				// Nothing we can do to get a new token, so just restart the
+				// watcher in case this was a transient renewal error.
+				go watcher.Start()
+				continue
+			}
+
+			secret, err := v.login(v.config.AuthMethod)
+			if err != nil {
+				v.logger.Error("Error logging in to Vault via auth method", "error", err)
+				go watcher.Start()
+				continue
+			}
+
+			// The old watcher is permanently bound to the lease of the
+			// token we just replaced, so it can't simply be restarted: per
+			// its own docs, DoneCh firing means the caller should re-read
+			// the secret and build a new watcher from it.
+			watcher.Stop()
+			newWatcher, err := v.client.NewLifetimeWatcher(&vaultapi.LifetimeWatcherInput{
+				Secret:        secret,
+				RenewBehavior: vaultapi.RenewBehaviorIgnoreErrors,
+			})
+			if err != nil {
+				v.logger.Error("Error starting Vault provider token renewal after re-authentication", "error", err)
+				go watcher.Start()
+				continue
+			}
+			watcher = newWatcher
 			go watcher.Start()
 
 		case <-watcher.RenewCh():
-			v.logger.Error("Successfully renewed token for Vault provider")
+			metrics.IncrCounter([]string{"connect", "vault", "token", "renew_success"}, 1)
+			v.logger.Trace("Successfully renewed token for Vault provider")
 		}
 	}
 }
@@ -554,8 +625,11 @@ func ParseVaultCAConfig(raw map[string]interface{}) (*structs.VaultCAProviderCon
 		return nil, fmt.Errorf("error decoding config: %s", err)
 	}
 
-	if config.Token == "" {
-		return nil, fmt.Errorf("must provide a Vault token")
+	if config.Token == "" && config.AuthMethod == nil {
+		return nil, fmt.Errorf("must provide a Vault token or an AuthMethod")
+	}
+	if config.AuthMethod != nil && config.AuthMethod.Type == "" {
+		return nil, fmt.Errorf("AuthMethod.Type is required")
 	}
 
 	if config.RootPKIPath == "" {
@@ -85,6 +85,66 @@ func TestVaultCAProvider_RenewToken(t *testing.T) {
 	})
 }
 
+// When the provider is configured with an AuthMethod and its token's lease
+// can no longer be renewed, the renewal loop must re-authenticate and build
+// a fresh LifetimeWatcher around the new token rather than getting stuck
+// restarting a watcher still bound to the old, dead lease.
+func TestVaultCAProvider_RenewToken_AuthMethodReauth(t *testing.T) {
+	t.Parallel()
+	SkipIfVaultNotPresent(t)
+
+	testVault, err := runTestVault(t)
+	require.NoError(t, err)
+	testVault.WaitUntilReady(t)
+
+	require.NoError(t, testVault.client.Sys().EnableAuthWithOptions("approle", &vaultapi.EnableAuthOptions{
+		Type: "approle",
+	}))
+
+	// Short-lived, non-renewable-past-max tokens so the watcher's DoneCh
+	// fires quickly and forces a re-login.
+	_, err = testVault.client.Logical().Write("auth/approle/role/consul-ca", map[string]interface{}{
+		"token_ttl":      "1s",
+		"token_max_ttl":  "1s",
+		"token_policies": "default",
+	})
+	require.NoError(t, err)
+
+	roleIDSecret, err := testVault.client.Logical().Read("auth/approle/role/consul-ca/role-id")
+	require.NoError(t, err)
+	roleID := roleIDSecret.Data["role_id"].(string)
+
+	secretIDSecret, err := testVault.client.Logical().Write("auth/approle/role/consul-ca/secret-id", nil)
+	require.NoError(t, err)
+	secretID := secretIDSecret.Data["secret_id"].(string)
+
+	provider, err := createVaultProvider(t, true, testVault.Addr, "", map[string]interface{}{
+		"Token": "",
+		"AuthMethod": map[string]interface{}{
+			"Type": "approle",
+			"Params": map[string]interface{}{
+				"role_id":   roleID,
+				"secret_id": secretID,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	firstToken := provider.client.Token()
+	require.NotEmpty(t, firstToken)
+
+	// Once the first token's lease expires and can't be renewed further,
+	// the provider should have logged back in and obtained a new one.
+	retry.Run(t, func(r *retry.R) {
+		require.NotEqual(r, firstToken, provider.client.Token())
+	})
+
+	// The new token must actually be usable, confirming the watcher wasn't
+	// left pointed at a dead lease.
+	_, err = provider.client.Auth().Token().LookupSelf()
+	require.NoError(t, err)
+}
+
 func TestVaultCAProvider_Bootstrap(t *testing.T) {
 	t.Parallel()
 
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	metrics "github.com/armon/go-metrics"
+
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/cache"
 	cachetype "github.com/hashicorp/consul/agent/cache-types"
 	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/consul"
 	"github.com/hashicorp/consul/agent/structs"
 )
 
@@ -45,17 +48,42 @@ func (a *Agent) ConnectAuthorize(token string,
 		return returnErr(BadRequestError{"Target service must be specified"})
 	}
 
-	// Parse the certificate URI from the client ID
-	uri, err := connect.ParseCertURIFromString(req.ClientCertURI)
-	if err != nil {
-		return returnErr(BadRequestError{"ClientCertURI not a valid Connect identifier"})
+	// The source is identified either by an mTLS client certificate or by
+	// an auth method login, never both.
+	if req.SourceAuthMethod != "" && req.ClientCertURI != "" {
+		return returnErr(BadRequestError{"ClientCertURI and SourceAuthMethod are mutually exclusive"})
 	}
 
-	uriService, ok := uri.(*connect.SpiffeIDService)
-	if !ok {
-		return returnErr(BadRequestError{"ClientCertURI not a valid Service identifier"})
+	var uriService *connect.SpiffeIDService
+	if req.SourceAuthMethod == "" {
+		// Parse the certificate URI from the client ID
+		uri, err := connect.ParseCertURIFromString(req.ClientCertURI)
+		if err != nil {
+			return returnErr(BadRequestError{"ClientCertURI not a valid Connect identifier"})
+		}
+
+		var ok bool
+		uriService, ok = uri.(*connect.SpiffeIDService)
+		if !ok {
+			return returnErr(BadRequestError{"ClientCertURI not a valid Service identifier"})
+		}
 	}
 
+	// Emit an allow/deny decision metric for every decision this method
+	// reaches, labeled by source and destination service so operators can
+	// monitor denied-connection attempts across the mesh. This only covers
+	// decisions that actually get this far; malformed requests and ACL
+	// failures above are not intentions decisions.
+	defer func() {
+		if err == nil {
+			source := req.SourceAuthMethod
+			if uriService != nil {
+				source = uriService.Service
+			}
+			a.emitConnectAuthorizeMetric(source, req.Target, allowed)
+		}
+	}()
+
 	// We need to verify service:write permissions for the given token.
 	// We do this manually here since the RPC request below only verifies
 	// service:read.
@@ -105,6 +133,20 @@ func (a *Agent) ConnectAuthorize(token string,
 	// Figure out which source matches this request.
 	var ixnMatch *structs.Intention
 	for _, ixn := range reply.Matches[0] {
+		if req.SourceAuthMethod != "" {
+			if ixn.SourceType != structs.IntentionSourceAuthMethod {
+				continue
+			}
+			if ixn.SourceAuthMethod != req.SourceAuthMethod {
+				continue
+			}
+			if !consul.DoesSelectorMatch(ixn.SourceSelector, req.SourceSelectorVars) {
+				continue
+			}
+			ixnMatch = ixn
+			break
+		}
+
 		if _, ok := uriService.Authorize(ixn); ok {
 			ixnMatch = ixn
 			break
@@ -135,3 +177,27 @@ func (a *Agent) ConnectAuthorize(token string,
 	reason = "Default behavior configured by ACLs"
 	return authz.IntentionDefaultAllow(nil) == acl.Allow, reason, &meta, nil
 }
+
+// emitConnectAuthorizeMetric increments a counter for a single intention
+// allow/deny decision made by ConnectAuthorize, labeled by source and
+// destination service. source and destination are bounded by
+// a.intentionMetricsCardinality (when telemetry.enable_intention_metrics is
+// set) so a large or adversarial mesh can't create unbounded metric series;
+// beyond the cap, decisions are reported under a shared "other" pair.
+func (a *Agent) emitConnectAuthorizeMetric(source, destination string, allowed bool) {
+	if a.intentionMetricsCardinality == nil {
+		return
+	}
+
+	source, destination = a.intentionMetricsCardinality.LabelPair(source, destination)
+
+	action := "denied"
+	if allowed {
+		action = "allowed"
+	}
+	metrics.IncrCounterWithLabels([]string{"acl", "connect_authorize", action}, 1,
+		[]metrics.Label{
+			{Name: "source", Value: source},
+			{Name: "destination", Value: destination},
+		})
+}
@@ -105,3 +105,22 @@ func (s *HTTPHandlers) ConnectCAConfigurationSet(resp http.ResponseWriter, req *
 	}
 	return nil, err
 }
+
+// PUT /v1/connect/ca/leaf/revoke
+func (s *HTTPHandlers) ConnectCARevokeLeaf(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.CARevokeLeafRequest
+	s.parseDC(req, &args.Datacenter)
+	s.parseToken(req, &args.Token)
+	if err := decodeBody(req.Body, &args); err != nil {
+		return nil, BadRequestError{
+			Reason: fmt.Sprintf("Request decode failed: %v", err),
+		}
+	}
+	if args.SerialNumber == "" {
+		return nil, BadRequestError{Reason: "SerialNumber is required"}
+	}
+
+	var reply struct{}
+	err := s.agent.RPC("ConnectCA.Revoke", &args, &reply)
+	return nil, err
+}
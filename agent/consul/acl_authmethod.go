@@ -64,7 +64,7 @@ func (s *Server) evaluateRoleBindings(
 	// Find all binding rules that match the provided fields.
 	var matchingRules []*structs.ACLBindingRule
 	for _, rule := range rules {
-		if doesSelectorMatch(rule.Selector, verifiedIdentity.SelectableFields) {
+		if DoesSelectorMatch(rule.Selector, verifiedIdentity.SelectableFields) {
 			matchingRules = append(matchingRules, rule)
 		}
 	}
@@ -114,8 +114,8 @@ func (s *Server) evaluateRoleBindings(
 	return &bindings, nil
 }
 
-// doesSelectorMatch checks that a single selector matches the provided vars.
-func doesSelectorMatch(selector string, selectableVars interface{}) bool {
+// DoesSelectorMatch checks that a single selector matches the provided vars.
+func DoesSelectorMatch(selector string, selectableVars interface{}) bool {
 	if selector == "" {
 		return true // catch-all
 	}
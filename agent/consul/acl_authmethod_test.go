@@ -39,7 +39,7 @@ func TestDoesSelectorMatch(t *testing.T) {
 			"", &matchable{A: "b"}, true},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			ok := doesSelectorMatch(test.selector, test.details)
+			ok := DoesSelectorMatch(test.selector, test.details)
 			require.Equal(t, test.ok, ok)
 		})
 	}
@@ -335,7 +335,7 @@ func (a *ACL) TokenClone(args *structs.ACLTokenSetRequest, reply *structs.ACLTok
 		cloneReq.ACLToken.Description = args.ACLToken.Description
 	}
 
-	return a.tokenSetInternal(&cloneReq, reply, false)
+	return a.tokenSetInternal(&cloneReq, reply, false, authz)
 }
 
 func (a *ACL) TokenSet(args *structs.ACLTokenSetRequest, reply *structs.ACLToken) error {
@@ -362,16 +362,17 @@ func (a *ACL) TokenSet(args *structs.ACLTokenSetRequest, reply *structs.ACLToken
 
 	// Verify token is permitted to modify ACLs
 	var authzContext acl.AuthorizerContext
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.ACLToken.EnterpriseMeta, &authzContext); err != nil {
+	authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.ACLToken.EnterpriseMeta, &authzContext)
+	if err != nil {
 		return err
 	} else if authz == nil || authz.ACLWrite(&authzContext) != acl.Allow {
 		return acl.ErrPermissionDenied
 	}
 
-	return a.tokenSetInternal(args, reply, false)
+	return a.tokenSetInternal(args, reply, false, authz)
 }
 
-func (a *ACL) tokenSetInternal(args *structs.ACLTokenSetRequest, reply *structs.ACLToken, fromLogin bool) error {
+func (a *ACL) tokenSetInternal(args *structs.ACLTokenSetRequest, reply *structs.ACLToken, fromLogin bool, writerAuthz acl.Authorizer) error {
 	token := &args.ACLToken
 
 	if !a.srv.LocalTokensEnabled() {
@@ -640,6 +641,16 @@ func (a *ACL) tokenSetInternal(args *structs.ACLTokenSetRequest, reply *structs.
 		return fmt.Errorf("Type cannot be specified for this token")
 	}
 
+	if a.srv.config.ACLEnforceTokenScoping && !fromLogin && writerAuthz != nil {
+		policies, err := a.srv.acls.resolvePoliciesForIdentity(token)
+		if err != nil {
+			return err
+		}
+		if err := enforceTokenScopeOnWrite(writerAuthz, a.srv.aclConfig, policies); err != nil {
+			return err
+		}
+	}
+
 	token.SetHash(true)
 
 	// validate the enterprise meta
@@ -683,6 +694,143 @@ func (a *ACL) tokenSetInternal(args *structs.ACLTokenSetRequest, reply *structs.
 	return nil
 }
 
+// enforceTokenScopeOnWrite checks that every rule granted by policies (the
+// effective policy set of a token or policy being written) is already
+// permitted by writerAuthz, the authorizer of the token performing the
+// write. It is used to implement ACLEnforceTokenScoping, which closes the
+// privilege-escalation path where acl:write is otherwise equivalent to
+// global management.
+//
+// Each rule is checked individually with acl.Enforce rather than by
+// comparing the two authorizers as a whole, since a compiled acl.Authorizer
+// does not expose its rules for inspection. This is an approximation: a
+// writer whose own rules cover the same ground through a differently shaped
+// rule (e.g. a broader prefix) will still be rejected, but a write is never
+// allowed to grant access the writer could not itself exercise.
+func enforceTokenScopeOnWrite(writerAuthz acl.Authorizer, aclConf *acl.Config, policies structs.ACLPolicies) error {
+	for _, policy := range policies {
+		parsed, err := acl.NewPolicyFromSource(policy.ID, policy.ModifyIndex, policy.Rules, policy.Syntax, aclConf, policy.EnterprisePolicyMeta())
+		if err != nil {
+			return fmt.Errorf("failed to parse policy %q while checking token scoping: %v", policy.Name, err)
+		}
+		if err := enforcePolicyRulesWithinScope(writerAuthz, parsed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enforcePolicyRulesWithinScope checks every rule of a single parsed policy
+// against writerAuthz. See enforceTokenScopeOnWrite for the rationale.
+func enforcePolicyRulesWithinScope(writerAuthz acl.Authorizer, policy *acl.Policy) error {
+	var authzContext acl.AuthorizerContext
+
+	check := func(rsc acl.Resource, segment, access string) error {
+		if access == "" || access == "deny" {
+			return nil
+		}
+		decision, err := acl.Enforce(writerAuthz, rsc, segment, access, &authzContext)
+		if err != nil {
+			return err
+		}
+		if decision != acl.Allow {
+			return acl.PermissionDeniedError{
+				Cause: fmt.Sprintf("token scoping: granting %s access to %s %q exceeds the permissions of the token performing the write", access, rsc, segment),
+			}
+		}
+		return nil
+	}
+
+	if err := check(acl.ResourceACL, "", policy.ACL); err != nil {
+		return err
+	}
+	if err := check(acl.ResourceKeyring, "", policy.Keyring); err != nil {
+		return err
+	}
+	if err := check(acl.ResourceOperator, "", policy.Operator); err != nil {
+		return err
+	}
+
+	for _, r := range policy.Agents {
+		if err := check(acl.ResourceAgent, r.Node, r.Policy); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.AgentPrefixes {
+		if err := check(acl.ResourceAgent, r.Node, r.Policy); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.Keys {
+		if err := check(acl.ResourceKey, r.Prefix, r.Policy); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.KeyPrefixes {
+		if err := check(acl.ResourceKey, r.Prefix, r.Policy); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.Nodes {
+		if err := check(acl.ResourceNode, r.Name, r.Policy); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.NodePrefixes {
+		if err := check(acl.ResourceNode, r.Name, r.Policy); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.Services {
+		if err := check(acl.ResourceService, r.Name, r.Policy); err != nil {
+			return err
+		}
+		if err := check(acl.ResourceIntention, r.Name, r.Intentions); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.ServicePrefixes {
+		if err := check(acl.ResourceService, r.Name, r.Policy); err != nil {
+			return err
+		}
+		if err := check(acl.ResourceIntention, r.Name, r.Intentions); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.Sessions {
+		if err := check(acl.ResourceSession, r.Node, r.Policy); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.SessionPrefixes {
+		if err := check(acl.ResourceSession, r.Node, r.Policy); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.Events {
+		if err := check(acl.ResourceEvent, r.Event, r.Policy); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.EventPrefixes {
+		if err := check(acl.ResourceEvent, r.Event, r.Policy); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.PreparedQueries {
+		if err := check(acl.ResourceQuery, r.Prefix, r.Policy); err != nil {
+			return err
+		}
+	}
+	for _, r := range policy.PreparedQueryPrefixes {
+		if err := check(acl.ResourceQuery, r.Prefix, r.Policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func validateBindingRuleBindName(bindType, bindName string, availableFields []string) (bool, error) {
 	if bindType == "" || bindName == "" {
 		return false, nil
@@ -1056,7 +1204,8 @@ func (a *ACL) PolicySet(args *structs.ACLPolicySetRequest, reply *structs.ACLPol
 	// Verify token is permitted to modify ACLs
 	var authzContext acl.AuthorizerContext
 
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.Policy.EnterpriseMeta, &authzContext); err != nil {
+	authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.Policy.EnterpriseMeta, &authzContext)
+	if err != nil {
 		return err
 	} else if authz == nil || authz.ACLWrite(&authzContext) != acl.Allow {
 		return acl.ErrPermissionDenied
@@ -1080,7 +1229,6 @@ func (a *ACL) PolicySet(args *structs.ACLPolicySetRequest, reply *structs.ACLPol
 
 	var idMatch *structs.ACLPolicy
 	var nameMatch *structs.ACLPolicy
-	var err error
 
 	if policy.ID != "" {
 		if _, err := uuid.ParseUUID(policy.ID); err != nil {
@@ -1132,11 +1280,17 @@ func (a *ACL) PolicySet(args *structs.ACLPolicySetRequest, reply *structs.ACLPol
 	}
 
 	// validate the rules
-	_, err = acl.NewPolicyFromSource("", 0, policy.Rules, policy.Syntax, a.srv.aclConfig, policy.EnterprisePolicyMeta())
+	parsed, err := acl.NewPolicyFromSource("", 0, policy.Rules, policy.Syntax, a.srv.aclConfig, policy.EnterprisePolicyMeta())
 	if err != nil {
 		return err
 	}
 
+	if a.srv.config.ACLEnforceTokenScoping {
+		if err := enforcePolicyRulesWithinScope(authz, parsed); err != nil {
+			return err
+		}
+	}
+
 	// validate the enterprise meta
 	err = state.ACLPolicyUpsertValidateEnterprise(policy, idMatch)
 	if err != nil {
@@ -1498,7 +1652,8 @@ func (a *ACL) RoleSet(args *structs.ACLRoleSetRequest, reply *structs.ACLRole) e
 	// Verify token is permitted to modify ACLs
 	var authzContext acl.AuthorizerContext
 
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.Role.EnterpriseMeta, &authzContext); err != nil {
+	authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.Role.EnterpriseMeta, &authzContext)
+	if err != nil {
 		return err
 	} else if authz == nil || authz.ACLWrite(&authzContext) != acl.Allow {
 		return acl.ErrPermissionDenied
@@ -1521,7 +1676,6 @@ func (a *ACL) RoleSet(args *structs.ACLRoleSetRequest, reply *structs.ACLRole) e
 	}
 
 	var existing *structs.ACLRole
-	var err error
 	if role.ID == "" {
 		// with no role ID one will be generated
 		role.ID, err = lib.GenerateUUID(a.srv.checkRoleUUID)
@@ -1612,6 +1766,26 @@ func (a *ACL) RoleSet(args *structs.ACLRoleSetRequest, reply *structs.ACLRole) e
 	}
 	role.NodeIdentities = dedupeNodeIdentities(role.NodeIdentities)
 
+	if a.srv.config.ACLEnforceTokenScoping {
+		var linkedPolicies structs.ACLPolicies
+		for _, link := range role.Policies {
+			_, policy, err := state.ACLPolicyGetByID(nil, link.ID, &role.EnterpriseMeta)
+			if err != nil {
+				return fmt.Errorf("Error looking up policy for id %q: %v", link.ID, err)
+			}
+			if policy == nil {
+				return fmt.Errorf("No such ACL policy with ID %q", link.ID)
+			}
+			linkedPolicies = append(linkedPolicies, policy)
+		}
+		linkedPolicies = append(linkedPolicies, a.srv.acls.synthesizePoliciesForServiceIdentities(role.ServiceIdentities, &role.EnterpriseMeta)...)
+		linkedPolicies = append(linkedPolicies, a.srv.acls.synthesizePoliciesForNodeIdentities(role.NodeIdentities)...)
+
+		if err := enforceTokenScopeOnWrite(authz, a.srv.aclConfig, linkedPolicies); err != nil {
+			return err
+		}
+	}
+
 	// calculate the hash for this role
 	role.SetHash(true)
 
@@ -2448,7 +2622,7 @@ func (a *ACL) tokenSetFromAuthMethod(
 	createReq.ACLToken.ACLAuthMethodEnterpriseMeta.FillWithEnterpriseMeta(entMeta)
 
 	// 5. return token information like a TokenCreate would
-	err = a.tokenSetInternal(createReq, reply, true)
+	err = a.tokenSetInternal(createReq, reply, true, nil)
 
 	// If we were in a slight race with a role delete operation then we may
 	// still end up failing to insert an unprivileged token in the state
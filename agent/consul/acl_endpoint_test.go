@@ -1401,6 +1401,195 @@ func TestACLEndpoint_TokenSet(t *testing.T) {
 	})
 }
 
+func TestACLEndpoint_TokenSet_EnforceTokenScoping(t *testing.T) {
+	t.Parallel()
+
+	_, srv, codec := testACLServerWithConfig(t, func(c *Config) {
+		c.ACLEnforceTokenScoping = true
+	}, false)
+	waitForLeaderEstablishment(t, srv)
+
+	aclEndpoint := ACL{srv: srv}
+
+	writerToken, err := upsertTestTokenWithPolicyRules(codec, TestDefaultMasterToken, "dc1", `
+		acl = "write"
+		node "foo" { policy = "write" }
+	`)
+	require.NoError(t, err)
+
+	t.Run("within scope is allowed", func(t *testing.T) {
+		policy, err := upsertTestPolicyWithRules(codec, TestDefaultMasterToken, "dc1", `
+			node "foo" { policy = "write" }
+		`)
+		require.NoError(t, err)
+
+		req := structs.ACLTokenSetRequest{
+			Datacenter: "dc1",
+			ACLToken: structs.ACLToken{
+				Description: "scoped-ok",
+				Policies:    []structs.ACLTokenPolicyLink{{ID: policy.ID}},
+			},
+			WriteRequest: structs.WriteRequest{Token: writerToken.SecretID},
+		}
+
+		resp := structs.ACLToken{}
+		err = aclEndpoint.TokenSet(&req, &resp)
+		require.NoError(t, err)
+	})
+
+	t.Run("beyond scope is denied", func(t *testing.T) {
+		policy, err := upsertTestPolicyWithRules(codec, TestDefaultMasterToken, "dc1", `
+			node "bar" { policy = "write" }
+		`)
+		require.NoError(t, err)
+
+		req := structs.ACLTokenSetRequest{
+			Datacenter: "dc1",
+			ACLToken: structs.ACLToken{
+				Description: "scoped-bad",
+				Policies:    []structs.ACLTokenPolicyLink{{ID: policy.ID}},
+			},
+			WriteRequest: structs.WriteRequest{Token: writerToken.SecretID},
+		}
+
+		resp := structs.ACLToken{}
+		err = aclEndpoint.TokenSet(&req, &resp)
+		require.True(t, acl.IsErrPermissionDenied(err), "Err %v is not acl.PermissionDenied", err)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		_, srv, codec := testACLServerWithConfig(t, nil, false)
+		waitForLeaderEstablishment(t, srv)
+		aclEndpoint := ACL{srv: srv}
+
+		writerToken, err := upsertTestTokenWithPolicyRules(codec, TestDefaultMasterToken, "dc1", `
+			acl = "write"
+			node "foo" { policy = "write" }
+		`)
+		require.NoError(t, err)
+
+		policy, err := upsertTestPolicyWithRules(codec, TestDefaultMasterToken, "dc1", `
+			node "bar" { policy = "write" }
+		`)
+		require.NoError(t, err)
+
+		req := structs.ACLTokenSetRequest{
+			Datacenter: "dc1",
+			ACLToken: structs.ACLToken{
+				Description: "no-scoping-enforced",
+				Policies:    []structs.ACLTokenPolicyLink{{ID: policy.ID}},
+			},
+			WriteRequest: structs.WriteRequest{Token: writerToken.SecretID},
+		}
+
+		resp := structs.ACLToken{}
+		err = aclEndpoint.TokenSet(&req, &resp)
+		require.NoError(t, err)
+	})
+}
+
+func TestACLEndpoint_RoleSet_EnforceTokenScoping(t *testing.T) {
+	t.Parallel()
+
+	_, srv, codec := testACLServerWithConfig(t, func(c *Config) {
+		c.ACLEnforceTokenScoping = true
+	}, false)
+	waitForLeaderEstablishment(t, srv)
+
+	aclEndpoint := ACL{srv: srv}
+
+	writerToken, err := upsertTestTokenWithPolicyRules(codec, TestDefaultMasterToken, "dc1", `
+		acl = "write"
+		node "foo" { policy = "write" }
+	`)
+	require.NoError(t, err)
+
+	t.Run("within scope is allowed", func(t *testing.T) {
+		policy, err := upsertTestPolicyWithRules(codec, TestDefaultMasterToken, "dc1", `
+			node "foo" { policy = "write" }
+		`)
+		require.NoError(t, err)
+
+		req := structs.ACLRoleSetRequest{
+			Datacenter: "dc1",
+			Role: structs.ACLRole{
+				Name:     "scoped-ok",
+				Policies: []structs.ACLRolePolicyLink{{ID: policy.ID}},
+			},
+			WriteRequest: structs.WriteRequest{Token: writerToken.SecretID},
+		}
+
+		resp := structs.ACLRole{}
+		err = aclEndpoint.RoleSet(&req, &resp)
+		require.NoError(t, err)
+	})
+
+	t.Run("beyond scope is denied", func(t *testing.T) {
+		policy, err := upsertTestPolicyWithRules(codec, TestDefaultMasterToken, "dc1", `
+			node "bar" { policy = "write" }
+		`)
+		require.NoError(t, err)
+
+		req := structs.ACLRoleSetRequest{
+			Datacenter: "dc1",
+			Role: structs.ACLRole{
+				Name:     "scoped-bad",
+				Policies: []structs.ACLRolePolicyLink{{ID: policy.ID}},
+			},
+			WriteRequest: structs.WriteRequest{Token: writerToken.SecretID},
+		}
+
+		resp := structs.ACLRole{}
+		err = aclEndpoint.RoleSet(&req, &resp)
+		require.True(t, acl.IsErrPermissionDenied(err), "Err %v is not acl.PermissionDenied", err)
+	})
+
+	t.Run("global-management policy link is denied", func(t *testing.T) {
+		req := structs.ACLRoleSetRequest{
+			Datacenter: "dc1",
+			Role: structs.ACLRole{
+				Name:     "escalate-via-global-management",
+				Policies: []structs.ACLRolePolicyLink{{ID: structs.ACLPolicyGlobalManagementID}},
+			},
+			WriteRequest: structs.WriteRequest{Token: writerToken.SecretID},
+		}
+
+		resp := structs.ACLRole{}
+		err = aclEndpoint.RoleSet(&req, &resp)
+		require.True(t, acl.IsErrPermissionDenied(err), "Err %v is not acl.PermissionDenied", err)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		_, srv, codec := testACLServerWithConfig(t, nil, false)
+		waitForLeaderEstablishment(t, srv)
+		aclEndpoint := ACL{srv: srv}
+
+		writerToken, err := upsertTestTokenWithPolicyRules(codec, TestDefaultMasterToken, "dc1", `
+			acl = "write"
+			node "foo" { policy = "write" }
+		`)
+		require.NoError(t, err)
+
+		policy, err := upsertTestPolicyWithRules(codec, TestDefaultMasterToken, "dc1", `
+			node "bar" { policy = "write" }
+		`)
+		require.NoError(t, err)
+
+		req := structs.ACLRoleSetRequest{
+			Datacenter: "dc1",
+			Role: structs.ACLRole{
+				Name:     "no-scoping-enforced",
+				Policies: []structs.ACLRolePolicyLink{{ID: policy.ID}},
+			},
+			WriteRequest: structs.WriteRequest{Token: writerToken.SecretID},
+		}
+
+		resp := structs.ACLRole{}
+		err = aclEndpoint.RoleSet(&req, &resp)
+		require.NoError(t, err)
+	})
+}
+
 func TestACLEndpoint_TokenSet_CustomID(t *testing.T) {
 	t.Parallel()
 
@@ -102,6 +102,18 @@ func (s *Server) updateACLAdvertisement() {
 	s.updateSerfTags("acls", string(structs.ACLModeEnabled))
 }
 
+// canUpgradeToNewACLs is the original, pre-dating serf-tag capability check
+// that motivated the featureUpgrade framework in feature_rollout.go, but it
+// isn't rebuilt on top of checkFeatureUpgrade/serversSupportFeature: those
+// assume a single server-side bool ("does every server support X?") that
+// gets latched and activated in one call, whereas this aggregates
+// ServersGetACLMode's tri-state structs.ACLMode (disabled/legacy/enabled)
+// together with separate leader-specific tracking, and the resulting
+// s.useNewACLs flag is latched by the two callers in leader.go rather than
+// by this predicate itself. Forcing that mismatch onto the binary framework
+// would lose the leader/mode distinction, so this keeps its own logic and
+// is registered with registerFeatureUpgrade only so its status is visible
+// alongside the newer features.
 func (s *Server) canUpgradeToNewACLs(isLeader bool) bool {
 	if atomic.LoadInt32(&s.useNewACLs) != 0 {
 		// can't upgrade because we are already upgraded
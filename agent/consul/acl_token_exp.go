@@ -10,7 +10,7 @@ import (
 )
 
 func (s *Server) reapExpiredTokens(ctx context.Context) error {
-	limiter := rate.NewLimiter(aclTokenReapingRateLimit, aclTokenReapingBurst)
+	limiter := rate.NewLimiter(s.config.ACLTokenReapingRateLimit, s.config.ACLTokenReapingBurst)
 	for {
 		if err := limiter.Wait(ctx); err != nil {
 			return err
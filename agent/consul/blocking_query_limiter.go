@@ -0,0 +1,72 @@
+package consul
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrTooManyBlockingQueries is returned by blockingQuery when the calling
+// token (or the anonymous bucket, if it didn't provide one) has already hit
+// its limit on concurrent blocking queries. It's distinguishable from other
+// RPC errors so operators and client logs can tell a buggy client piling up
+// watches apart from an actual server-side failure.
+var ErrTooManyBlockingQueries = errors.New("rejecting blocking query, too many outstanding blocking queries for this token")
+
+// blockingQueryLimiter bounds how many blocking queries a single ACL token
+// may hold open against this server at once. Without it, a client that
+// leaks watches (reconnecting without cancelling the old ones, say) can
+// accumulate tens of thousands of blocked goroutines over time; capping per
+// token makes the offending client identifiable and keeps it from starving
+// every other client of server resources.
+type blockingQueryLimiter struct {
+	maxPerClient int
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func newBlockingQueryLimiter(maxPerClient int) *blockingQueryLimiter {
+	return &blockingQueryLimiter{
+		maxPerClient: maxPerClient,
+		counts:       make(map[string]int),
+	}
+}
+
+// acquire reserves a slot for key, returning ErrTooManyBlockingQueries if
+// doing so would exceed maxPerClient. A limit of zero or less disables
+// enforcement entirely. Every successful acquire must be paired with a call
+// to release.
+func (l *blockingQueryLimiter) acquire(key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.maxPerClient <= 0 {
+		return nil
+	}
+	if l.counts[key] >= l.maxPerClient {
+		return ErrTooManyBlockingQueries
+	}
+	l.counts[key]++
+	return nil
+}
+
+// release frees the slot reserved by a prior successful call to acquire
+// with the same key.
+func (l *blockingQueryLimiter) release(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.counts[key] == 0 {
+		return
+	}
+	l.counts[key]--
+	if l.counts[key] <= 0 {
+		delete(l.counts, key)
+	}
+}
+
+// SetMaxPerClient updates the limit applied to future calls to acquire.
+// Queries already holding a slot are unaffected.
+func (l *blockingQueryLimiter) SetMaxPerClient(maxPerClient int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.maxPerClient = maxPerClient
+}
@@ -0,0 +1,26 @@
+package consul
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockingQueryLimiter(t *testing.T) {
+	l := newBlockingQueryLimiter(2)
+
+	require.NoError(t, l.acquire("tok"))
+	require.NoError(t, l.acquire("tok"))
+	require.Equal(t, ErrTooManyBlockingQueries, l.acquire("tok"))
+
+	// A different key isn't affected by "tok"'s count.
+	require.NoError(t, l.acquire("other"))
+
+	l.release("tok")
+	require.NoError(t, l.acquire("tok"))
+
+	l.SetMaxPerClient(0)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, l.acquire("tok"))
+	}
+}
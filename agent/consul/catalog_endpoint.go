@@ -85,6 +85,26 @@ func servicePreApply(service *structs.NodeService, authz acl.Authorizer) error {
 	return nil
 }
 
+// validateServiceTagSchema rejects the registration if the service's
+// service-defaults config entry constrains tags via TagSchema and the
+// service is registering with tags outside it.
+func validateServiceTagSchema(state *state.Store, service *structs.NodeService, entMeta *structs.EnterpriseMeta) error {
+	_, entry, err := state.ConfigEntry(nil, structs.ServiceDefaults, service.Service, entMeta)
+	if err != nil {
+		return fmt.Errorf("Failed to look up service-defaults for %q: %v", service.Service, err)
+	}
+
+	svcDefaults, ok := entry.(*structs.ServiceConfigEntry)
+	if !ok || svcDefaults.TagSchema == nil {
+		return nil
+	}
+
+	if bad := svcDefaults.TagSchema.NonConformingTags(service.Tags); len(bad) > 0 {
+		return fmt.Errorf("Service %q tags %v do not conform to the tag schema configured in its service-defaults entry", service.Service, bad)
+	}
+	return nil
+}
+
 // checkPreApply does the verification of a check before it is applied to Raft.
 func checkPreApply(check *structs.HealthCheck) {
 	if check.CheckID == "" && check.Name != "" {
@@ -93,7 +113,7 @@ func checkPreApply(check *structs.HealthCheck) {
 }
 
 // Register is used register that a node is providing a given service.
-func (c *Catalog) Register(args *structs.RegisterRequest, reply *struct{}) error {
+func (c *Catalog) Register(args *structs.RegisterRequest, reply *structs.WriteIndexResponse) error {
 	if done, err := c.srv.ForwardRPC("Catalog.Register", args, args, reply); done {
 		return err
 	}
@@ -130,6 +150,9 @@ func (c *Catalog) Register(args *structs.RegisterRequest, reply *struct{}) error
 		if err := servicePreApply(args.Service, authz); err != nil {
 			return err
 		}
+		if err := validateServiceTagSchema(state, args.Service, entMeta); err != nil {
+			return err
+		}
 	}
 
 	// Move the old format single check into the slice, and fixup IDs.
@@ -163,18 +186,19 @@ func (c *Catalog) Register(args *structs.RegisterRequest, reply *struct{}) error
 		}
 	}
 
-	resp, err := c.srv.raftApply(structs.RegisterRequestType, args)
+	resp, index, err := c.srv.raftApplyMsgpackWithIndex(structs.RegisterRequestType, args)
 	if err != nil {
 		return err
 	}
 	if respErr, ok := resp.(error); ok {
 		return respErr
 	}
+	reply.Index = index
 	return nil
 }
 
 // Deregister is used to remove a service registration for a given node.
-func (c *Catalog) Deregister(args *structs.DeregisterRequest, reply *struct{}) error {
+func (c *Catalog) Deregister(args *structs.DeregisterRequest, reply *structs.WriteIndexResponse) error {
 	if done, err := c.srv.ForwardRPC("Catalog.Deregister", args, args, reply); done {
 		return err
 	}
@@ -221,9 +245,14 @@ func (c *Catalog) Deregister(args *structs.DeregisterRequest, reply *struct{}) e
 
 	}
 
-	if _, err := c.srv.raftApply(structs.DeregisterRequestType, args); err != nil {
+	resp, index, err := c.srv.raftApplyMsgpackWithIndex(structs.DeregisterRequestType, args)
+	if err != nil {
 		return err
 	}
+	if respErr, ok := resp.(error); ok {
+		return respErr
+	}
+	reply.Index = index
 	return nil
 }
 
@@ -238,7 +267,25 @@ func (c *Catalog) ListDatacenters(args *structs.DatacentersRequest, reply *[]str
 		dcs = []string{c.srv.config.Datacenter}
 	}
 
-	*reply = dcs
+	// Exclude any DC that's currently the target of a failover drill, so
+	// that mesh gateways and other consumers of this list route around it
+	// the same way they would for a genuinely unreachable DC.
+	filtered := dcs[:0]
+	for _, dc := range dcs {
+		if dc == c.srv.config.Datacenter {
+			filtered = append(filtered, dc)
+			continue
+		}
+		drilled, err := c.srv.fsm.State().IsDatacenterDrilled(dc)
+		if err != nil {
+			return err
+		}
+		if !drilled {
+			filtered = append(filtered, dc)
+		}
+	}
+
+	*reply = filtered
 	return nil
 }
 
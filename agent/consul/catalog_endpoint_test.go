@@ -43,12 +43,15 @@ func TestCatalog_Register(t *testing.T) {
 			ServiceID: "db",
 		},
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 
 	err := msgpackrpc.CallWithCodec(codec, "Catalog.Register", &arg, &out)
 	if err != nil {
 		t.Fatalf("err: %v", err)
 	}
+	if out.Index == 0 {
+		t.Fatalf("bad: %v", out)
+	}
 }
 
 func TestCatalog_RegisterService_InvalidAddress(t *testing.T) {
@@ -120,6 +123,46 @@ func TestCatalog_RegisterService_SkipNodeUpdate(t *testing.T) {
 	}
 }
 
+func TestCatalog_Register_TagSchema(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	waitForLeaderEstablishment(t, s1)
+
+	entry := &structs.ServiceConfigEntry{
+		Kind: structs.ServiceDefaults,
+		Name: "db",
+		TagSchema: &structs.ServiceTagSchema{
+			AllowedTags: []string{"primary", "replica"},
+		},
+	}
+	require.NoError(s1.fsm.State().EnsureConfigEntry(1, entry, nil))
+
+	arg := structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       "foo",
+		Address:    "127.0.0.1",
+		Service: &structs.NodeService{
+			Service: "db",
+			Tags:    []string{"rogue"},
+			Port:    8000,
+		},
+	}
+	var out struct{}
+	err := msgpackrpc.CallWithCodec(codec, "Catalog.Register", &arg, &out)
+	require.Error(err)
+	require.Contains(err.Error(), "do not conform")
+
+	arg.Service.Tags = []string{"primary"}
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Catalog.Register", &arg, &out))
+}
+
 func TestCatalog_Register_NodeID(t *testing.T) {
 	t.Parallel()
 	dir1, s1 := testServer(t)
@@ -485,7 +528,7 @@ func TestCatalog_Deregister(t *testing.T) {
 		Datacenter: "dc1",
 		Node:       "foo",
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 
 	err := msgpackrpc.CallWithCodec(codec, "Catalog.Deregister", &arg, &out)
 	if err != nil {
@@ -497,6 +540,9 @@ func TestCatalog_Deregister(t *testing.T) {
 	if err := msgpackrpc.CallWithCodec(codec, "Catalog.Deregister", &arg, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
+	if out.Index == 0 {
+		t.Fatalf("bad: %v", out)
+	}
 }
 
 func TestCatalog_Deregister_ACLDeny(t *testing.T) {
@@ -210,6 +210,20 @@ func (c *Client) LANMembersAllSegments() ([]serf.Member, error) {
 	return c.serf.Members(), nil
 }
 
+// LANMembersHealthScore returns this client's own memberlist health score,
+// a value from 0 (healthy) upward indicating how well it's meeting the soft
+// real-time requirements of the protocol. It cannot be used to score any
+// other member, since nodes do not gossip their own health score.
+func (c *Client) LANMembersHealthScore() int {
+	return c.serf.Memberlist().GetHealthScore()
+}
+
+// SyncPacingHint returns the largest anti-entropy pacing hint advertised by
+// any server this client currently knows about.
+func (c *Client) SyncPacingHint() time.Duration {
+	return c.router.GetLANManager().MaxSyncPacing()
+}
+
 // LANSegmentMembers only returns our own segment's members, because clients
 // can't be in multiple segments.
 func (c *Client) LANSegmentMembers(segment string) ([]serf.Member, error) {
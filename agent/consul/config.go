@@ -260,6 +260,11 @@ type Config struct {
 	// enabled. This
 	ACLDisabledTTL time.Duration
 
+	// ACLTokenReapingRateLimit and ACLTokenReapingBurst control how fast the
+	// leader deletes expired ACL tokens in batches of aclBatchDeleteSize.
+	ACLTokenReapingRateLimit rate.Limit
+	ACLTokenReapingBurst     int
+
 	// ACLTokenReplication is used to enabled token replication.
 	//
 	// By default policy-only replication is enabled. When token
@@ -300,6 +305,10 @@ type Config struct {
 	// by default in Consul 1.0 and later.
 	ACLEnableKeyListPolicy bool
 
+	// ACLEnforceTokenScoping, when set, prevents a token from writing a
+	// token or policy whose effective permissions exceed its own.
+	ACLEnforceTokenScoping bool
+
 	AutoConfigEnabled              bool
 	AutoConfigIntroToken           string
 	AutoConfigIntroTokenFile       string
@@ -432,6 +441,19 @@ type Config struct {
 	// allowed from a single source IP.
 	RPCMaxConnsPerClient int
 
+	// RPCMaxBlockingQueriesPerClient is the limit of how many concurrent
+	// blocking queries are allowed from a single ACL token (or from the
+	// anonymous token, if none is provided). Zero or less disables the
+	// limit. This guards against a single buggy or malicious client
+	// holding open tens of thousands of watches.
+	RPCMaxBlockingQueriesPerClient int
+
+	// SessionsPerNodeLimit limits how many sessions may be held open
+	// against a single node at once. Zero or less disables the limit.
+	// This guards against a leaky client piling up sessions that bloat
+	// the state store and lock tables.
+	SessionsPerNodeLimit int
+
 	// LeaveDrainTime is used to wait after a server has left the LAN Serf
 	// pool for RPCs to drain and new requests to be sent to other servers.
 	LeaveDrainTime time.Duration
@@ -460,6 +482,12 @@ type Config struct {
 	// datacenters should exclusively traverse mesh gateways.
 	ConnectMeshGatewayWANFederationEnabled bool
 
+	// SerfWANGossipTLSEnabled wraps all WAN Serf traffic in a TLS connection
+	// authenticated with the agent's own TLS certificates rather than relying
+	// solely on the shared gossip encryption keyring. Mutually exclusive with
+	// ConnectMeshGatewayWANFederationEnabled.
+	SerfWANGossipTLSEnabled bool
+
 	// DisableFederationStateAntiEntropy solely exists for use in unit tests to
 	// disable a background routine.
 	DisableFederationStateAntiEntropy bool
@@ -560,6 +588,8 @@ func DefaultConfig() *Config {
 		ACLDefaultPolicy:                     "allow",
 		ACLDownPolicy:                        "extend-cache",
 		ACLReplicationRate:                   1,
+		ACLTokenReapingRateLimit:             1.0,
+		ACLTokenReapingBurst:                 5,
 		ACLReplicationBurst:                  5,
 		ACLReplicationApplyLimit:             100, // ops / sec
 		ConfigReplicationRate:                1,
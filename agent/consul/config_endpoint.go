@@ -67,6 +67,8 @@ func (c *ConfigEntry) applyInternal(args *structs.ConfigEntryRequest, reply *boo
 	if args.Op != structs.ConfigEntryUpsert && args.Op != structs.ConfigEntryUpsertCAS {
 		args.Op = structs.ConfigEntryUpsert
 	}
+	args.Author = c.auditAuthor(args.Token)
+	args.Timestamp = time.Now()
 	resp, err := c.srv.raftApply(structs.ConfigEntryRequestType, args)
 	if err != nil {
 		return err
@@ -173,6 +175,68 @@ func (c *ConfigEntry) List(args *structs.ConfigEntryQuery, reply *structs.Indexe
 		})
 }
 
+// TagConformance reports the registered instances of a service whose tags
+// don't satisfy the tag schema configured on its service-defaults config
+// entry, if any is configured. It exists so operators can check what would
+// break before introducing or tightening a schema, rather than finding out
+// at the next registration.
+func (c *ConfigEntry) TagConformance(args *structs.ServiceTagConformanceRequest, reply *structs.ServiceTagConformanceResponse) error {
+	if err := c.srv.validateEnterpriseRequest(&args.EnterpriseMeta, false); err != nil {
+		return err
+	}
+
+	if done, err := c.srv.ForwardRPC("ConfigEntry.TagConformance", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"config_entry", "tag_conformance"}, time.Now())
+
+	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	if err != nil {
+		return err
+	}
+
+	var authzContext acl.AuthorizerContext
+	args.EnterpriseMeta.FillAuthzContext(&authzContext)
+	if authz != nil && authz.ServiceRead(args.ServiceName, &authzContext) != acl.Allow {
+		return acl.ErrPermissionDenied
+	}
+
+	return c.srv.blockingQuery(
+		&args.QueryOptions,
+		&reply.QueryMeta,
+		func(ws memdb.WatchSet, state *state.Store) error {
+			index, entry, err := state.ConfigEntry(ws, structs.ServiceDefaults, args.ServiceName, &args.EnterpriseMeta)
+			if err != nil {
+				return err
+			}
+			reply.Index = index
+
+			svcDefaults, ok := entry.(*structs.ServiceConfigEntry)
+			if !ok || svcDefaults.TagSchema == nil {
+				reply.NonConforming = nil
+				return nil
+			}
+
+			_, nodes, err := state.ServiceNodes(ws, args.ServiceName, &args.EnterpriseMeta)
+			if err != nil {
+				return err
+			}
+
+			var nonConforming []structs.ServiceTagConformanceEntry
+			for _, sn := range nodes {
+				if bad := svcDefaults.TagSchema.NonConformingTags(sn.ServiceTags); len(bad) > 0 {
+					nonConforming = append(nonConforming, structs.ServiceTagConformanceEntry{
+						Node:      sn.Node,
+						ServiceID: sn.ServiceID,
+						Tags:      bad,
+					})
+				}
+			}
+			reply.NonConforming = nonConforming
+			return nil
+		})
+}
+
 var configEntryKindsFromConsul_1_8_0 = []string{
 	structs.ServiceDefaults,
 	structs.ProxyDefaults,
@@ -275,6 +339,8 @@ func (c *ConfigEntry) Delete(args *structs.ConfigEntryRequest, reply *struct{})
 	}
 
 	args.Op = structs.ConfigEntryDelete
+	args.Author = c.auditAuthor(args.Token)
+	args.Timestamp = time.Now()
 	resp, err := c.srv.raftApply(structs.ConfigEntryRequestType, args)
 	if err != nil {
 		return err
@@ -368,6 +434,10 @@ func (c *ConfigEntry) ResolveServiceConfig(args *structs.ServiceConfigRequest, r
 					}
 					reply.ProxyConfig["protocol"] = serviceConf.Protocol
 				}
+				reply.CheckInterval = serviceConf.CheckInterval
+				reply.CheckTimeout = serviceConf.CheckTimeout
+				reply.MinHealthyInstances = serviceConf.MinHealthyInstances
+				reply.Meta = serviceConf.Meta
 			}
 
 			// Extract the global protocol from proxyConf for upstream configs.
@@ -448,6 +518,17 @@ func (c *ConfigEntry) ResolveServiceConfig(args *structs.ServiceConfigRequest, r
 		})
 }
 
+// auditAuthor returns the accessor ID to record against a config entry
+// audit log entry for a write made with the given token, or "anonymous" if
+// ACLs are disabled or the token doesn't resolve to an identity.
+func (c *ConfigEntry) auditAuthor(token string) string {
+	identity, err := c.srv.ResolveTokenToIdentity(token)
+	if err != nil || identity == nil {
+		return "anonymous"
+	}
+	return identity.ID()
+}
+
 // preflightCheck is meant to have kind-specific system validation outside of
 // content validation. The initial use case is restricting the ability to do
 // writes of service-intentions until the system is finished migration.
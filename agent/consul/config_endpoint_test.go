@@ -843,6 +843,41 @@ func TestConfigEntry_ResolveServiceConfig(t *testing.T) {
 	require.Equal(map[string]interface{}{"foo": 1}, proxyConf.Config)
 }
 
+func TestConfigEntry_ResolveServiceConfig_CheckDefaultsAndMeta(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	state := s1.fsm.State()
+	require.NoError(state.EnsureConfigEntry(1, &structs.ServiceConfigEntry{
+		Kind:          structs.ServiceDefaults,
+		Name:          "foo",
+		Protocol:      "http",
+		CheckInterval: 15 * time.Second,
+		CheckTimeout:  5 * time.Second,
+		Meta: map[string]string{
+			"env": "prod",
+		},
+	}, nil))
+
+	args := structs.ServiceConfigRequest{
+		Name:       "foo",
+		Datacenter: s1.config.Datacenter,
+	}
+	var out structs.ServiceConfigResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ConfigEntry.ResolveServiceConfig", &args, &out))
+
+	require.Equal(15*time.Second, out.CheckInterval)
+	require.Equal(5*time.Second, out.CheckTimeout)
+	require.Equal(map[string]string{"env": "prod"}, out.Meta)
+}
+
 func TestConfigEntry_ResolveServiceConfig_Blocking(t *testing.T) {
 	t.Parallel()
 
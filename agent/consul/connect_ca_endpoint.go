@@ -343,6 +343,94 @@ func (s *ConnectCA) Roots(
 	)
 }
 
+// Revoke marks a leaf certificate's serial number as revoked so that the
+// revocation is distributed to proxies via the active CA root's
+// RevokedSerials, letting them reject the certificate before its TTL
+// expires.
+func (s *ConnectCA) Revoke(
+	args *structs.CARevokeLeafRequest,
+	reply *struct{}) error {
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	if done, err := s.srv.ForwardRPC("ConnectCA.Revoke", args, args, reply); done {
+		return err
+	}
+
+	// This action requires operator write access.
+	rule, err := s.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if rule != nil && rule.OperatorWrite(nil) != acl.Allow {
+		return acl.ErrPermissionDenied
+	}
+
+	state := s.srv.fsm.State()
+	idx, roots, err := state.CARoots(nil)
+	if err != nil {
+		return err
+	}
+
+	newRoots := make(structs.CARoots, 0, len(roots))
+	hasActive := false
+	for _, r := range roots {
+		root := *r
+		if root.Active {
+			hasActive = true
+			alreadyRevoked := false
+			for _, serial := range root.RevokedSerials {
+				if serial == args.SerialNumber {
+					alreadyRevoked = true
+					break
+				}
+			}
+			if !alreadyRevoked {
+				root.RevokedSerials = append(append([]string{}, root.RevokedSerials...), args.SerialNumber)
+			}
+		}
+		newRoots = append(newRoots, &root)
+	}
+	if !hasActive {
+		return fmt.Errorf("no active CA root to revoke the certificate against")
+	}
+
+	if provider, _ := s.srv.getCAProvider(); provider != nil {
+		if gen, ok := provider.(ca.CRLGenerator); ok {
+			for _, root := range newRoots {
+				if !root.Active {
+					continue
+				}
+				crl, err := gen.GenerateCRL(root.RevokedSerials)
+				if err != nil {
+					return fmt.Errorf("error generating CRL: %v", err)
+				}
+				root.CRLPEM = crl
+			}
+		}
+	}
+
+	req := &structs.CARequest{
+		Op:    structs.CAOpSetRoots,
+		Index: idx,
+		Roots: newRoots,
+	}
+	resp, err := s.srv.raftApply(structs.ConnectCARequestType, req)
+	if err != nil {
+		return err
+	}
+	if respErr, ok := resp.(error); ok {
+		return respErr
+	}
+	if respOk, ok := resp.(bool); ok && !respOk {
+		return fmt.Errorf("could not atomically update roots")
+	}
+
+	return nil
+}
+
 // Sign signs a certificate for a service.
 func (s *ConnectCA) Sign(
 	args *structs.CASignRequest,
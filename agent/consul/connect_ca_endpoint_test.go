@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/connect"
 	ca "github.com/hashicorp/consul/agent/connect/ca"
 	"github.com/hashicorp/consul/agent/structs"
@@ -405,6 +406,167 @@ func TestConnectCAConfig_TriggerRotation(t *testing.T) {
 	}
 }
 
+// A token without operator:write access must not be able to revoke certs.
+func TestConnectCARevoke_ACLDeny(t *testing.T) {
+	t.Parallel()
+
+	_, srv, codec := testACLServerWithConfig(t, nil, false)
+	waitForLeaderEstablishment(t, srv)
+
+	readToken, err := upsertTestTokenWithPolicyRules(codec, TestDefaultMasterToken, "dc1", `
+		operator = "read"
+	`)
+	require.NoError(t, err)
+
+	args := &structs.CARevokeLeafRequest{
+		Datacenter:   "dc1",
+		SerialNumber: "1234",
+		WriteRequest: structs.WriteRequest{Token: readToken.SecretID},
+	}
+	var reply struct{}
+	err = msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", args, &reply)
+	require.True(t, acl.IsErrPermissionDenied(err), "Err %v is not acl.PermissionDenied", err)
+
+	writeToken, err := upsertTestTokenWithPolicyRules(codec, TestDefaultMasterToken, "dc1", `
+		operator = "write"
+	`)
+	require.NoError(t, err)
+	args.WriteRequest = structs.WriteRequest{Token: writeToken.SecretID}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", args, &reply))
+}
+
+// Test revoking a leaf certificate's serial number.
+func TestConnectCARevoke(t *testing.T) {
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	args := &structs.CARevokeLeafRequest{
+		Datacenter:   "dc1",
+		SerialNumber: "1234",
+	}
+	var reply struct{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", args, &reply))
+
+	_, roots, err := s1.fsm.State().CARoots(nil)
+	require.NoError(t, err)
+	var active *structs.CARoot
+	for _, r := range roots {
+		if r.Active {
+			active = r
+		}
+	}
+	require.NotNil(t, active)
+	require.Contains(t, active.RevokedSerials, "1234")
+	// The default provider implements ca.CRLGenerator, so revoking should
+	// have produced a CRL signed against the revoked serial.
+	require.NotEmpty(t, active.CRLPEM)
+}
+
+// Revoking the same serial number twice should be a no-op the second time,
+// rather than erroring or appending a duplicate entry.
+func TestConnectCARevoke_Idempotent(t *testing.T) {
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	args := &structs.CARevokeLeafRequest{
+		Datacenter:   "dc1",
+		SerialNumber: "1234",
+	}
+	var reply struct{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", args, &reply))
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", args, &reply))
+
+	_, roots, err := s1.fsm.State().CARoots(nil)
+	require.NoError(t, err)
+	var active *structs.CARoot
+	for _, r := range roots {
+		if r.Active {
+			active = r
+		}
+	}
+	require.NotNil(t, active)
+	count := 0
+	for _, serial := range active.RevokedSerials {
+		if serial == "1234" {
+			count++
+		}
+	}
+	require.Equal(t, 1, count)
+}
+
+// Revoke reads the current roots and then CAS-writes them back with the
+// new serial appended. Firing many revocations concurrently means some of
+// those CAS writes race against each other; the loser of a race must come
+// back as an error rather than silently appear to succeed while dropping
+// the revocation.
+func TestConnectCARevoke_CASFailure(t *testing.T) {
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	// CallWithCodec assumes its connection isn't shared across concurrent
+	// RPCs, so each goroutine gets its own client connection.
+	const n = 10
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codec := rpcClient(t, s1)
+			defer codec.Close()
+			<-start
+			args := &structs.CARevokeLeafRequest{
+				Datacenter:   "dc1",
+				SerialNumber: fmt.Sprintf("%04x", i+1),
+			}
+			var reply struct{}
+			errs[i] = msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", args, &reply)
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	_, roots, err := s1.fsm.State().CARoots(nil)
+	require.NoError(t, err)
+	var active *structs.CARoot
+	for _, r := range roots {
+		if r.Active {
+			active = r
+		}
+	}
+	require.NotNil(t, active)
+
+	for i, err := range errs {
+		serial := fmt.Sprintf("%04x", i+1)
+		if err == nil {
+			require.Contains(t, active.RevokedSerials, serial)
+		} else {
+			require.Equal(t, "could not atomically update roots", err.Error())
+			require.NotContains(t, active.RevokedSerials, serial)
+		}
+	}
+}
+
 // Test CA signing
 func TestConnectCASign(t *testing.T) {
 	t.Parallel()
@@ -0,0 +1,156 @@
+package consul
+
+import (
+	"sync/atomic"
+
+	"github.com/hashicorp/serf/serf"
+
+	"github.com/hashicorp/consul/agent/metadata"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// featureUpgrade implements the common dance used to gate a capability on
+// every server in a datacenter advertising support for it via a "ft_<name>"
+// serf tag: poll until every server does, then latch an in-memory flag
+// (optionally durable via Raft system metadata) so that future checks don't
+// need to re-derive readiness from serf. This generalizes the binary
+// all-servers-must-support-it tracking that used to be duplicated per
+// feature. Not every existing ad hoc check fits this shape, though:
+// DatacenterSupportsFederationStates and DatacenterSupportsIntentionsAsConfigEntries
+// also need to check the primary datacenter when run from a secondary, and
+// canUpgradeToNewACLs (see acl_server.go) aggregates a tri-state value with
+// leader-specific tracking rather than a simple bool. Those keep their own
+// gating logic and only register with registerFeatureUpgrade for rollout
+// visibility.
+type featureUpgrade struct {
+	// name is both the feature's identifier and the "ft_<name>" serf tag
+	// suffix used to detect support for it.
+	name string
+
+	// systemMetadataKey, if set, is persisted via Raft the first time the
+	// feature is activated so that a restart (or a secondary datacenter)
+	// doesn't have to re-derive readiness from serf.
+	systemMetadataKey string
+
+	active int32
+}
+
+func newFeatureUpgrade(name, systemMetadataKey string) *featureUpgrade {
+	return &featureUpgrade{name: name, systemMetadataKey: systemMetadataKey}
+}
+
+// Enabled reports whether the feature has been activated. Once true it
+// never reverts to false.
+func (f *featureUpgrade) Enabled() bool {
+	return atomic.LoadInt32(&f.active) != 0
+}
+
+func (f *featureUpgrade) activate() {
+	atomic.StoreInt32(&f.active, 1)
+}
+
+// checkFeatureUpgrade reports whether f is active, activating it (and
+// persisting that fact via Raft, if f has a systemMetadataKey) the first
+// time every server in dc advertises support for it.
+func (s *Server) checkFeatureUpgrade(dc string, f *featureUpgrade) bool {
+	if f.Enabled() {
+		return true
+	}
+
+	if f.systemMetadataKey != "" {
+		val, err := s.getSystemMetadata(f.systemMetadataKey)
+		if err != nil {
+			s.logger.Warn("failed to read feature upgrade system metadata",
+				"feature", f.name, "error", err)
+		} else if val == "1" {
+			// Bypass serf and jump right to the final state.
+			f.activate()
+			return true
+		}
+	}
+
+	supported, found := s.serversSupportFeature(dc, f.name)
+	if !supported || !found {
+		return false
+	}
+
+	if f.systemMetadataKey != "" {
+		if err := s.setSystemMetadataKey(f.systemMetadataKey, "1"); err != nil {
+			s.logger.Warn("failed to persist feature upgrade system metadata",
+				"feature", f.name, "error", err)
+			return false
+		}
+	}
+
+	f.activate()
+	return true
+}
+
+// serversSupportFeature reports whether every alive or failed server in dc
+// currently advertises support for the named feature via its "ft_<name>"
+// serf tag. found indicates whether any servers were considered at all.
+func (s *Server) serversSupportFeature(dc, name string) (supported, found bool) {
+	state := featureCheckState{name: name, supported: true}
+	s.CheckServers(dc, state.update)
+	return state.supported, state.found
+}
+
+type featureCheckState struct {
+	name string
+
+	// supported indicates whether every processed server supports the feature
+	supported bool
+
+	// found indicates that at least one server was processed
+	found bool
+}
+
+func (f *featureCheckState) update(srv *metadata.Server) bool {
+	if srv.Status != serf.StatusAlive && srv.Status != serf.StatusFailed {
+		// they are left or something so regardless we treat these servers as
+		// meeting the requirement
+		return true
+	}
+
+	f.found = true
+
+	if supported, ok := srv.FeatureFlags[f.name]; ok && supported == 1 {
+		return true
+	}
+
+	f.supported = false
+
+	// prevent continuing server evaluation
+	return false
+}
+
+// registeredFeatureUpgrade records a feature's name alongside a function
+// that reports its current enabled state, for surfacing via
+// Operator.FeatureRollout.
+type registeredFeatureUpgrade struct {
+	name    string
+	enabled func() bool
+}
+
+// registerFeatureUpgrade adds a feature to the registry reported by
+// Operator.FeatureRollout. Most callers should pass the Enabled method of a
+// *featureUpgrade created with newFeatureUpgrade, but any func() bool works,
+// which lets features that predate this framework report their status too.
+func (s *Server) registerFeatureUpgrade(name string, enabled func() bool) {
+	s.featureUpgradesLock.Lock()
+	defer s.featureUpgradesLock.Unlock()
+	s.featureUpgrades = append(s.featureUpgrades, registeredFeatureUpgrade{name: name, enabled: enabled})
+}
+
+// FeatureRolloutStatus returns the current rollout status of every feature
+// registered with registerFeatureUpgrade.
+func (s *Server) FeatureRolloutStatus() []structs.FeatureRolloutStatus {
+	s.featureUpgradesLock.Lock()
+	defer s.featureUpgradesLock.Unlock()
+
+	out := make([]structs.FeatureRolloutStatus, 0, len(s.featureUpgrades))
+	for _, f := range s.featureUpgrades {
+		out = append(out, structs.FeatureRolloutStatus{Name: f.name, Enabled: f.enabled()})
+	}
+	return out
+}
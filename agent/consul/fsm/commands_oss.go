@@ -38,6 +38,7 @@ func init() {
 	registerCommand(structs.ACLAuthMethodDeleteRequestType, (*FSM).applyACLAuthMethodDeleteOperation)
 	registerCommand(structs.FederationStateRequestType, (*FSM).applyFederationStateOperation)
 	registerCommand(structs.SystemMetadataRequestType, (*FSM).applySystemMetadataOperation)
+	registerCommand(structs.DatacenterDrillRequestType, (*FSM).applyDatacenterDrillOperation)
 }
 
 func (c *FSM) applyRegister(buf []byte, index uint64) interface{} {
@@ -470,6 +471,9 @@ func (c *FSM) applyConfigEntryOperation(buf []byte, index uint64) interface{} {
 		if err != nil {
 			return err
 		}
+		if updated {
+			c.recordConfigEntryAudit(index, req, "upsert")
+		}
 		return updated
 	case structs.ConfigEntryUpsert:
 		defer metrics.MeasureSinceWithLabels([]string{"fsm", "config_entry", req.Entry.GetKind()}, time.Now(),
@@ -477,16 +481,34 @@ func (c *FSM) applyConfigEntryOperation(buf []byte, index uint64) interface{} {
 		if err := c.state.EnsureConfigEntry(index, req.Entry, req.Entry.GetEnterpriseMeta()); err != nil {
 			return err
 		}
+		c.recordConfigEntryAudit(index, req, "upsert")
 		return true
 	case structs.ConfigEntryDelete:
 		defer metrics.MeasureSinceWithLabels([]string{"fsm", "config_entry", req.Entry.GetKind()}, time.Now(),
 			[]metrics.Label{{Name: "op", Value: "delete"}})
-		return c.state.DeleteConfigEntry(index, req.Entry.GetKind(), req.Entry.GetName(), req.Entry.GetEnterpriseMeta())
+		if err := c.state.DeleteConfigEntry(index, req.Entry.GetKind(), req.Entry.GetName(), req.Entry.GetEnterpriseMeta()); err != nil {
+			return err
+		}
+		c.recordConfigEntryAudit(index, req, "delete")
+		return nil
 	default:
 		return fmt.Errorf("invalid config entry operation type: %v", req.Op)
 	}
 }
 
+// recordConfigEntryAudit appends an entry to the bounded config entry and
+// intention change audit log. Recording the audit entry is best-effort and
+// never fails the config entry write it describes.
+func (c *FSM) recordConfigEntryAudit(index uint64, req structs.ConfigEntryRequest, op string) {
+	author := req.Author
+	if author == "" {
+		author = "anonymous"
+	}
+	if err := c.state.RecordConfigEntryAudit(index, req.Entry.GetKind(), req.Entry.GetName(), op, author, req.Timestamp); err != nil {
+		c.logger.Warn("failed recording config entry audit log entry", "error", err)
+	}
+}
+
 func (c *FSM) applyACLRoleSetOperation(buf []byte, index uint64) interface{} {
 	var req structs.ACLRoleBatchSetRequest
 	if err := structs.Decode(buf, &req); err != nil {
@@ -598,3 +620,23 @@ func (c *FSM) applySystemMetadataOperation(buf []byte, index uint64) interface{}
 		return fmt.Errorf("invalid system metadata operation type: %v", req.Op)
 	}
 }
+
+func (c *FSM) applyDatacenterDrillOperation(buf []byte, index uint64) interface{} {
+	var req structs.DatacenterDrillRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	switch req.Op {
+	case structs.DatacenterDrillStart:
+		defer metrics.MeasureSinceWithLabels([]string{"fsm", "dc_drill"}, time.Now(),
+			[]metrics.Label{{Name: "op", Value: "start"}})
+		return c.state.EnsureDatacenterDrill(index, req.TargetDatacenter, req.ExpiresAt)
+	case structs.DatacenterDrillStop:
+		defer metrics.MeasureSinceWithLabels([]string{"fsm", "dc_drill"}, time.Now(),
+			[]metrics.Label{{Name: "op", Value: "stop"}})
+		return c.state.DeleteDatacenterDrill(index, req.TargetDatacenter)
+	default:
+		return fmt.Errorf("invalid datacenter drill operation type: %v", req.Op)
+	}
+}
@@ -36,49 +36,49 @@ func init() {
 }
 
 func persistOSS(s *snapshot, sink raft.SnapshotSink, encoder *codec.Encoder) error {
-	if err := s.persistNodes(sink, encoder); err != nil {
+	if err := persistTable("nodes", func() error { return s.persistNodes(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistSessions(sink, encoder); err != nil {
+	if err := persistTable("sessions", func() error { return s.persistSessions(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistACLs(sink, encoder); err != nil {
+	if err := persistTable("acls", func() error { return s.persistACLs(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistKVs(sink, encoder); err != nil {
+	if err := persistTable("kvs", func() error { return s.persistKVs(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistTombstones(sink, encoder); err != nil {
+	if err := persistTable("tombstones", func() error { return s.persistTombstones(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistPreparedQueries(sink, encoder); err != nil {
+	if err := persistTable("prepared-queries", func() error { return s.persistPreparedQueries(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistAutopilot(sink, encoder); err != nil {
+	if err := persistTable("autopilot", func() error { return s.persistAutopilot(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistLegacyIntentions(sink, encoder); err != nil {
+	if err := persistTable("legacy-intentions", func() error { return s.persistLegacyIntentions(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistConnectCA(sink, encoder); err != nil {
+	if err := persistTable("connect-ca", func() error { return s.persistConnectCA(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistConnectCAProviderState(sink, encoder); err != nil {
+	if err := persistTable("connect-ca-provider-state", func() error { return s.persistConnectCAProviderState(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistConnectCAConfig(sink, encoder); err != nil {
+	if err := persistTable("connect-ca-config", func() error { return s.persistConnectCAConfig(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistConfigEntries(sink, encoder); err != nil {
+	if err := persistTable("config-entries", func() error { return s.persistConfigEntries(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistFederationStates(sink, encoder); err != nil {
+	if err := persistTable("federation-states", func() error { return s.persistFederationStates(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistSystemMetadata(sink, encoder); err != nil {
+	if err := persistTable("system-metadata", func() error { return s.persistSystemMetadata(sink, encoder) }); err != nil {
 		return err
 	}
-	if err := s.persistIndex(sink, encoder); err != nil {
+	if err := persistTable("index", func() error { return s.persistIndex(sink, encoder) }); err != nil {
 		return err
 	}
 	return nil
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/consul/state"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
 	bexpr "github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-memdb"
 )
@@ -68,6 +69,71 @@ func (h *Health) ChecksInState(args *structs.ChecksInStateRequest,
 		})
 }
 
+// Summary is used to get, per service, counts of instances by health status
+// without the full per-instance payloads that ServiceNodes/ServiceDump
+// return. It's meant for dashboards that otherwise have to fetch full health
+// lists for every service just to compute these counts.
+func (h *Health) Summary(args *structs.DCSpecificRequest, reply *structs.IndexedServiceHealthSummaries) error {
+	if done, err := h.srv.ForwardRPC("Health.Summary", args, args, reply); done {
+		return err
+	}
+
+	_, err := h.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := h.srv.validateEnterpriseRequest(&args.EnterpriseMeta, false); err != nil {
+		return err
+	}
+
+	return h.srv.blockingQuery(
+		&args.QueryOptions,
+		&reply.QueryMeta,
+		func(ws memdb.WatchSet, state *state.Store) error {
+			index, nodes, err := state.ServiceDump(ws, "", false, &args.EnterpriseMeta)
+			if err != nil {
+				return err
+			}
+
+			if err := h.srv.filterACL(args.Token, &nodes); err != nil {
+				return err
+			}
+
+			summaries := make(map[string]*structs.ServiceHealthSummary)
+			var order []string
+			for _, node := range nodes {
+				summary, ok := summaries[node.Service.Service]
+				if !ok {
+					summary = &structs.ServiceHealthSummary{
+						Name:           node.Service.Service,
+						EnterpriseMeta: node.Service.EnterpriseMeta,
+					}
+					summaries[node.Service.Service] = summary
+					order = append(order, node.Service.Service)
+				}
+
+				switch node.Checks.AggregatedStatus() {
+				case api.HealthPassing:
+					summary.Passing++
+				case api.HealthWarning:
+					summary.Warning++
+				default:
+					summary.Critical++
+				}
+			}
+
+			sort.Strings(order)
+			reply.Index = index
+			reply.Summaries = make([]*structs.ServiceHealthSummary, 0, len(order))
+			for _, name := range order {
+				reply.Summaries = append(reply.Summaries, summaries[name])
+			}
+
+			return nil
+		})
+}
+
 // NodeChecks is used to get all the checks for a node
 func (h *Health) NodeChecks(args *structs.NodeSpecificRequest,
 	reply *structs.IndexedHealthChecks) error {
@@ -286,6 +352,9 @@ func (h *Health) ServiceNodes(args *structs.ServiceSpecificRequest, reply *struc
 // can be used by the ServiceNodes endpoint.
 
 func (h *Health) serviceNodesConnect(ws memdb.WatchSet, s *state.Store, args *structs.ServiceSpecificRequest) (uint64, structs.CheckServiceNodes, error) {
+	if args.MergeNodeMeta {
+		return s.CheckServiceNodesWithNodeMeta(ws, args.ServiceName, true, &args.EnterpriseMeta)
+	}
 	return s.CheckConnectServiceNodes(ws, args.ServiceName, &args.EnterpriseMeta)
 }
 
@@ -305,5 +374,8 @@ func (h *Health) serviceNodesTagFilter(ws memdb.WatchSet, s *state.Store, args *
 }
 
 func (h *Health) serviceNodesDefault(ws memdb.WatchSet, s *state.Store, args *structs.ServiceSpecificRequest) (uint64, structs.CheckServiceNodes, error) {
+	if args.MergeNodeMeta {
+		return s.CheckServiceNodesWithNodeMeta(ws, args.ServiceName, false, &args.EnterpriseMeta)
+	}
 	return s.CheckServiceNodes(ws, args.ServiceName, &args.EnterpriseMeta)
 }
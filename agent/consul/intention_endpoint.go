@@ -3,6 +3,7 @@ package consul
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/armon/go-metrics"
@@ -503,6 +504,168 @@ func (s *Intention) Apply(
 	}
 }
 
+// sourceIntentionKey returns a key that uniquely identifies a source within
+// a single destination's Sources list, for diffing purposes. Consul-catalog
+// sources are keyed by service identity; auth-method sources have no service
+// identity, so they're keyed by the auth method and selector instead.
+func sourceIntentionKey(src *structs.SourceIntention) string {
+	if src.Type == structs.IntentionSourceAuthMethod {
+		return "auth-method/" + src.SourceAuthMethod + "/" + src.SourceSelector
+	}
+	sn := src.SourceServiceName()
+	return "consul/" + sn.String()
+}
+
+// sourceIntentionContentEqual reports whether two sources represent the
+// same user-specified content, ignoring fields that Consul itself computes
+// (Precedence) or that only apply to legacy UUID-based intentions.
+func sourceIntentionContentEqual(a, b *structs.SourceIntention) bool {
+	return a.Name == b.Name &&
+		a.EnterpriseMeta.IsSame(&b.EnterpriseMeta) &&
+		a.Action == b.Action &&
+		a.Type == b.Type &&
+		a.SourceAuthMethod == b.SourceAuthMethod &&
+		a.SourceSelector == b.SourceSelector &&
+		a.Description == b.Description &&
+		reflect.DeepEqual(a.Permissions, b.Permissions)
+}
+
+// Reconcile declaratively replaces the complete set of intention sources
+// for a single destination service. Unlike Apply, which adds, updates, or
+// removes one source at a time, Reconcile accepts the full desired set of
+// sources and computes the add/update/remove diff against what is
+// currently stored, applying it as a single config entry write. This lets
+// GitOps-style tooling submit its desired state directly rather than
+// fetching the existing sources via List and diffing against them itself.
+func (s *Intention) Reconcile(
+	args *structs.IntentionsReconcileRequest,
+	reply *structs.IntentionsReconcileResponse) error {
+
+	// Ensure that all service-intentions config entry writes go to the primary
+	// datacenter. These will then be replicated to all the other datacenters.
+	args.Datacenter = s.srv.config.PrimaryDatacenter
+
+	if done, err := s.srv.ForwardRPC("Intention.Reconcile", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"consul", "intention", "reconcile"}, time.Now())
+	defer metrics.MeasureSince([]string{"intention", "reconcile"}, time.Now())
+
+	if err := s.legacyUpgradeCheck(); err != nil {
+		return err
+	}
+
+	var entMeta structs.EnterpriseMeta
+	ident, authz, err := s.srv.ResolveTokenIdentityAndDefaultMeta(args.Token, &entMeta, nil)
+	if err != nil {
+		return err
+	}
+
+	args.Destination.EnterpriseMeta.MergeNoWildcard(&entMeta)
+	args.Destination.EnterpriseMeta.Normalize()
+	if err := s.srv.validateEnterpriseIntentionNamespace(args.Destination.NamespaceOrDefault(), false); err != nil {
+		return fmt.Errorf("Invalid Destination namespace %q: %v", args.Destination.NamespaceOrDefault(), err)
+	}
+
+	lookupEntry := &structs.ServiceIntentionsConfigEntry{
+		Kind:           structs.ServiceIntentions,
+		Name:           args.Destination.Name,
+		EnterpriseMeta: args.Destination.EnterpriseMeta,
+	}
+	if authz != nil && !lookupEntry.CanWrite(authz) {
+		var accessorID string
+		if ident != nil {
+			accessorID = ident.ID()
+		}
+		// todo(kit) Migrate intention access denial logging over to audit logging when we implement it
+		s.logger.Warn("Reconcile operation on intentions denied due to ACLs", "destination", args.Destination.String(), "accessorID", accessorID)
+		return acl.ErrPermissionDenied
+	}
+
+	prevEntry, err := s.getServiceIntentionsConfigEntry(args.Destination.Name, &args.Destination.EnterpriseMeta)
+	if err != nil {
+		return err
+	}
+
+	for _, src := range args.Sources {
+		if src.Type == "" {
+			src.Type = structs.IntentionSourceConsul
+		}
+	}
+
+	existingByKey := make(map[string]*structs.SourceIntention)
+	if prevEntry != nil {
+		for _, src := range prevEntry.Sources {
+			existingByKey[sourceIntentionKey(src)] = src
+		}
+	}
+
+	upsertEntry := &structs.ServiceIntentionsConfigEntry{
+		Kind:           structs.ServiceIntentions,
+		Name:           args.Destination.Name,
+		EnterpriseMeta: args.Destination.EnterpriseMeta,
+		Sources:        args.Sources,
+	}
+	if prevEntry != nil {
+		upsertEntry.Meta = prevEntry.Meta
+		upsertEntry.RaftIndex = prevEntry.RaftIndex
+	}
+
+	desiredByKey := make(map[string]struct{}, len(args.Sources))
+	for _, src := range args.Sources {
+		key := sourceIntentionKey(src)
+		desiredByKey[key] = struct{}{}
+
+		if existing, found := existingByKey[key]; found {
+			if !sourceIntentionContentEqual(existing, src) {
+				reply.Updated = append(reply.Updated, key)
+			}
+		} else {
+			reply.Added = append(reply.Added, key)
+		}
+	}
+	for key := range existingByKey {
+		if _, found := desiredByKey[key]; !found {
+			reply.Removed = append(reply.Removed, key)
+		}
+	}
+
+	if len(reply.Added) == 0 && len(reply.Updated) == 0 && len(reply.Removed) == 0 {
+		return nil
+	}
+
+	configReq := &structs.ConfigEntryRequest{
+		Datacenter:   args.Datacenter,
+		WriteRequest: args.WriteRequest,
+	}
+	if len(upsertEntry.Sources) == 0 {
+		if prevEntry == nil {
+			return nil
+		}
+		configReq.Op = structs.ConfigEntryDelete
+		configReq.Entry = &structs.ServiceIntentionsConfigEntry{
+			Kind:           structs.ServiceIntentions,
+			Name:           prevEntry.Name,
+			EnterpriseMeta: prevEntry.EnterpriseMeta,
+		}
+
+		var ignored struct{}
+		return s.configEntryEndpoint.Delete(configReq, &ignored)
+	}
+
+	configReq.Op = structs.ConfigEntryUpsertCAS
+	configReq.Entry = upsertEntry
+
+	var applied bool
+	if err := s.configEntryEndpoint.applyInternal(configReq, &applied, nil); err != nil {
+		return err
+	}
+	if !applied {
+		return fmt.Errorf("config entry failed to persist due to CAS failure: kind=%q, name=%q", upsertEntry.Kind, upsertEntry.Name)
+	}
+	return nil
+}
+
 // Get returns a single intention by ID.
 func (s *Intention) Get(
 	args *structs.IntentionQueryRequest,
@@ -762,6 +925,11 @@ func (s *Intention) Check(
 			Service:   query.SourceName,
 		}
 
+	case structs.IntentionSourceAuthMethod:
+		// Auth-method sources aren't identified by a SPIFFE cert URI, so
+		// they're matched separately below rather than through
+		// state.IntentionDecision.
+
 	default:
 		return fmt.Errorf("unsupported SourceType: %q", query.SourceType)
 	}
@@ -801,6 +969,17 @@ func (s *Intention) Check(
 	}
 
 	state := s.srv.fsm.State()
+
+	if query.SourceType == structs.IntentionSourceAuthMethod {
+		allowed, err := s.checkAuthMethodSourceDecision(state, query, defaultDecision)
+		if err != nil {
+			return fmt.Errorf("failed to get intention decision for auth method %q to (%s/%s): %v",
+				query.SourceAuthMethod, query.DestinationNS, query.DestinationName, err)
+		}
+		reply.Allowed = allowed
+		return nil
+	}
+
 	decision, err := state.IntentionDecision(uri, query.DestinationName, query.DestinationNS, defaultDecision)
 	if err != nil {
 		return fmt.Errorf("failed to get intention decision from (%s/%s) to (%s/%s): %v",
@@ -811,6 +990,55 @@ func (s *Intention) Check(
 	return nil
 }
 
+// checkAuthMethodSourceDecision mirrors state.IntentionDecision's matching
+// logic for a hypothetical source that authenticated via an auth method
+// rather than presenting a Consul service identity: it walks the
+// destination's intentions in precedence order and returns the Action of
+// the first one whose SourceAuthMethod matches and whose SourceSelector (if
+// any) matches the supplied query.SourceSelectorVars.
+func (s *Intention) checkAuthMethodSourceDecision(
+	state *state.Store,
+	query *structs.IntentionQueryCheck,
+	defaultDecision acl.EnforcementDecision,
+) (bool, error) {
+	_, matches, err := state.IntentionMatch(nil, &structs.IntentionQueryMatch{
+		Type: structs.IntentionMatchDestination,
+		Entries: []structs.IntentionMatchEntry{
+			{
+				Namespace: query.DestinationNS,
+				Name:      query.DestinationName,
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(matches) != 1 {
+		return false, errors.New("internal error loading matches")
+	}
+
+	for _, ixn := range matches[0] {
+		if ixn.SourceType != structs.IntentionSourceAuthMethod {
+			continue
+		}
+		if ixn.SourceAuthMethod != query.SourceAuthMethod {
+			continue
+		}
+		if !DoesSelectorMatch(ixn.SourceSelector, query.SourceSelectorVars) {
+			continue
+		}
+
+		if len(ixn.Permissions) > 0 {
+			// This is an L7 intention; treat it as DENY like the
+			// cert-based decision path does.
+			return false, nil
+		}
+		return ixn.Action == structs.IntentionActionAllow, nil
+	}
+
+	return defaultDecision == acl.Allow, nil
+}
+
 // aclAccessorID is used to convert an ACLToken's secretID to its accessorID for non-
 // critical purposes, such as logging. Therefore we interpret all errors as empty-string
 // so we can safely log it without handling non-critical errors at the usage site.
@@ -2015,6 +2015,143 @@ func TestIntentionCheck_match(t *testing.T) {
 	}
 }
 
+func TestIntentionCheck_authMethodSourceMatch(t *testing.T) {
+	t.Parallel()
+
+	_, srv, codec := testACLServerWithConfig(t, nil, false)
+	waitForLeaderEstablishment(t, srv)
+
+	token, err := upsertTestTokenWithPolicyRules(codec, TestDefaultMasterToken, "dc1", `service "api" { policy = "read" }`)
+	require.NoError(t, err)
+
+	entry := &structs.ServiceIntentionsConfigEntry{
+		Kind: structs.ServiceIntentions,
+		Name: "api",
+		Sources: []*structs.SourceIntention{
+			{
+				Type:             structs.IntentionSourceAuthMethod,
+				SourceAuthMethod: "minikube",
+				SourceSelector:   `namespace == default`,
+				Action:           structs.IntentionActionAllow,
+			},
+		},
+	}
+	{
+		req := &structs.ConfigEntryRequest{
+			Datacenter:   "dc1",
+			Op:           structs.ConfigEntryUpsert,
+			Entry:        entry,
+			WriteRequest: structs.WriteRequest{Token: TestDefaultMasterToken},
+		}
+		var ignored bool
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConfigEntry.Apply", req, &ignored))
+	}
+
+	checkReq := func(authMethod string, vars map[string]string) bool {
+		req := &structs.IntentionQueryRequest{
+			Datacenter: "dc1",
+			Check: &structs.IntentionQueryCheck{
+				DestinationNS:      "default",
+				DestinationName:    "api",
+				SourceType:         structs.IntentionSourceAuthMethod,
+				SourceAuthMethod:   authMethod,
+				SourceSelectorVars: vars,
+			},
+			QueryOptions: structs.QueryOptions{Token: token.SecretID},
+		}
+		var resp structs.IntentionQueryCheckResponse
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "Intention.Check", req, &resp))
+		return resp.Allowed
+	}
+
+	require.True(t, checkReq("minikube", map[string]string{"namespace": "default"}))
+	require.False(t, checkReq("minikube", map[string]string{"namespace": "other"}))
+	require.False(t, checkReq("other-method", map[string]string{"namespace": "default"}))
+}
+
+func TestIntentionReconcile(t *testing.T) {
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	waitForLeaderEstablishment(t, s1)
+
+	reconcile := func(sources []*structs.SourceIntention) (*structs.IntentionsReconcileResponse, error) {
+		req := &structs.IntentionsReconcileRequest{
+			Datacenter:  "dc1",
+			Destination: structs.NewServiceName("web", structs.DefaultEnterpriseMeta()),
+			Sources:     sources,
+		}
+		var resp structs.IntentionsReconcileResponse
+		if err := msgpackrpc.CallWithCodec(codec, "Intention.Reconcile", req, &resp); err != nil {
+			return nil, err
+		}
+		return &resp, nil
+	}
+
+	getSources := func() []*structs.SourceIntention {
+		_, entry, err := s1.fsm.State().ConfigEntry(nil, structs.ServiceIntentions, "web", structs.DefaultEnterpriseMeta())
+		require.NoError(t, err)
+		if entry == nil {
+			return nil
+		}
+		return entry.(*structs.ServiceIntentionsConfigEntry).Sources
+	}
+
+	// Creating from nothing adds every source.
+	resp, err := reconcile([]*structs.SourceIntention{
+		{Name: "api", Action: structs.IntentionActionAllow},
+		{Name: "db", Action: structs.IntentionActionDeny},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"consul/api", "consul/db"}, resp.Added)
+	require.Empty(t, resp.Updated)
+	require.Empty(t, resp.Removed)
+	require.Len(t, getSources(), 2)
+
+	// Reconciling with the identical desired set is a no-op.
+	resp, err = reconcile([]*structs.SourceIntention{
+		{Name: "api", Action: structs.IntentionActionAllow},
+		{Name: "db", Action: structs.IntentionActionDeny},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Added)
+	require.Empty(t, resp.Updated)
+	require.Empty(t, resp.Removed)
+
+	// Changing one source's action, dropping another, and adding a new one
+	// updates/removes/adds in a single call.
+	resp, err = reconcile([]*structs.SourceIntention{
+		{Name: "api", Action: structs.IntentionActionDeny},
+		{Name: "cache", Action: structs.IntentionActionAllow},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"consul/cache"}, resp.Added)
+	require.Equal(t, []string{"consul/api"}, resp.Updated)
+	require.Equal(t, []string{"consul/db"}, resp.Removed)
+
+	sources := getSources()
+	require.Len(t, sources, 2)
+	for _, src := range sources {
+		if src.Name == "api" {
+			require.Equal(t, structs.IntentionActionDeny, src.Action)
+		}
+	}
+
+	// Reconciling to an empty set deletes the underlying config entry.
+	resp, err = reconcile(nil)
+	require.NoError(t, err)
+	require.Empty(t, resp.Added)
+	require.Empty(t, resp.Updated)
+	require.ElementsMatch(t, []string{"consul/api", "consul/cache"}, resp.Removed)
+	require.Nil(t, getSources())
+}
+
 func TestEqualStringMaps(t *testing.T) {
 	m1 := map[string]string{
 		"foo": "a",
@@ -385,7 +385,9 @@ func (m *Internal) EventFire(args *structs.EventFireRequest,
 		if err != nil {
 			err = fmt.Errorf("error broadcasting event to segment %q: %v", name, err)
 			errs = multierror.Append(errs, err)
+			continue
 		}
+		reply.NumRecipients += segment.NumNodes()
 	}
 	return errs
 }
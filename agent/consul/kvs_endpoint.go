@@ -86,7 +86,7 @@ func kvsPreApply(logger hclog.Logger, srv *Server, authz acl.Authorizer, op api.
 }
 
 // Apply is used to apply a KVS update request to the data store.
-func (k *KVS) Apply(args *structs.KVSRequest, reply *bool) error {
+func (k *KVS) Apply(args *structs.KVSRequest, reply *structs.KVSApplyResponse) error {
 	if done, err := k.srv.ForwardRPC("KVS.Apply", args, args, reply); done {
 		return err
 	}
@@ -107,12 +107,12 @@ func (k *KVS) Apply(args *structs.KVSRequest, reply *bool) error {
 		return err
 	}
 	if !ok {
-		*reply = false
+		reply.Success = false
 		return nil
 	}
 
 	// Apply the update.
-	resp, err := k.srv.raftApply(structs.KVSRequestType, args)
+	resp, index, err := k.srv.raftApplyMsgpackWithIndex(structs.KVSRequestType, args)
 	if err != nil {
 		k.logger.Error("Raft apply failed", "error", err)
 		return err
@@ -121,9 +121,16 @@ func (k *KVS) Apply(args *structs.KVSRequest, reply *bool) error {
 		return respErr
 	}
 
-	// Check if the return type is a bool.
+	// Apply ops like KVSet, KVDelete, and KVDeleteTree return a nil error on
+	// success rather than a bool, so only treat the response as a failure if
+	// it's explicitly false.
 	if respBool, ok := resp.(bool); ok {
-		*reply = respBool
+		reply.Success = respBool
+	} else {
+		reply.Success = true
+	}
+	if reply.Success {
+		reply.Index = index
 	}
 	return nil
 }
@@ -135,8 +142,7 @@ func (k *KVS) Get(args *structs.KeyRequest, reply *structs.IndexedDirEntries) er
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
-	if err != nil {
+	if _, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	}
 
@@ -148,6 +154,17 @@ func (k *KVS) Get(args *structs.KeyRequest, reply *structs.IndexedDirEntries) er
 		&args.QueryOptions,
 		&reply.QueryMeta,
 		func(ws memdb.WatchSet, state *state.Store) error {
+			// Re-resolve the token on every pass through the blocking query
+			// loop, rather than once up front, so that a token whose
+			// policies change or that is revoked/expires while this watch is
+			// blocked loses access on its very next result instead of only
+			// after the client reconnects.
+			var authzContext acl.AuthorizerContext
+			authz, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+			if err != nil {
+				return err
+			}
+
 			index, ent, err := state.KVSGet(ws, args.Key, &args.EnterpriseMeta)
 			if err != nil {
 				return err
@@ -180,8 +197,7 @@ func (k *KVS) List(args *structs.KeyRequest, reply *structs.IndexedDirEntries) e
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
-	if err != nil {
+	if _, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	}
 
@@ -189,14 +205,21 @@ func (k *KVS) List(args *structs.KeyRequest, reply *structs.IndexedDirEntries) e
 		return err
 	}
 
-	if authz != nil && k.srv.config.ACLEnableKeyListPolicy && authz.KeyList(args.Key, &authzContext) != acl.Allow {
-		return acl.ErrPermissionDenied
-	}
-
 	return k.srv.blockingQuery(
 		&args.QueryOptions,
 		&reply.QueryMeta,
 		func(ws memdb.WatchSet, state *state.Store) error {
+			// Re-resolve the token on every pass through the blocking query
+			// loop; see the comment in KVS.Get for why.
+			var authzContext acl.AuthorizerContext
+			authz, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+			if err != nil {
+				return err
+			}
+			if authz != nil && k.srv.config.ACLEnableKeyListPolicy && authz.KeyList(args.Key, &authzContext) != acl.Allow {
+				return acl.ErrPermissionDenied
+			}
+
 			index, ent, err := state.KVSList(ws, args.Key, &args.EnterpriseMeta)
 			if err != nil {
 				return err
@@ -232,8 +255,7 @@ func (k *KVS) ListKeys(args *structs.KeyListRequest, reply *structs.IndexedKeyLi
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
-	if err != nil {
+	if _, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	}
 
@@ -241,14 +263,21 @@ func (k *KVS) ListKeys(args *structs.KeyListRequest, reply *structs.IndexedKeyLi
 		return err
 	}
 
-	if authz != nil && k.srv.config.ACLEnableKeyListPolicy && authz.KeyList(args.Prefix, &authzContext) != acl.Allow {
-		return acl.ErrPermissionDenied
-	}
-
 	return k.srv.blockingQuery(
 		&args.QueryOptions,
 		&reply.QueryMeta,
 		func(ws memdb.WatchSet, state *state.Store) error {
+			// Re-resolve the token on every pass through the blocking query
+			// loop; see the comment in KVS.Get for why.
+			var authzContext acl.AuthorizerContext
+			authz, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+			if err != nil {
+				return err
+			}
+			if authz != nil && k.srv.config.ACLEnableKeyListPolicy && authz.KeyList(args.Prefix, &authzContext) != acl.Allow {
+				return acl.ErrPermissionDenied
+			}
+
 			index, entries, err := state.KVSList(ws, args.Prefix, &args.EnterpriseMeta)
 			if err != nil {
 				return err
@@ -32,10 +32,13 @@ func TestKVS_Apply(t *testing.T) {
 			Value: []byte("test"),
 		},
 	}
-	var out bool
+	var out structs.KVSApplyResponse
 	if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
+	if out.Index == 0 {
+		t.Fatalf("bad: %v", out)
+	}
 
 	// Verify
 	state := s1.fsm.State()
@@ -46,6 +49,9 @@ func TestKVS_Apply(t *testing.T) {
 	if d == nil {
 		t.Fatalf("should not be nil")
 	}
+	if d.ModifyIndex != out.Index {
+		t.Fatalf("bad: %v != %v", d.ModifyIndex, out.Index)
+	}
 
 	// Do a check and set
 	arg.Op = api.KVCAS
@@ -56,7 +62,10 @@ func TestKVS_Apply(t *testing.T) {
 	}
 
 	// Check this was applied
-	if out != true {
+	if out.Success != true {
+		t.Fatalf("bad: %v", out.Success)
+	}
+	if out.Index == 0 {
 		t.Fatalf("bad: %v", out)
 	}
 
@@ -113,7 +122,7 @@ func TestKVS_Apply_ACLDeny(t *testing.T) {
 		},
 		WriteRequest: structs.WriteRequest{Token: id},
 	}
-	var outR bool
+	var outR structs.KVSApplyResponse
 	err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &argR, &outR)
 	if !acl.IsErrPermissionDenied(err) {
 		t.Fatalf("err: %v", err)
@@ -153,7 +162,7 @@ func TestKVS_Get(t *testing.T) {
 			Value: []byte("test"),
 		},
 	}
-	var out bool
+	var out structs.KVSApplyResponse
 	if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -207,7 +216,7 @@ func TestKVS_Get_ACLDeny(t *testing.T) {
 		},
 		WriteRequest: structs.WriteRequest{Token: "root"},
 	}
-	var out bool
+	var out structs.KVSApplyResponse
 	if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -223,6 +232,79 @@ func TestKVS_Get_ACLDeny(t *testing.T) {
 
 }
 
+// TestKVS_Get_BlockingQuery_TokenRevoked verifies that a blocking KVS.Get
+// held open by a token that gets deleted while the query is still blocked
+// picks up the revocation on its next wakeup, instead of returning results
+// under the token's now-stale authorization until the client reconnects.
+func TestKVS_Get_BlockingQuery_TokenRevoked(t *testing.T) {
+	t.Parallel()
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.ACLDatacenter = "dc1"
+		c.ACLsEnabled = true
+		c.ACLMasterToken = "root"
+		c.ACLDefaultPolicy = "deny"
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForTestAgent(t, s1.RPC, "dc1", testrpc.WithToken("root"))
+
+	token, err := upsertTestTokenWithPolicyRules(codec, "root", "dc1", `key_prefix "" { policy = "read" }`)
+	require.NoError(t, err)
+
+	arg := structs.KVSRequest{
+		Datacenter: "dc1",
+		Op:         api.KVSet,
+		DirEnt: structs.DirEntry{
+			Key:   "watched",
+			Value: []byte("one"),
+		},
+		WriteRequest: structs.WriteRequest{Token: "root"},
+	}
+	var out structs.KVSApplyResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out))
+
+	getR := structs.KeyRequest{
+		Datacenter: "dc1",
+		Key:        "watched",
+		QueryOptions: structs.QueryOptions{
+			Token: token.SecretID,
+		},
+	}
+	var dirent structs.IndexedDirEntries
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "KVS.Get", &getR, &dirent))
+	require.Len(t, dirent.Entries, 1)
+
+	blockingCodec := rpcClient(t, s1)
+	defer blockingCodec.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		blockingR := getR
+		blockingR.QueryOptions.MinQueryIndex = dirent.Index
+		blockingR.QueryOptions.MaxQueryTime = 10 * time.Second
+		var blockingDirent structs.IndexedDirEntries
+		errCh <- msgpackrpc.CallWithCodec(blockingCodec, "KVS.Get", &blockingR, &blockingDirent)
+	}()
+
+	require.NoError(t, deleteTestToken(codec, "root", "dc1", token.AccessorID))
+
+	// Wake the blocked query up by changing the watched key, so it re-checks
+	// authorization on its next pass through the loop instead of waiting out
+	// the full MaxQueryTime.
+	arg.DirEnt.Value = []byte("two")
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out))
+
+	select {
+	case err := <-errCh:
+		require.True(t, acl.IsErrPermissionDenied(err) || acl.IsErrNotFound(err), "expected permission denied or not found, got: %v", err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("blocking query did not return after its token was revoked")
+	}
+}
+
 func TestKVSEndpoint_List(t *testing.T) {
 	t.Parallel()
 	dir1, s1 := testServer(t)
@@ -248,7 +330,7 @@ func TestKVSEndpoint_List(t *testing.T) {
 				Flags: 1,
 			},
 		}
-		var out bool
+		var out structs.KVSApplyResponse
 		if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -320,7 +402,7 @@ func TestKVSEndpoint_List_Blocking(t *testing.T) {
 				Flags: 1,
 			},
 		}
-		var out bool
+		var out structs.KVSApplyResponse
 		if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -352,7 +434,7 @@ func TestKVSEndpoint_List_Blocking(t *testing.T) {
 				Key: "/test/sub/key3",
 			},
 		}
-		var out bool
+		var out structs.KVSApplyResponse
 		if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 			t.Errorf("RPC call failed: %v", err)
 		}
@@ -424,7 +506,7 @@ func TestKVSEndpoint_List_ACLDeny(t *testing.T) {
 			},
 			WriteRequest: structs.WriteRequest{Token: "root"},
 		}
-		var out bool
+		var out structs.KVSApplyResponse
 		if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -510,7 +592,7 @@ func TestKVSEndpoint_List_ACLEnableKeyListPolicy(t *testing.T) {
 			},
 			WriteRequest: structs.WriteRequest{Token: "root"},
 		}
-		var out bool
+		var out structs.KVSApplyResponse
 		if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -627,7 +709,7 @@ func TestKVSEndpoint_ListKeys(t *testing.T) {
 				Flags: 1,
 			},
 		}
-		var out bool
+		var out structs.KVSApplyResponse
 		if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -705,7 +787,7 @@ func TestKVSEndpoint_ListKeys_ACLDeny(t *testing.T) {
 			},
 			WriteRequest: structs.WriteRequest{Token: "root"},
 		}
-		var out bool
+		var out structs.KVSApplyResponse
 		if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -804,11 +886,11 @@ func TestKVS_Apply_LockDelay(t *testing.T) {
 			Session: validID,
 		},
 	}
-	var out bool
+	var out structs.KVSApplyResponse
 	if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
-	if out != false {
+	if out.Success != false {
 		t.Fatalf("should not acquire")
 	}
 
@@ -819,7 +901,7 @@ func TestKVS_Apply_LockDelay(t *testing.T) {
 	if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
-	if out != true {
+	if out.Success != true {
 		t.Fatalf("should acquire")
 	}
 }
@@ -844,7 +926,7 @@ func TestKVS_Issue_1626(t *testing.T) {
 				Value: []byte("test"),
 			},
 		}
-		var out bool
+		var out structs.KVSApplyResponse
 		if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -908,7 +990,7 @@ func TestKVS_Issue_1626(t *testing.T) {
 				Value: []byte("test"),
 			},
 		}
-		var out bool
+		var out structs.KVSApplyResponse
 		if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -931,7 +1013,7 @@ func TestKVS_Issue_1626(t *testing.T) {
 				Value: []byte("updated"),
 			},
 		}
-		var out bool
+		var out structs.KVSApplyResponse
 		if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -173,18 +173,20 @@ RECONCILE:
 	interval := time.After(s.config.ReconcileInterval)
 
 	// Apply a raft barrier to ensure our FSM is caught up
-	start := time.Now()
+	timer := newLeadershipTimer()
 	barrier := s.raft.Barrier(barrierWriteTimeout)
 	if err := barrier.Error(); err != nil {
 		s.logger.Error("failed to wait for barrier", "error", err)
+		timer.finish(s, err)
 		goto WAIT
 	}
-	metrics.MeasureSince([]string{"leader", "barrier"}, start)
+	timer.mark("barrier")
 
 	// Check if we need to handle initial leadership actions
 	if !establishedLeader {
-		if err := s.establishLeadership(); err != nil {
+		if err := s.establishLeadership(timer); err != nil {
 			s.logger.Error("failed to establish leadership", "error", err)
+			timer.finish(s, err)
 			// Immediately revoke leadership since we didn't successfully
 			// establish leadership.
 			s.revokeLeadership()
@@ -201,6 +203,7 @@ RECONCILE:
 			}
 			return
 		}
+		timer.finish(s, nil)
 		establishedLeader = true
 		defer s.revokeLeadership()
 	}
@@ -256,7 +259,9 @@ WAIT:
 			// leader, which means revokeLeadership followed by an
 			// establishLeadership().
 			s.revokeLeadership()
-			err := s.establishLeadership()
+			reassertTimer := newLeadershipTimer()
+			err := s.establishLeadership(reassertTimer)
+			reassertTimer.finish(s, err)
 			errCh <- err
 
 			// in case establishLeadership failed, we will try to
@@ -285,11 +290,55 @@ WAIT:
 	}
 }
 
+// leadershipTimer accumulates the per-phase timings of a single leader
+// establishment attempt (the raft barrier plus establishLeadership), both
+// emitting a "consul.leader.<phase>" metric for each phase and recording
+// the timeline on the server for reporting via the Operator.Leadership RPC,
+// to help diagnose slow failovers.
+type leadershipTimer struct {
+	start    time.Time
+	lastMark time.Time
+	phases   []structs.LeadershipTransitionPhase
+}
+
+func newLeadershipTimer() *leadershipTimer {
+	now := time.Now()
+	return &leadershipTimer{start: now, lastMark: now}
+}
+
+// mark records the duration since the previous mark (or since the timer was
+// created) under name, both as a metric and as a phase in the timeline
+// recorded by finish.
+func (t *leadershipTimer) mark(name string) {
+	now := time.Now()
+	metrics.MeasureSince([]string{"leader", name}, t.lastMark)
+	t.phases = append(t.phases, structs.LeadershipTransitionPhase{Name: name, Duration: now.Sub(t.lastMark)})
+	t.lastMark = now
+}
+
+// finish records the completed transition - successful or not - as the
+// server's most recently observed leadership transition.
+func (t *leadershipTimer) finish(s *Server, err error) {
+	transition := &structs.LeadershipTransition{
+		Time:     t.start,
+		Phases:   t.phases,
+		Duration: time.Since(t.start),
+	}
+	if err != nil {
+		transition.Error = err.Error()
+	}
+
+	s.lastLeadershipTransitionLock.Lock()
+	s.lastLeadershipTransition = transition
+	s.lastLeadershipTransitionLock.Unlock()
+}
+
 // establishLeadership is invoked once we become leader and are able
 // to invoke an initial barrier. The barrier is used to ensure any
 // previously inflight transactions have been committed and that our
-// state is up-to-date.
-func (s *Server) establishLeadership() error {
+// state is up-to-date. timer accumulates the duration of each phase below
+// for reporting via Operator.Leadership; see leadershipTimer.
+func (s *Server) establishLeadership(timer *leadershipTimer) error {
 	start := time.Now()
 	// check for the upgrade here - this helps us transition to new ACLs much
 	// quicker if this is a new cluster or this is a test agent
@@ -302,6 +351,7 @@ func (s *Server) establishLeadership() error {
 	} else if err := s.initializeACLs(false); err != nil {
 		return err
 	}
+	timer.mark("acl-init")
 
 	// Hint the tombstone expiration timer. When we freshly establish leadership
 	// we become the authoritative timer, and so we need to start the clock
@@ -329,6 +379,7 @@ func (s *Server) establishLeadership() error {
 
 	s.getOrCreateAutopilotConfig()
 	s.autopilot.Start()
+	timer.mark("autopilot-start")
 
 	// todo(kyhavlov): start a goroutine here for handling periodic CA rotation
 	if err := s.initializeCA(); err != nil {
@@ -336,11 +387,14 @@ func (s *Server) establishLeadership() error {
 	}
 
 	s.startConfigReplication()
+	timer.mark("config-replication-start")
 
 	s.startFederationStateReplication()
 
 	s.startFederationStateAntiEntropy()
 
+	s.startSyncPacing()
+
 	if err := s.startConnectLeader(); err != nil {
 		return err
 	}
@@ -372,6 +426,8 @@ func (s *Server) revokeLeadership() {
 
 	s.stopFederationStateAntiEntropy()
 
+	s.stopSyncPacing()
+
 	s.stopFederationStateReplication()
 
 	s.stopConfigReplication()
@@ -1563,10 +1619,7 @@ func (s *Server) DatacenterSupportsFederationStates() bool {
 		return true
 	}
 
-	state := serversFederationStatesInfo{
-		supported: true,
-		found:     false,
-	}
+	state := featureCheckState{name: "fs", supported: true}
 
 	// if we are in a secondary, check if they are supported in the primary dc
 	if s.config.PrimaryDatacenter != s.config.Datacenter {
@@ -1590,35 +1643,6 @@ func (s *Server) DatacenterSupportsFederationStates() bool {
 	return false
 }
 
-type serversFederationStatesInfo struct {
-	// supported indicates whether every processed server supports federation states
-	supported bool
-
-	// found indicates that at least one server was processed
-	found bool
-}
-
-func (s *serversFederationStatesInfo) update(srv *metadata.Server) bool {
-	if srv.Status != serf.StatusAlive && srv.Status != serf.StatusFailed {
-		// they are left or something so regardless we treat these servers as meeting
-		// the version requirement
-		return true
-	}
-
-	// mark that we processed at least one server
-	s.found = true
-
-	if supported, ok := srv.FeatureFlags["fs"]; ok && supported == 1 {
-		return true
-	}
-
-	// mark that at least one server does not support federation states
-	s.supported = false
-
-	// prevent continuing server evaluation
-	return false
-}
-
 func (s *Server) setDatacenterSupportsIntentionsAsConfigEntries() {
 	atomic.StoreInt32(&s.dcSupportsIntentionsAsConfigEntries, 1)
 }
@@ -1628,10 +1652,7 @@ func (s *Server) DatacenterSupportsIntentionsAsConfigEntries() bool {
 		return true
 	}
 
-	state := serversIntentionsAsConfigEntriesInfo{
-		supported: true,
-		found:     false,
-	}
+	state := featureCheckState{name: "si", supported: true}
 
 	// if we are in a secondary, check if they are supported in the primary dc
 	if s.config.PrimaryDatacenter != s.config.Datacenter {
@@ -1654,32 +1675,3 @@ func (s *Server) DatacenterSupportsIntentionsAsConfigEntries() bool {
 	s.logger.Debug("intentions cannot be migrated to config entries in this datacenter", "datacenter", s.config.Datacenter)
 	return false
 }
-
-type serversIntentionsAsConfigEntriesInfo struct {
-	// supported indicates whether every processed server supports intentions as config entries
-	supported bool
-
-	// found indicates that at least one server was processed
-	found bool
-}
-
-func (s *serversIntentionsAsConfigEntriesInfo) update(srv *metadata.Server) bool {
-	if srv.Status != serf.StatusAlive && srv.Status != serf.StatusFailed {
-		// they are left or something so regardless we treat these servers as meeting
-		// the version requirement
-		return true
-	}
-
-	// mark that we processed at least one server
-	s.found = true
-
-	if supported, ok := srv.FeatureFlags["si"]; ok && supported == 1 {
-		return true
-	}
-
-	// mark that at least one server does not support service-intentions
-	s.supported = false
-
-	// prevent continuing server evaluation
-	return false
-}
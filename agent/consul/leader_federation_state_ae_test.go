@@ -102,7 +102,7 @@ func TestLeader_FederationStateAntiEntropy_BlockingQuery(t *testing.T) {
 			Service:    csn.Service,
 			Checks:     csn.Checks,
 		}
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.NoError(t, s2.RPC("Catalog.Register", &arg, &out))
 	}
 
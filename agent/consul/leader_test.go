@@ -460,7 +460,7 @@ func TestLeader_Reconcile_ReapMember(t *testing.T) {
 			Token: "root",
 		},
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := s1.RPC("Catalog.Register", &dead, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -566,7 +566,7 @@ func TestLeader_Reconcile_Races(t *testing.T) {
 			Output:  "",
 		},
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := s1.RPC("Catalog.Register", &req, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -838,7 +838,7 @@ func TestLeader_ReapTombstones(t *testing.T) {
 			Token: "root",
 		},
 	}
-	var out bool
+	var out structs.KVSApplyResponse
 	if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -1352,7 +1352,7 @@ func TestDatacenterSupportsFederationStates(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.NoError(t, srv.RPC("Catalog.Register", &arg, &out))
 	}
 
@@ -0,0 +1,38 @@
+package consul
+
+import (
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/go-memdb"
+)
+
+// ConfigEntryAuditLog returns the bounded log of config entry and intention
+// changes, recording who (ACL accessor ID) changed what and when.
+func (op *Operator) ConfigEntryAuditLog(args *structs.ConfigEntryAuditLogRequest, reply *structs.ConfigEntryAuditLogResponse) error {
+	if done, err := op.srv.ForwardRPC("Operator.ConfigEntryAuditLog", args, args, reply); done {
+		return err
+	}
+
+	rule, err := op.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if rule != nil && rule.OperatorRead(nil) != acl.Allow {
+		return acl.ErrPermissionDenied
+	}
+
+	return op.srv.blockingQuery(
+		&args.QueryOptions,
+		&reply.QueryMeta,
+		func(ws memdb.WatchSet, state *state.Store) error {
+			index, entries, err := state.ConfigEntryAuditLog(ws)
+			if err != nil {
+				return err
+			}
+
+			reply.Index = index
+			reply.Entries = entries
+			return nil
+		})
+}
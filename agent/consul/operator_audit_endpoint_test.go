@@ -0,0 +1,53 @@
+package consul
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperator_ConfigEntryAuditLog(t *testing.T) {
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	entry := &structs.ServiceConfigEntry{
+		Kind: structs.ServiceDefaults,
+		Name: "web",
+	}
+	{
+		req := structs.ConfigEntryRequest{
+			Datacenter: "dc1",
+			Op:         structs.ConfigEntryUpsert,
+			Entry:      entry,
+		}
+		var reply bool
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConfigEntry.Apply", &req, &reply))
+	}
+
+	arg := structs.ConfigEntryAuditLogRequest{
+		Datacenter: "dc1",
+	}
+	var reply structs.ConfigEntryAuditLogResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.ConfigEntryAuditLog", &arg, &reply))
+
+	var found *structs.ConfigEntryAuditEntry
+	for _, e := range reply.Entries {
+		if e.Kind == structs.ServiceDefaults && e.Name == "web" {
+			found = e
+		}
+	}
+	require.NotNil(t, found, "expected to find the audit entry for the service-defaults write")
+	require.Equal(t, "upsert", found.Op)
+	require.Equal(t, "anonymous", found.Author)
+	require.False(t, found.Timestamp.IsZero())
+}
@@ -0,0 +1,69 @@
+package consul
+
+import (
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/metadata"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// ConvergenceStatus reports, for every server in the datacenter, whether it
+// has applied a given Raft index. It's meant for deployment tooling that
+// would otherwise sleep an arbitrary duration after a write and hope that's
+// long enough for the change to show up everywhere; this lets it poll
+// instead. This only covers servers: see ConvergenceStatusResponse for why
+// client agents' caches and streams have to be checked separately.
+func (op *Operator) ConvergenceStatus(args *structs.ConvergenceStatusRequest, reply *structs.ConvergenceStatusResponse) error {
+	// This must be sent to the leader, since it's the one place that has an
+	// up to date view of every server in the datacenter via serf.
+	args.RequireConsistent = true
+	args.AllowStale = false
+	if done, err := op.srv.ForwardRPC("Operator.ConvergenceStatus", args, args, reply); done {
+		return err
+	}
+
+	// This action requires operator read access.
+	rule, err := op.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if rule != nil && rule.OperatorRead(nil) != acl.Allow {
+		return acl.ErrPermissionDenied
+	}
+
+	var servers []*metadata.Server
+	for _, m := range op.srv.serfLAN.Members() {
+		if ok, parts := metadata.IsConsulServer(m); ok {
+			servers = append(servers, parts)
+		}
+	}
+
+	resultCh := make(chan structs.ServerConvergenceStatus, len(servers))
+	for _, server := range servers {
+		go op.fetchConvergenceStatus(server, args.Index, resultCh)
+	}
+
+	reply.Index = args.Index
+	reply.Servers = make([]structs.ServerConvergenceStatus, 0, len(servers))
+	for i := 0; i < len(servers); i++ {
+		reply.Servers = append(reply.Servers, <-resultCh)
+	}
+	return nil
+}
+
+// fetchConvergenceStatus asks a single server for its applied index and
+// reports the result on resultCh. It's run in its own goroutine per server
+// so one slow or unreachable server can't hold up the others.
+func (op *Operator) fetchConvergenceStatus(server *metadata.Server, index uint64, resultCh chan<- structs.ServerConvergenceStatus) {
+	status := structs.ServerConvergenceStatus{Name: server.Name}
+
+	var applied uint64
+	if err := op.srv.connPool.RPC(op.srv.config.Datacenter, server.ShortName, server.Addr,
+		"Status.AppliedIndex", struct{}{}, &applied); err != nil {
+		status.Error = err.Error()
+	} else {
+		status.AppliedIndex = applied
+		status.Applied = applied >= index
+	}
+
+	resultCh <- status
+}
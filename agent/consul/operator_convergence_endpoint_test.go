@@ -0,0 +1,41 @@
+package consul
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperator_ConvergenceStatus(t *testing.T) {
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	arg := structs.ConvergenceStatusRequest{
+		Datacenter: "dc1",
+		Index:      1,
+	}
+	var reply structs.ConvergenceStatusResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.ConvergenceStatus", &arg, &reply))
+
+	require.EqualValues(t, 1, reply.Index)
+	require.Len(t, reply.Servers, 1)
+	require.Empty(t, reply.Servers[0].Error)
+	require.True(t, reply.Servers[0].Applied)
+	require.GreaterOrEqual(t, reply.Servers[0].AppliedIndex, uint64(1))
+
+	// An index far beyond anything applied should come back unsatisfied.
+	arg.Index = reply.Servers[0].AppliedIndex + 1000000
+	var reply2 structs.ConvergenceStatusResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.ConvergenceStatus", &arg, &reply2))
+	require.False(t, reply2.Servers[0].Applied)
+}
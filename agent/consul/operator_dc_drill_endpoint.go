@@ -0,0 +1,83 @@
+package consul
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/go-memdb"
+)
+
+// DatacenterDrill starts or stops a simulated failover drill against a
+// single remote datacenter. While a drill is active against a datacenter,
+// this server excludes it from prepared query failover and from the list
+// of other datacenters it reports for mesh gateway discovery, without
+// making any change to real networking.
+func (op *Operator) DatacenterDrill(args *structs.DatacenterDrillRequest, reply *struct{}) error {
+	if done, err := op.srv.ForwardRPC("Operator.DatacenterDrill", args, args, reply); done {
+		return err
+	}
+
+	rule, err := op.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if rule != nil && rule.OperatorWrite(nil) != acl.Allow {
+		return acl.ErrPermissionDenied
+	}
+
+	switch args.Op {
+	case structs.DatacenterDrillStart:
+		if args.TargetDatacenter == "" {
+			return fmt.Errorf("TargetDatacenter must be set")
+		}
+		if args.Duration <= 0 {
+			return fmt.Errorf("Duration must be positive")
+		}
+		args.ExpiresAt = time.Now().Add(args.Duration)
+	case structs.DatacenterDrillStop:
+	default:
+		return fmt.Errorf("invalid datacenter drill operation: %v", args.Op)
+	}
+
+	resp, err := op.srv.raftApply(structs.DatacenterDrillRequestType, args)
+	if err != nil {
+		return err
+	}
+	if respErr, ok := resp.(error); ok {
+		return respErr
+	}
+	return nil
+}
+
+// DatacenterDrills returns the remote datacenters that currently have an
+// active failover drill against them.
+func (op *Operator) DatacenterDrills(args *structs.DCSpecificRequest, reply *structs.DatacenterDrillsResponse) error {
+	if done, err := op.srv.ForwardRPC("Operator.DatacenterDrills", args, args, reply); done {
+		return err
+	}
+
+	rule, err := op.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if rule != nil && rule.OperatorRead(nil) != acl.Allow {
+		return acl.ErrPermissionDenied
+	}
+
+	return op.srv.blockingQuery(
+		&args.QueryOptions,
+		&reply.QueryMeta,
+		func(ws memdb.WatchSet, state *state.Store) error {
+			index, drills, err := state.DatacenterDrills(ws)
+			if err != nil {
+				return err
+			}
+
+			reply.Index = index
+			reply.Drills = drills
+			return nil
+		})
+}
@@ -0,0 +1,65 @@
+package consul
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperator_DatacenterDrill(t *testing.T) {
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	{
+		arg := structs.DatacenterDrillRequest{
+			Datacenter:       "dc1",
+			Op:               structs.DatacenterDrillStart,
+			TargetDatacenter: "dc2",
+			Duration:         time.Minute,
+		}
+		var reply struct{}
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DatacenterDrill", &arg, &reply))
+	}
+
+	{
+		arg := structs.DCSpecificRequest{Datacenter: "dc1"}
+		var reply structs.DatacenterDrillsResponse
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DatacenterDrills", &arg, &reply))
+		require.Len(t, reply.Drills, 1)
+		require.Equal(t, "dc2", reply.Drills[0].TargetDatacenter)
+	}
+
+	require.True(t, func() bool {
+		drilled, err := s1.fsm.State().IsDatacenterDrilled("dc2")
+		require.NoError(t, err)
+		return drilled
+	}())
+
+	{
+		arg := structs.DatacenterDrillRequest{
+			Datacenter:       "dc1",
+			Op:               structs.DatacenterDrillStop,
+			TargetDatacenter: "dc2",
+		}
+		var reply struct{}
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DatacenterDrill", &arg, &reply))
+	}
+
+	{
+		arg := structs.DCSpecificRequest{Datacenter: "dc1"}
+		var reply structs.DatacenterDrillsResponse
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DatacenterDrills", &arg, &reply))
+		require.Empty(t, reply.Drills)
+	}
+}
@@ -0,0 +1,27 @@
+package consul
+
+import (
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// FeatureRollout is used to retrieve the cluster-wide rollout status of
+// features that are gated on every server in the datacenter supporting
+// them.
+func (op *Operator) FeatureRollout(args *structs.DCSpecificRequest, reply *structs.FeatureRolloutStatusResponse) error {
+	if done, err := op.srv.ForwardRPC("Operator.FeatureRollout", args, args, reply); done {
+		return err
+	}
+
+	// This action requires operator read access.
+	rule, err := op.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if rule != nil && rule.OperatorRead(nil) != acl.Allow {
+		return acl.ErrPermissionDenied
+	}
+
+	reply.Features = op.srv.FeatureRolloutStatus()
+	return nil
+}
@@ -0,0 +1,39 @@
+package consul
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperator_FeatureRollout(t *testing.T) {
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	arg := structs.DCSpecificRequest{
+		Datacenter: "dc1",
+	}
+	var reply structs.FeatureRolloutStatusResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.FeatureRollout", &arg, &reply))
+
+	names := make(map[string]bool)
+	for _, f := range reply.Features {
+		names[f.Name] = f.Enabled
+	}
+	require.Contains(t, names, "fs")
+	require.Contains(t, names, "si")
+	require.Contains(t, names, "new-acls")
+	// a single voting server should always support its own features
+	require.True(t, names["fs"])
+	require.True(t, names["si"])
+}
@@ -0,0 +1,75 @@
+package consul
+
+import (
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// FSCK scans the catalog, session, ACL, and config entry tables for
+// dangling references (services on nodes that no longer exist, checks on
+// services that no longer exist, and so on) and optionally repairs the
+// subset of violations that can be corrected automatically.
+func (op *Operator) FSCK(args *structs.FSCKRequest, reply *structs.FSCKResponse) error {
+	if done, err := op.srv.ForwardRPC("Operator.FSCK", args, args, reply); done {
+		return err
+	}
+
+	// This action requires operator read access, and operator write access
+	// if a repair was requested.
+	rule, err := op.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if rule != nil && rule.OperatorRead(nil) != acl.Allow {
+		return acl.ErrPermissionDenied
+	}
+	if args.Repair && rule != nil && rule.OperatorWrite(nil) != acl.Allow {
+		return acl.ErrPermissionDenied
+	}
+
+	state := op.srv.fsm.State()
+	results, err := state.Fsck(structs.WildcardEnterpriseMeta())
+	if err != nil {
+		return err
+	}
+
+	if args.Repair {
+		for i, result := range results {
+			if !result.Repairable {
+				continue
+			}
+			if err := op.repair(result); err != nil {
+				return err
+			}
+			results[i].Repaired = true
+		}
+	}
+
+	reply.Results = results
+	return nil
+}
+
+// repair corrects a single repairable FSCKResult in place via the normal
+// Raft apply path for the affected subsystem.
+func (op *Operator) repair(result structs.FSCKResult) error {
+	switch result.Category {
+	case structs.FSCKSessionMissingNode:
+		req := structs.SessionRequest{
+			Datacenter: op.srv.config.Datacenter,
+			Op:         structs.SessionDestroy,
+			Session: structs.Session{
+				ID: result.Resource,
+			},
+		}
+		resp, err := op.srv.raftApply(structs.SessionRequestType, &req)
+		if err != nil {
+			return err
+		}
+		if respErr, ok := resp.(error); ok {
+			return respErr
+		}
+		return nil
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,65 @@
+package consul
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperator_FSCK(t *testing.T) {
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	// Deregistering a node normally destroys any sessions still held
+	// against it, so a dangling session can't arise through the ordinary
+	// catalog/session RPCs. Insert one directly via the restore path
+	// (which skips that cascade) to simulate one, e.g. left over from a
+	// partial Raft restore.
+	sessionID := generateUUID()
+	restore := s1.fsm.State().Restore()
+	require.NoError(t, restore.Session(&structs.Session{
+		ID:       sessionID,
+		Node:     "orphan",
+		Behavior: structs.SessionKeysRelease,
+		RaftIndex: structs.RaftIndex{
+			CreateIndex: 100,
+			ModifyIndex: 100,
+		},
+	}))
+	require.NoError(t, restore.Commit())
+
+	arg := structs.FSCKRequest{
+		Datacenter: "dc1",
+	}
+	var reply structs.FSCKResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.FSCK", &arg, &reply))
+
+	var found *structs.FSCKResult
+	for i, result := range reply.Results {
+		if result.Category == structs.FSCKSessionMissingNode && result.Resource == sessionID {
+			found = &reply.Results[i]
+		}
+	}
+	require.NotNil(t, found, "expected to find the dangling session")
+	require.True(t, found.Repairable)
+	require.False(t, found.Repaired)
+
+	// Repair should destroy the dangling session.
+	arg.Repair = true
+	var repairReply structs.FSCKResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.FSCK", &arg, &repairReply))
+
+	_, session, err := s1.fsm.State().SessionGet(nil, sessionID, nil)
+	require.NoError(t, err)
+	require.Nil(t, session)
+}
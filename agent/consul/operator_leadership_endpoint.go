@@ -0,0 +1,28 @@
+package consul
+
+import (
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// Leadership is used to retrieve the timeline of the leader's most recent
+// establishLeadership attempt, to help diagnose slow failovers.
+func (op *Operator) Leadership(args *structs.DCSpecificRequest, reply *structs.LeadershipStatusResponse) error {
+	if done, err := op.srv.ForwardRPC("Operator.Leadership", args, args, reply); done {
+		return err
+	}
+
+	// This action requires operator read access.
+	rule, err := op.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if rule != nil && rule.OperatorRead(nil) != acl.Allow {
+		return acl.ErrPermissionDenied
+	}
+
+	op.srv.lastLeadershipTransitionLock.Lock()
+	reply.LastTransition = op.srv.lastLeadershipTransition
+	op.srv.lastLeadershipTransitionLock.Unlock()
+	return nil
+}
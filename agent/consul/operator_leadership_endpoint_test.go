@@ -0,0 +1,40 @@
+package consul
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperator_Leadership(t *testing.T) {
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	arg := structs.DCSpecificRequest{
+		Datacenter: "dc1",
+	}
+	var reply structs.LeadershipStatusResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.Leadership", &arg, &reply))
+
+	require.NotNil(t, reply.LastTransition)
+	require.Empty(t, reply.LastTransition.Error)
+	require.NotEmpty(t, reply.LastTransition.Phases)
+
+	var sawBarrier bool
+	for _, phase := range reply.LastTransition.Phases {
+		if phase.Name == "barrier" {
+			sawBarrier = true
+		}
+	}
+	require.True(t, sawBarrier)
+}
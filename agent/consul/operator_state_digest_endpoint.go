@@ -0,0 +1,28 @@
+package consul
+
+import (
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// StateDigest returns the most recently computed FSM table hashes for the
+// server handling the RPC. Unlike most Operator RPCs, this is deliberately
+// never forwarded to the leader: the whole point is to let an operator
+// query each server individually (by targeting its RPC address) and compare
+// the digests to catch silent divergence, so forwarding would defeat the
+// purpose.
+func (op *Operator) StateDigest(args *structs.DCSpecificRequest, reply *structs.StateDigestResponse) error {
+	// This action requires operator read access.
+	rule, err := op.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if rule != nil && rule.OperatorRead(nil) != acl.Allow {
+		return acl.ErrPermissionDenied
+	}
+
+	op.srv.lastStateDigestLock.Lock()
+	reply.Digest = op.srv.lastStateDigest
+	op.srv.lastStateDigestLock.Unlock()
+	return nil
+}
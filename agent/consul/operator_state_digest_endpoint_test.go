@@ -0,0 +1,36 @@
+package consul
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperator_StateDigest(t *testing.T) {
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+	require.NoError(t, s1.updateStateDigest())
+
+	arg := structs.DCSpecificRequest{
+		Datacenter: "dc1",
+	}
+	var reply structs.StateDigestResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.StateDigest", &arg, &reply))
+
+	require.NotNil(t, reply.Digest)
+	for _, table := range state.DigestTables {
+		require.Contains(t, reply.Digest.Tables, table)
+		require.NotEmpty(t, reply.Digest.Tables[table])
+	}
+}
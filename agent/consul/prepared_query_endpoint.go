@@ -319,6 +319,50 @@ func (p *PreparedQuery) Explain(args *structs.PreparedQueryExecuteRequest,
 	return nil
 }
 
+// FailoverHistory returns the most recently recorded cross-datacenter
+// failover decisions for a prepared query, so operators can see which DCs a
+// query tried and how each hop fared without correlating server logs by
+// hand. The history is tracked in memory on whichever server last ran the
+// query's failover, so it isn't replicated via Raft and only reflects
+// hops that happened to run on the server handling this request.
+func (p *PreparedQuery) FailoverHistory(args *structs.PreparedQueryFailoverHistoryRequest,
+	reply *structs.PreparedQueryFailoverHistoryResponse) error {
+	if done, err := p.srv.ForwardRPC("PreparedQuery.FailoverHistory", args, args, reply); done {
+		return err
+	}
+
+	p.srv.setQueryMeta(&reply.QueryMeta)
+
+	state := p.srv.fsm.State()
+	_, query, err := state.PreparedQueryGet(nil, args.QueryID)
+	if err != nil {
+		return err
+	}
+	if query == nil {
+		return structs.ErrQueryNotFound
+	}
+
+	// Apply the same ACL rules as Get: if no prefix ACL applies to this
+	// query, they're always allowed to see it if they have the ID,
+	// otherwise filter it the usual way.
+	if _, ok := query.GetACLPrefix(); !ok {
+		if err := p.srv.filterACL(args.Token, &query); err != nil {
+			return err
+		}
+	} else {
+		wrapped := &structs.IndexedPreparedQueries{Queries: structs.PreparedQueries{query}}
+		if err := p.srv.filterACL(args.Token, wrapped); err != nil {
+			return err
+		}
+		if len(wrapped.Queries) == 0 {
+			return acl.ErrPermissionDenied
+		}
+	}
+
+	reply.Events = p.srv.queryFailoverEvents.history(args.QueryID)
+	return nil
+}
+
 // Execute runs a prepared query and returns the results. This will perform the
 // failover logic if no local results are available. This is typically called as
 // part of a DNS lookup, or when executing prepared queries from the HTTP API.
@@ -434,6 +478,10 @@ func (p *PreparedQuery) Execute(args *structs.PreparedQueryExecuteRequest,
 		}
 	}
 
+	// Push draining instances to the back so a limit sheds them first,
+	// in favor of healthy, non-draining alternatives.
+	reply.Nodes = reply.Nodes.DeprioritizeDraining()
+
 	// Apply the limit if given.
 	if args.Limit > 0 && len(reply.Nodes) > args.Limit {
 		reply.Nodes = reply.Nodes[:args.Limit]
@@ -631,6 +679,7 @@ type queryServer interface {
 	GetLogger() hclog.Logger
 	GetOtherDatacentersByDistance() ([]string, error)
 	ForwardDC(method, dc string, args interface{}, reply interface{}) error
+	RecordFailoverEvent(queryID string, event structs.QueryFailoverEvent)
 }
 
 // queryServerWrapper applies the queryServer interface to a Server.
@@ -644,7 +693,8 @@ func (q *queryServerWrapper) GetLogger() hclog.Logger {
 }
 
 // GetOtherDatacentersByDistance calls into the server's fn and filters out the
-// server's own DC.
+// server's own DC, along with any DC that's currently the target of a
+// failover drill.
 func (q *queryServerWrapper) GetOtherDatacentersByDistance() ([]string, error) {
 	// TODO (slackpad) - We should cache this result since it's expensive to
 	// compute.
@@ -655,9 +705,17 @@ func (q *queryServerWrapper) GetOtherDatacentersByDistance() ([]string, error) {
 
 	var result []string
 	for _, dc := range dcs {
-		if dc != q.srv.config.Datacenter {
-			result = append(result, dc)
+		if dc == q.srv.config.Datacenter {
+			continue
+		}
+		drilled, err := q.srv.fsm.State().IsDatacenterDrilled(dc)
+		if err != nil {
+			return nil, err
+		}
+		if drilled {
+			continue
 		}
+		result = append(result, dc)
 	}
 	return result, nil
 }
@@ -667,6 +725,12 @@ func (q *queryServerWrapper) ForwardDC(method, dc string, args interface{}, repl
 	return q.srv.forwardDC(method, dc, args, reply)
 }
 
+// RecordFailoverEvent records a failover hop in the server's in-memory
+// failover history for the given query.
+func (q *queryServerWrapper) RecordFailoverEvent(queryID string, event structs.QueryFailoverEvent) {
+	q.srv.queryFailoverEvents.record(queryID, event)
+}
+
 // queryFailover runs an algorithm to determine which DCs to try and then calls
 // them to try to locate alternative services.
 func queryFailover(q queryServer, query *structs.PreparedQuery,
@@ -742,7 +806,23 @@ func queryFailover(q queryServer, query *structs.PreparedQuery,
 			QueryOptions: args.QueryOptions,
 			Connect:      args.Connect,
 		}
-		if err := q.ForwardDC("PreparedQuery.ExecuteRemote", dc, remote, reply); err != nil {
+		start := time.Now()
+		err := q.ForwardDC("PreparedQuery.ExecuteRemote", dc, remote, reply)
+		rtt := time.Since(start)
+
+		labels := []metrics.Label{{Name: "datacenter", Value: dc}}
+		metrics.MeasureSinceWithLabels([]string{"prepared-query", "failover"}, start, labels)
+
+		q.RecordFailoverEvent(query.ID, structs.QueryFailoverEvent{
+			Datacenter:  dc,
+			Success:     err == nil,
+			ResultCount: len(reply.Nodes),
+			Latency:     rtt,
+			Timestamp:   start,
+		})
+
+		if err != nil {
+			metrics.IncrCounterWithLabels([]string{"prepared-query", "failover", "error"}, 1, labels)
 			q.GetLogger().Warn("Failed querying for service in datacenter",
 				"service", query.Service.Service,
 				"datacenter", dc,
@@ -751,6 +831,13 @@ func queryFailover(q queryServer, query *structs.PreparedQuery,
 			continue
 		}
 
+		q.GetLogger().Debug("Tried prepared query failover hop",
+			"service", query.Service.Service,
+			"datacenter", dc,
+			"latency", rtt,
+			"result_count", len(reply.Nodes),
+		)
+
 		// We can stop if we found some nodes.
 		if len(reply.Nodes) > 0 {
 			break
@@ -469,6 +469,59 @@ func TestPreparedQuery_Apply_ACLDeny(t *testing.T) {
 	}
 }
 
+func TestPreparedQuery_Apply_ACLPrefixDeny(t *testing.T) {
+	t.Parallel()
+	_, s1, codec := testACLServerWithConfig(t, nil, false)
+	waitForLeaderEstablishment(t, s1)
+
+	// Create a token that can only manage queries named with the "team1-"
+	// prefix, without any global query:write access.
+	token, err := upsertTestTokenWithPolicyRules(codec, TestDefaultMasterToken, "dc1", `
+            query_prefix "team1-" {
+                policy = "write"
+            }
+        `)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	// Creating a query under the "team1-" prefix should succeed.
+	query := structs.PreparedQueryRequest{
+		Datacenter: "dc1",
+		Op:         structs.PreparedQueryCreate,
+		Query: &structs.PreparedQuery{
+			Name: "team1-redis",
+			Service: structs.ServiceQuery{
+				Service: "the-redis",
+			},
+		},
+		WriteRequest: structs.WriteRequest{Token: token.SecretID},
+	}
+	var reply string
+	if err := msgpackrpc.CallWithCodec(codec, "PreparedQuery.Apply", &query, &reply); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	// Creating a query outside of the "team1-" prefix should be denied,
+	// even though the token has write access to queries under "team1-".
+	other := structs.PreparedQueryRequest{
+		Datacenter: "dc1",
+		Op:         structs.PreparedQueryCreate,
+		Query: &structs.PreparedQuery{
+			Name: "team2-redis",
+			Service: structs.ServiceQuery{
+				Service: "the-redis",
+			},
+		},
+		WriteRequest: structs.WriteRequest{Token: token.SecretID},
+	}
+	var otherReply string
+	err = msgpackrpc.CallWithCodec(codec, "PreparedQuery.Apply", &other, &otherReply)
+	if !acl.IsErrPermissionDenied(err) {
+		t.Fatalf("bad: %v", err)
+	}
+}
+
 func TestPreparedQuery_Apply_ForwardLeader(t *testing.T) {
 	t.Parallel()
 	dir1, s1 := testServerWithConfig(t, func(c *Config) {
@@ -2787,6 +2840,7 @@ type mockQueryServer struct {
 	QueryFn          func(dc string, args interface{}, reply interface{}) error
 	Logger           hclog.Logger
 	LogBuffer        *bytes.Buffer
+	FailoverEvents   []structs.QueryFailoverEvent
 }
 
 func (m *mockQueryServer) JoinQueryLog() string {
@@ -2821,6 +2875,10 @@ func (m *mockQueryServer) ForwardDC(method, dc string, args interface{}, reply i
 	return nil
 }
 
+func (m *mockQueryServer) RecordFailoverEvent(queryID string, event structs.QueryFailoverEvent) {
+	m.FailoverEvents = append(m.FailoverEvents, event)
+}
+
 func TestPreparedQuery_queryFailover(t *testing.T) {
 	t.Parallel()
 	query := &structs.PreparedQuery{
@@ -2949,6 +3007,18 @@ func TestPreparedQuery_queryFailover(t *testing.T) {
 		if queries := mock.JoinQueryLog(); queries != "dc1:PreparedQuery.ExecuteRemote|dc2:PreparedQuery.ExecuteRemote|dc3:PreparedQuery.ExecuteRemote" {
 			t.Fatalf("bad: %s", queries)
 		}
+		if len(mock.FailoverEvents) != 3 {
+			t.Fatalf("bad: %v", mock.FailoverEvents)
+		}
+		for i, dc := range []string{"dc1", "dc2", "dc3"} {
+			event := mock.FailoverEvents[i]
+			if event.Datacenter != dc || !event.Success {
+				t.Fatalf("bad: %v", event)
+			}
+		}
+		if got := mock.FailoverEvents[2].ResultCount; got != 3 {
+			t.Fatalf("bad: %d", got)
+		}
 	}
 
 	// Try the first four nearest datacenters, nobody has the data.
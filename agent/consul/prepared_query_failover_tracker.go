@@ -0,0 +1,57 @@
+package consul
+
+import (
+	"sync"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// maxQueryFailoverEvents caps how many failover decisions we remember per
+// prepared query, so the history stays a useful recent trace instead of an
+// unbounded leak for queries that failover constantly.
+const maxQueryFailoverEvents = 10
+
+// queryFailoverTracker records the most recent cross-datacenter failover
+// attempts for each prepared query executed on this server, purely in
+// memory. It exists so operators can see which DCs a query tried, and how
+// long each hop took, without having to correlate logs across datacenters
+// by hand. Since it's not replicated via Raft, it only reflects failovers
+// that happened to run on this particular server.
+type queryFailoverTracker struct {
+	mu     sync.Mutex
+	events map[string][]structs.QueryFailoverEvent
+}
+
+func newQueryFailoverTracker() *queryFailoverTracker {
+	return &queryFailoverTracker{
+		events: make(map[string][]structs.QueryFailoverEvent),
+	}
+}
+
+// record appends an event to queryID's history, trimming the oldest entry
+// if that would exceed maxQueryFailoverEvents.
+func (t *queryFailoverTracker) record(queryID string, event structs.QueryFailoverEvent) {
+	if queryID == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	events := append(t.events[queryID], event)
+	if len(events) > maxQueryFailoverEvents {
+		events = events[len(events)-maxQueryFailoverEvents:]
+	}
+	t.events[queryID] = events
+}
+
+// history returns a copy of the recorded events for queryID, oldest first.
+func (t *queryFailoverTracker) history(queryID string) []structs.QueryFailoverEvent {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	events := t.events[queryID]
+	out := make([]structs.QueryFailoverEvent, len(events))
+	copy(out, events)
+	return out
+}
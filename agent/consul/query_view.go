@@ -0,0 +1,302 @@
+package consul
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	bexpr "github.com/hashicorp/go-bexpr"
+
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/consul/stream"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/lib"
+	"github.com/hashicorp/consul/proto/pbsubscribe"
+)
+
+// queryViewIdleTTL is how long a registered view is kept alive without being
+// fetched before it is torn down and its handle is forgotten.
+const queryViewIdleTTL = 10 * time.Minute
+
+// queryViewRegistry tracks the server-side materialized views created by
+// QueryView.Register, keyed by the handle returned to the client. Each view
+// maintains its own bexpr-filtered copy of a service's health incrementally,
+// from the catalog event stream, so that many callers watching the same
+// filter don't each have to re-evaluate it against the full result set.
+type queryViewRegistry struct {
+	srv *Server
+
+	lock  sync.Mutex
+	views map[string]*queryView
+}
+
+func newQueryViewRegistry(srv *Server) *queryViewRegistry {
+	r := &queryViewRegistry{srv: srv, views: make(map[string]*queryView)}
+	go r.reap(&lib.StopChannelContext{StopCh: srv.shutdownCh})
+	return r
+}
+
+// reap periodically removes views that haven't been fetched in
+// queryViewIdleTTL, until ctx is cancelled.
+func (r *queryViewRegistry) reap(ctx context.Context) {
+	ticker := time.NewTicker(queryViewIdleTTL / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.lock.Lock()
+			for handle, qv := range r.views {
+				if time.Since(qv.lastAccess()) > queryViewIdleTTL {
+					qv.close()
+					delete(r.views, handle)
+				}
+			}
+			r.lock.Unlock()
+		}
+	}
+}
+
+// register creates a new view for req, starts maintaining it in the
+// background, and returns the handle clients must use to fetch it.
+func (r *queryViewRegistry) register(req *structs.QueryViewRegisterRequest) (string, error) {
+	filter, err := newQueryViewFilter(req.Filter)
+	if err != nil {
+		return "", err
+	}
+
+	handle, err := lib.GenerateUUID(nil)
+	if err != nil {
+		return "", err
+	}
+
+	topic := pbsubscribe.Topic_ServiceHealth
+	if req.Connect {
+		topic = pbsubscribe.Topic_ServiceHealthConnect
+	}
+
+	qv := newQueryView(filter, req.Fields)
+	ctx, cancel := context.WithCancel(&lib.StopChannelContext{StopCh: r.srv.shutdownCh})
+	qv.cancel = cancel
+
+	sub, err := r.srv.fsm.State().EventPublisher().Subscribe(&stream.SubscribeRequest{
+		Topic: topic,
+		Key:   req.ServiceName,
+	})
+	if err != nil {
+		cancel()
+		return "", err
+	}
+
+	go qv.run(ctx, sub)
+
+	r.lock.Lock()
+	r.views[handle] = qv
+	r.lock.Unlock()
+
+	return handle, nil
+}
+
+// fetch blocks until the view identified by handle has an index greater
+// than minIndex, or until timeout elapses, then returns the view's current
+// filtered results and the fields it was registered to project.
+func (r *queryViewRegistry) fetch(ctx context.Context, handle string, minIndex uint64, timeout time.Duration) (uint64, []structs.CheckServiceNode, []string, error) {
+	r.lock.Lock()
+	qv, ok := r.views[handle]
+	r.lock.Unlock()
+	if !ok {
+		return 0, nil, nil, fmt.Errorf("no query view registered for handle %q", handle)
+	}
+
+	index, nodes, err := qv.fetch(ctx, minIndex, timeout)
+	return index, nodes, qv.fields, err
+}
+
+// queryView is a single registered, incrementally-maintained, filtered view
+// of a service's health.
+type queryView struct {
+	filter queryViewFilter
+	fields []string
+	cancel func()
+
+	lock     sync.Mutex
+	index    uint64
+	nodes    map[string]structs.CheckServiceNode
+	updateCh chan struct{}
+	accessed time.Time
+	err      error
+}
+
+func newQueryView(filter queryViewFilter, fields []string) *queryView {
+	return &queryView{
+		filter:   filter,
+		fields:   fields,
+		nodes:    make(map[string]structs.CheckServiceNode),
+		updateCh: make(chan struct{}),
+		accessed: time.Now(),
+	}
+}
+
+func (qv *queryView) close() {
+	qv.cancel()
+}
+
+func (qv *queryView) lastAccess() time.Time {
+	qv.lock.Lock()
+	defer qv.lock.Unlock()
+	return qv.accessed
+}
+
+// run consumes events from sub until ctx is cancelled, maintaining qv.nodes.
+func (qv *queryView) run(ctx context.Context, sub *stream.Subscription) {
+	defer sub.Unsubscribe()
+	for {
+		event, err := sub.Next(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				qv.lock.Lock()
+				qv.notifyLocked(err)
+				qv.lock.Unlock()
+			}
+			return
+		}
+
+		switch {
+		case event.IsNewSnapshotToFollow():
+			qv.lock.Lock()
+			qv.nodes = make(map[string]structs.CheckServiceNode)
+			qv.lock.Unlock()
+		case event.IsEndOfSnapshot():
+			qv.lock.Lock()
+			qv.index = event.Index
+			qv.notifyLocked(nil)
+			qv.lock.Unlock()
+		default:
+			if err := qv.apply(event); err != nil {
+				qv.lock.Lock()
+				qv.notifyLocked(err)
+				qv.lock.Unlock()
+				return
+			}
+		}
+	}
+}
+
+func (qv *queryView) apply(event stream.Event) error {
+	if batch, ok := event.Payload.([]stream.Event); ok {
+		for _, e := range batch {
+			if err := qv.applyOne(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return qv.applyOne(event)
+}
+
+func (qv *queryView) applyOne(event stream.Event) error {
+	payload, ok := event.Payload.(state.EventPayloadCheckServiceNode)
+	if !ok {
+		return nil
+	}
+
+	id := structs.UniqueID(payload.Value.Node.Node, payload.Value.Service.ID)
+
+	qv.lock.Lock()
+	defer qv.lock.Unlock()
+
+	switch payload.Op {
+	case pbsubscribe.CatalogOp_Deregister:
+		delete(qv.nodes, id)
+	default:
+		passed, err := qv.filter.Evaluate(*payload.Value)
+		if err != nil {
+			return err
+		}
+		if passed {
+			qv.nodes[id] = *payload.Value
+		} else {
+			delete(qv.nodes, id)
+		}
+	}
+
+	qv.index = event.Index
+	qv.notifyLocked(nil)
+	return nil
+}
+
+func (qv *queryView) notifyLocked(err error) {
+	qv.err = err
+	close(qv.updateCh)
+	qv.updateCh = make(chan struct{})
+}
+
+func (qv *queryView) fetch(ctx context.Context, minIndex uint64, timeout time.Duration) (uint64, []structs.CheckServiceNode, error) {
+	qv.lock.Lock()
+	qv.accessed = time.Now()
+	index := qv.index
+	updateCh := qv.updateCh
+	qv.lock.Unlock()
+
+	if index > 0 && index > minIndex {
+		return qv.snapshot()
+	}
+
+	timeoutCh := time.After(timeout)
+	for {
+		select {
+		case <-updateCh:
+			qv.lock.Lock()
+			index = qv.index
+			updateCh = qv.updateCh
+			err := qv.err
+			qv.lock.Unlock()
+			if err != nil {
+				return 0, nil, err
+			}
+			if index <= minIndex {
+				continue
+			}
+			return qv.snapshot()
+		case <-timeoutCh:
+			return qv.snapshot()
+		case <-ctx.Done():
+			return 0, nil, ctx.Err()
+		}
+	}
+}
+
+func (qv *queryView) snapshot() (uint64, []structs.CheckServiceNode, error) {
+	qv.lock.Lock()
+	defer qv.lock.Unlock()
+
+	nodes := make([]structs.CheckServiceNode, 0, len(qv.nodes))
+	for _, n := range qv.nodes {
+		nodes = append(nodes, n)
+	}
+	return qv.index, nodes, nil
+}
+
+// queryViewFilter evaluates a bexpr expression against a
+// structs.CheckServiceNode.
+type queryViewFilter interface {
+	Evaluate(datum interface{}) (bool, error)
+}
+
+func newQueryViewFilter(expr string) (queryViewFilter, error) {
+	if expr == "" {
+		return noopQueryViewFilter{}, nil
+	}
+	return bexpr.CreateEvaluatorForType(expr, nil, reflect.TypeOf(structs.CheckServiceNode{}))
+}
+
+// noopQueryViewFilter is used when no filter expression was given, so every
+// instance passes.
+type noopQueryViewFilter struct{}
+
+func (noopQueryViewFilter) Evaluate(_ interface{}) (bool, error) {
+	return true, nil
+}
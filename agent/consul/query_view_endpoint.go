@@ -0,0 +1,118 @@
+package consul
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/lib"
+)
+
+// QueryView endpoint is used to register and fetch server-side materialized
+// views of service health, filtered by a bexpr expression, so that many
+// identical consumers don't each pay the cost of re-evaluating the same
+// filter against the full catalog.
+type QueryView struct {
+	srv    *Server
+	logger hclog.Logger
+}
+
+// Register creates a new materialized view for the requested service and
+// filter, and returns a handle that can be used to fetch its results with
+// QueryView.Fetch.
+func (q *QueryView) Register(args *structs.QueryViewRegisterRequest, reply *structs.QueryViewRegisterResponse) error {
+	if done, err := q.srv.ForwardRPC("QueryView.Register", args, args, reply); done {
+		return err
+	}
+
+	rule, err := q.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if rule != nil && rule.ServiceRead(args.ServiceName, nil) != acl.Allow {
+		return acl.ErrPermissionDenied
+	}
+
+	handle, err := q.srv.queryViews.register(args)
+	if err != nil {
+		return err
+	}
+
+	reply.Handle = handle
+	return nil
+}
+
+// Fetch returns the current results of a view previously created with
+// QueryView.Register, blocking until there is a newer result than the one
+// the caller already has, or until the query timeout elapses.
+func (q *QueryView) Fetch(args *structs.QueryViewFetchRequest, reply *structs.QueryViewFetchResponse) error {
+	if done, err := q.srv.ForwardRPC("QueryView.Fetch", args, args, reply); done {
+		return err
+	}
+
+	_, rule, err := q.srv.ResolveTokenToIdentityAndAuthorizer(args.Token)
+	if err != nil {
+		return err
+	}
+
+	timeout := args.QueryOptions.MaxQueryTime
+	if timeout <= 0 {
+		timeout = q.srv.config.DefaultQueryTime
+	}
+	if timeout > q.srv.config.MaxQueryTime {
+		timeout = q.srv.config.MaxQueryTime
+	}
+
+	ctx := &lib.StopChannelContext{StopCh: q.srv.shutdownCh}
+	index, nodes, fields, err := q.srv.queryViews.fetch(ctx, args.Handle, args.MinQueryIndex, timeout)
+	if err != nil {
+		return err
+	}
+
+	filtered := make(structs.CheckServiceNodes, len(nodes))
+	copy(filtered, nodes)
+	if err := q.srv.filterACLWithAuthorizer(rule, &filtered); err != nil {
+		return err
+	}
+
+	results := make([]map[string]interface{}, 0, len(filtered))
+	for _, node := range filtered {
+		projected, err := projectQueryViewFields(node, fields)
+		if err != nil {
+			return err
+		}
+		results = append(results, projected)
+	}
+
+	reply.Index = index
+	reply.Results = results
+	return nil
+}
+
+// projectQueryViewFields renders node as a map, restricted to fields if
+// non-empty.
+func projectQueryViewFields(node structs.CheckServiceNode, fields []string) (map[string]interface{}, error) {
+	b, err := json.Marshal(node)
+	if err != nil {
+		return nil, err
+	}
+
+	var full map[string]interface{}
+	if err := json.Unmarshal(b, &full); err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return full, nil
+	}
+
+	out := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		if v, ok := full[f]; ok {
+			out[f] = v
+		}
+	}
+	return out, nil
+}
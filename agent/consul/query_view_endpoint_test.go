@@ -0,0 +1,87 @@
+package consul
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryView_RegisterAndFetch(t *testing.T) {
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	registerArg := structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       "foo",
+		Address:    "127.0.0.1",
+		Service: &structs.NodeService{
+			ID:      "db",
+			Service: "db",
+			Tags:    []string{"primary"},
+		},
+		Check: &structs.HealthCheck{
+			Name:      "db connect",
+			Status:    api.HealthPassing,
+			ServiceID: "db",
+		},
+	}
+	var out struct{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Catalog.Register", &registerArg, &out))
+
+	regArg := structs.QueryViewRegisterRequest{
+		Datacenter:  "dc1",
+		ServiceName: "db",
+		Filter:      `"primary" in Service.Tags`,
+		Fields:      []string{"Node", "Service"},
+	}
+	var regReply structs.QueryViewRegisterResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "QueryView.Register", &regArg, &regReply))
+	require.NotEmpty(t, regReply.Handle)
+
+	fetchArg := structs.QueryViewFetchRequest{
+		Datacenter: "dc1",
+		Handle:     regReply.Handle,
+		QueryOptions: structs.QueryOptions{
+			MaxQueryTime: 3 * time.Second,
+		},
+	}
+	var fetchReply structs.QueryViewFetchResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "QueryView.Fetch", &fetchArg, &fetchReply))
+	require.Len(t, fetchReply.Results, 1)
+	require.Contains(t, fetchReply.Results[0], "Node")
+	require.NotContains(t, fetchReply.Results[0], "Checks")
+}
+
+func TestQueryView_FetchUnknownHandle(t *testing.T) {
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	fetchArg := structs.QueryViewFetchRequest{
+		Datacenter: "dc1",
+		Handle:     "nope",
+		QueryOptions: structs.QueryOptions{
+			MaxQueryTime: time.Second,
+		},
+	}
+	var fetchReply structs.QueryViewFetchResponse
+	err := msgpackrpc.CallWithCodec(codec, "QueryView.Fetch", &fetchArg, &fetchReply)
+	require.Error(t, err)
+}
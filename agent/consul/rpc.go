@@ -696,12 +696,28 @@ func (s *Server) raftApplyProtobuf(t structs.MessageType, msg interface{}) (inte
 // and return the FSM response along with any errors. Unlike raftApply this
 // takes the encoder to use as an argument.
 func (s *Server) raftApplyWithEncoder(t structs.MessageType, msg interface{}, encoder raftEncoder) (interface{}, error) {
+	resp, _, err := s.raftApplyWithEncoderAndIndex(t, msg, encoder)
+	return resp, err
+}
+
+// raftApplyMsgpackWithIndex behaves exactly like raftApplyMsgpack, but also
+// returns the Raft log index the entry was committed at. Callers can hand
+// that index back to a client (e.g. as a blocking query's MinQueryIndex on a
+// later read) to get a read that is guaranteed to reflect this write without
+// requiring a fully consistent read.
+func (s *Server) raftApplyMsgpackWithIndex(t structs.MessageType, msg interface{}) (interface{}, uint64, error) {
+	return s.raftApplyWithEncoderAndIndex(t, msg, structs.Encode)
+}
+
+// raftApplyWithEncoderAndIndex is raftApplyWithEncoder, but it also returns
+// the Raft log index the entry was committed at.
+func (s *Server) raftApplyWithEncoderAndIndex(t structs.MessageType, msg interface{}, encoder raftEncoder) (interface{}, uint64, error) {
 	if encoder == nil {
-		return nil, fmt.Errorf("Failed to encode request: nil encoder")
+		return nil, 0, fmt.Errorf("Failed to encode request: nil encoder")
 	}
 	buf, err := encoder(t, msg)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to encode request: %v", err)
+		return nil, 0, fmt.Errorf("Failed to encode request: %v", err)
 	}
 
 	// Warn if the command is very large
@@ -720,10 +736,11 @@ func (s *Server) raftApplyWithEncoder(t structs.MessageType, msg interface{}, en
 	}
 
 	if err := future.Error(); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	resp := future.Response()
+	index := future.Index()
 
 	if chunked {
 		// In this case we didn't apply all chunks successfully, possibly due
@@ -734,18 +751,18 @@ func (s *Server) raftApplyWithEncoder(t structs.MessageType, msg interface{}, en
 			// apply function. Downstream client code expects to see any error
 			// from the FSM (as opposed to the apply itself) and decide whether
 			// it can retry in the future's response.
-			return ErrChunkingResubmit, nil
+			return ErrChunkingResubmit, 0, nil
 		}
 		// We expect that this conversion should always work
 		chunkedSuccess, ok := resp.(raftchunking.ChunkingSuccess)
 		if !ok {
-			return nil, errors.New("unknown type of response back from chunking FSM")
+			return nil, 0, errors.New("unknown type of response back from chunking FSM")
 		}
 		// Return the inner wrapped response
-		return chunkedSuccess.Response, nil
+		return chunkedSuccess.Response, index, nil
 	}
 
-	return resp, nil
+	return resp, index, nil
 }
 
 // queryFn is used to perform a query operation. If a re-query is needed, the
@@ -762,6 +779,7 @@ func (s *Server) blockingQuery(queryOpts structs.QueryOptionsCompat, queryMeta s
 
 	var queriesBlocking uint64
 	var queryTimeout time.Duration
+	var limiterKey string
 
 	// Instrument all queries run
 	metrics.IncrCounter([]string{"rpc", "query"}, 1)
@@ -772,6 +790,16 @@ func (s *Server) blockingQuery(queryOpts structs.QueryOptionsCompat, queryMeta s
 		goto RUN_QUERY
 	}
 
+	// Cap how many blocking queries this token (or the anonymous token, if
+	// none was given) may hold open at once, so a client leaking watches
+	// can't exhaust server resources.
+	limiterKey = queryOpts.GetToken()
+	if err := s.blockingQueryLimiter.acquire(limiterKey); err != nil {
+		metrics.IncrCounter([]string{"rpc", "query", "blocked_queries_rejected"}, 1)
+		return err
+	}
+	defer s.blockingQueryLimiter.release(limiterKey)
+
 	queryTimeout = queryOpts.GetMaxQueryTime()
 	// Restrict the max query time, and ensure there is always one.
 	if queryTimeout > s.config.MaxQueryTime {
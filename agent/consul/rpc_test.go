@@ -293,6 +293,38 @@ func TestRPC_blockingQuery(t *testing.T) {
 	}
 }
 
+func TestRPC_blockingQuery_MaxBlockingQueriesPerClient(t *testing.T) {
+	t.Parallel()
+	dir, s := testServerWithConfig(t, func(c *Config) {
+		c.RPCMaxBlockingQueriesPerClient = 1
+	})
+	defer os.RemoveAll(dir)
+	defer s.Shutdown()
+
+	require.NoError(t, s.blockingQueryLimiter.acquire("a-token"))
+
+	opts := structs.QueryOptions{Token: "a-token", MinQueryIndex: 3}
+	var meta structs.QueryMeta
+	fn := func(ws memdb.WatchSet, state *state.Store) error {
+		t.Fatal("fn should not be called once the client's limit is reached")
+		return nil
+	}
+	err := s.blockingQuery(&opts, &meta, fn)
+	require.Equal(t, ErrTooManyBlockingQueries, err)
+
+	// A different token isn't affected by the first token's limit.
+	s.blockingQueryLimiter.release("a-token")
+	otherOpts := structs.QueryOptions{Token: "other-token"}
+	var otherMeta structs.QueryMeta
+	var otherCalls int
+	otherFn := func(ws memdb.WatchSet, state *state.Store) error {
+		otherCalls++
+		return nil
+	}
+	require.NoError(t, s.blockingQuery(&otherOpts, &otherMeta, otherFn))
+	require.Equal(t, 1, otherCalls)
+}
+
 func TestRPC_ReadyForConsistentReads(t *testing.T) {
 	t.Parallel()
 	dir, s := testServerWithConfig(t, func(c *Config) {
@@ -810,7 +842,7 @@ func TestRPC_LocalTokenStrippedOnForward(t *testing.T) {
 		},
 		WriteRequest: structs.WriteRequest{Token: localToken2.SecretID},
 	}
-	var out bool
+	var out structs.KVSApplyResponse
 	err = msgpackrpc.CallWithCodec(codec2, "KVS.Apply", &arg, &out)
 	require.NoError(t, err)
 	require.Equal(t, localToken2.SecretID, arg.WriteRequest.Token, "token should not be stripped")
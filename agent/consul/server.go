@@ -107,6 +107,7 @@ const (
 	intentionMigrationRoutineName         = "intention config entry migration"
 	secondaryCARootWatchRoutineName       = "secondary CA roots watch"
 	intermediateCertRenewWatchRoutineName = "intermediate cert renew watch"
+	syncPacingRoutineName                 = "anti-entropy sync pacing"
 )
 
 var (
@@ -122,6 +123,15 @@ type Server struct {
 	// correctly 64-byte aligned in the struct layout
 	queriesBlocking uint64
 
+	// blockingQueryLimiter caps how many blocking queries a single ACL
+	// token may hold open against this server at once, per
+	// RPCMaxBlockingQueriesPerClient.
+	blockingQueryLimiter *blockingQueryLimiter
+
+	// queryFailoverEvents tracks the most recent cross-datacenter failover
+	// decisions made for each prepared query executed on this server.
+	queryFailoverEvents *queryFailoverTracker
+
 	// aclConfig is the configuration for the ACL system
 	aclConfig *acl.Config
 
@@ -311,9 +321,38 @@ type Server struct {
 	// service-intentions before this will get enabled.
 	dcSupportsIntentionsAsConfigEntries int32
 
+	// featureUpgrades tracks the rollout status of features that are gated
+	// on cluster-wide server support, for reporting via the
+	// Operator.FeatureRollout RPC endpoint.
+	featureUpgradesLock sync.Mutex
+	featureUpgrades     []registeredFeatureUpgrade
+
+	// queryViews tracks the server-side materialized views registered via
+	// the QueryView.Register RPC.
+	queryViews *queryViewRegistry
+
+	// lastLeadershipTransition records the timeline of the most recent
+	// establishLeadership call on this server, for reporting via the
+	// Operator.Leadership RPC endpoint to help diagnose slow failovers.
+	lastLeadershipTransitionLock sync.Mutex
+	lastLeadershipTransition     *structs.LeadershipTransition
+
+	// lastStateDigest records this server's most recent set of FSM table
+	// hashes, for reporting via the Operator.StateDigest RPC endpoint so
+	// operators can compare digests across servers and catch silent
+	// divergence between the leader and its followers.
+	lastStateDigestLock sync.Mutex
+	lastStateDigest     *structs.StateDigest
+
 	// Manager to handle starting/stopping go routines when establishing/revoking raft leadership
 	leaderRoutineManager *LeaderRoutineManager
 
+	// syncPacing tracks how many LAN members have recently reconnected,
+	// so the leader can advertise a pacing hint for anti-entropy full
+	// syncs after a mass reconnect. Only meaningful while this server is
+	// the leader.
+	syncPacing *syncPacingTracker
+
 	// embedded struct to hold all the enterprise specific data
 	EnterpriseServer
 }
@@ -391,7 +430,11 @@ func NewServer(config *Config, flat Deps) (*Server, error) {
 		shutdownCh:              shutdownCh,
 		leaderRoutineManager:    NewLeaderRoutineManager(logger),
 		aclAuthMethodValidators: authmethod.NewCache(),
+		syncPacing:              newSyncPacingTracker(),
+		blockingQueryLimiter:    newBlockingQueryLimiter(config.RPCMaxBlockingQueriesPerClient),
+		queryFailoverEvents:     newQueryFailoverTracker(),
 	}
+	s.queryViews = newQueryViewRegistry(s)
 
 	if s.config.ConnectMeshGatewayWANFederationEnabled {
 		s.gatewayLocator = NewGatewayLocator(
@@ -613,6 +656,21 @@ func NewServer(config *Config, flat Deps) (*Server, error) {
 	// Start the metrics handlers.
 	go s.updateMetrics()
 
+	// Start periodically hashing FSM tables to help detect silent
+	// divergence between this server and the rest of the cluster.
+	go s.runStateDigestVerifier()
+
+	// Register the pre-existing ad hoc feature upgrades so their rollout
+	// status is reported alongside any features built on the newer
+	// featureUpgrade primitive. These predate the framework and have
+	// semantics (leader-aware, multi-valued readiness) that don't fit
+	// checkFeatureUpgrade/serversSupportFeature, so they keep their own
+	// gating logic and are registered only for rollout-status visibility;
+	// see the comment on canUpgradeToNewACLs for why.
+	s.registerFeatureUpgrade("fs", s.DatacenterSupportsFederationStates)
+	s.registerFeatureUpgrade("si", s.DatacenterSupportsIntentionsAsConfigEntries)
+	s.registerFeatureUpgrade("new-acls", func() bool { return atomic.LoadInt32(&s.useNewACLs) != 0 })
+
 	return s, nil
 }
 
@@ -1165,6 +1223,20 @@ func (s *Server) LANMembers() []serf.Member {
 	return s.serfLAN.Members()
 }
 
+// LANMembersHealthScore returns this server's own memberlist health score,
+// a value from 0 (healthy) upward indicating how well it's meeting the soft
+// real-time requirements of the protocol. It cannot be used to score any
+// other member, since nodes do not gossip their own health score.
+func (s *Server) LANMembersHealthScore() int {
+	return s.serfLAN.Memberlist().GetHealthScore()
+}
+
+// SyncPacingHint returns the largest anti-entropy pacing hint advertised by
+// any server this server currently knows about.
+func (s *Server) SyncPacingHint() time.Duration {
+	return s.router.GetLANManager().MaxSyncPacing()
+}
+
 // WANMembers is used to return the members of the LAN cluster
 func (s *Server) WANMembers() []serf.Member {
 	if s.serfWAN == nil {
@@ -1415,6 +1487,7 @@ func (s *Server) ReloadConfig(config *Config) error {
 	s.rpcConnLimiter.SetConfig(connlimit.Config{
 		MaxConnsPerClientIP: config.RPCMaxConnsPerClient,
 	})
+	s.blockingQueryLimiter.SetMaxPerClient(config.RPCMaxBlockingQueriesPerClient)
 
 	if s.IsLeader() {
 		// only bootstrap the config entries if we are the leader
@@ -121,6 +121,8 @@ func (s *Server) getCARoots(ws memdb.WatchSet, state *state.Store) (*structs.Ind
 			Active:              r.Active,
 			PrivateKeyType:      r.PrivateKeyType,
 			PrivateKeyBits:      r.PrivateKeyBits,
+			RevokedSerials:      r.RevokedSerials,
+			CRLPEM:              r.CRLPEM,
 		}
 
 		if r.Active {
@@ -126,7 +126,8 @@ func (s *Server) setupSerf(conf *serf.Config, ch chan serf.Event, path string, w
 			return nil, err
 		}
 
-		if s.config.ConnectMeshGatewayWANFederationEnabled {
+		switch {
+		case s.config.ConnectMeshGatewayWANFederationEnabled:
 			mgwTransport, err := wanfed.NewTransport(
 				s.tlsConfigurator,
 				nt,
@@ -138,7 +139,18 @@ func (s *Server) setupSerf(conf *serf.Config, ch chan serf.Event, path string, w
 			}
 
 			conf.MemberlistConfig.Transport = mgwTransport
-		} else {
+		case s.config.SerfWANGossipTLSEnabled:
+			tlsTransport, err := wanfed.NewDirectTransport(
+				s.tlsConfigurator,
+				nt,
+				s.config.Datacenter,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			conf.MemberlistConfig.Transport = tlsTransport
+		default:
 			conf.MemberlistConfig.Transport = nt
 		}
 	}
@@ -204,6 +216,7 @@ func (s *Server) lanEventHandler() {
 			case serf.EventMemberJoin:
 				s.lanNodeJoin(e.(serf.MemberEvent))
 				s.localMemberEvent(e.(serf.MemberEvent))
+				s.syncPacing.recordJoins(len(e.(serf.MemberEvent).Members))
 
 			case serf.EventMemberLeave, serf.EventMemberFailed, serf.EventMemberReap:
 				s.lanNodeFailed(e.(serf.MemberEvent))
@@ -723,7 +723,7 @@ func TestServer_JoinWAN_viaMeshGateway(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.NoError(t, s1.RPC("Catalog.Register", &arg, &out))
 	}
 
@@ -778,7 +778,7 @@ func TestServer_JoinWAN_viaMeshGateway(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.NoError(t, s2.RPC("Catalog.Register", &arg, &out))
 	}
 	{
@@ -84,6 +84,20 @@ func (s *Session) Apply(args *structs.SessionRequest, reply *string) error {
 		}
 	}
 
+	// Cap how many sessions may be held open against a single node at
+	// once, so a leaky client can't bloat the state store and lock
+	// tables with sessions it never destroys.
+	if args.Op == structs.SessionCreate && s.srv.config.SessionsPerNodeLimit > 0 {
+		state := s.srv.fsm.State()
+		_, existing, err := state.NodeSessions(nil, args.Session.Node, &args.Session.EnterpriseMeta)
+		if err != nil {
+			return fmt.Errorf("Session lookup failed: %v", err)
+		}
+		if len(existing) >= s.srv.config.SessionsPerNodeLimit {
+			return fmt.Errorf("Node %q has reached its limit of %d concurrent sessions", args.Session.Node, s.srv.config.SessionsPerNodeLimit)
+		}
+	}
+
 	// Ensure that the specified behavior is allowed
 	switch args.Session.Behavior {
 	case "":
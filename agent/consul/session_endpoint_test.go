@@ -951,3 +951,59 @@ func TestSession_Apply_BadTTL(t *testing.T) {
 		t.Fatalf("incorrect error message: %s", err.Error())
 	}
 }
+
+func TestSession_Apply_NodeLimit(t *testing.T) {
+	t.Parallel()
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.SessionsPerNodeLimit = 1
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	s1.fsm.State().EnsureNode(1, &structs.Node{Node: "foo", Address: "127.0.0.1"})
+
+	arg := structs.SessionRequest{
+		Datacenter: "dc1",
+		Op:         structs.SessionCreate,
+		Session: structs.Session{
+			Node: "foo",
+		},
+	}
+
+	// The first session should succeed.
+	var out string
+	if err := msgpackrpc.CallWithCodec(codec, "Session.Apply", &arg, &out); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	// A second session against the same node should be rejected since it
+	// would exceed the per-node limit of 1.
+	var out2 string
+	err := msgpackrpc.CallWithCodec(codec, "Session.Apply", &arg, &out2)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if err.Error() != `Node "foo" has reached its limit of 1 concurrent sessions` {
+		t.Fatalf("incorrect error message: %s", err.Error())
+	}
+
+	// Destroying the first session should free up room for another.
+	destroy := structs.SessionRequest{
+		Datacenter: "dc1",
+		Op:         structs.SessionDestroy,
+		Session:    structs.Session{ID: out},
+	}
+	var destroyOut string
+	if err := msgpackrpc.CallWithCodec(codec, "Session.Apply", &destroy, &destroyOut); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if err := msgpackrpc.CallWithCodec(codec, "Session.Apply", &arg, &out2); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+}
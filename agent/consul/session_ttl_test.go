@@ -336,7 +336,7 @@ func TestServer_SessionTTL_Failover(t *testing.T) {
 		Node:       "foo",
 		Address:    "127.0.0.1",
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := s1.RPC("Catalog.Register", &node, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
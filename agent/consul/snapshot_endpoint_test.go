@@ -33,7 +33,7 @@ func verifySnapshot(t *testing.T, s *Server, dc, token string) {
 				Token: token,
 			},
 		}
-		var out bool
+		var out structs.KVSApplyResponse
 		if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -88,7 +88,7 @@ func verifySnapshot(t *testing.T, s *Server, dc, token string) {
 				Token: token,
 			},
 		}
-		var out bool
+		var out structs.KVSApplyResponse
 		if err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
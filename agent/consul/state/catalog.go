@@ -2047,13 +2047,20 @@ func (s *Store) CombinedCheckServiceNodes(ws memdb.WatchSet, service structs.Ser
 
 // CheckServiceNodes is used to query all nodes and checks for a given service.
 func (s *Store) CheckServiceNodes(ws memdb.WatchSet, serviceName string, entMeta *structs.EnterpriseMeta) (uint64, structs.CheckServiceNodes, error) {
-	return s.checkServiceNodes(ws, serviceName, false, entMeta)
+	return s.checkServiceNodes(ws, serviceName, false, false, entMeta)
 }
 
 // CheckConnectServiceNodes is used to query all nodes and checks for Connect
 // compatible endpoints for a given service.
 func (s *Store) CheckConnectServiceNodes(ws memdb.WatchSet, serviceName string, entMeta *structs.EnterpriseMeta) (uint64, structs.CheckServiceNodes, error) {
-	return s.checkServiceNodes(ws, serviceName, true, entMeta)
+	return s.checkServiceNodes(ws, serviceName, true, false, entMeta)
+}
+
+// CheckServiceNodesWithNodeMeta is like CheckServiceNodes but additionally
+// merges each instance's node's metadata into the returned service
+// metadata, so callers don't need to separately join against the node.
+func (s *Store) CheckServiceNodesWithNodeMeta(ws memdb.WatchSet, serviceName string, connect bool, entMeta *structs.EnterpriseMeta) (uint64, structs.CheckServiceNodes, error) {
+	return s.checkServiceNodes(ws, serviceName, connect, true, entMeta)
 }
 
 // CheckIngressServiceNodes is used to query all nodes and checks for ingress
@@ -2084,7 +2091,7 @@ func (s *Store) CheckIngressServiceNodes(ws memdb.WatchSet, serviceName string,
 
 	var results structs.CheckServiceNodes
 	for sn := range names {
-		idx, n, err := checkServiceNodesTxn(tx, ws, sn.Name, false, &sn.EnterpriseMeta)
+		idx, n, err := checkServiceNodesTxn(tx, ws, sn.Name, false, false, &sn.EnterpriseMeta)
 		if err != nil {
 			return 0, nil, err
 		}
@@ -2094,14 +2101,14 @@ func (s *Store) CheckIngressServiceNodes(ws memdb.WatchSet, serviceName string,
 	return maxIdx, results, nil
 }
 
-func (s *Store) checkServiceNodes(ws memdb.WatchSet, serviceName string, connect bool, entMeta *structs.EnterpriseMeta) (uint64, structs.CheckServiceNodes, error) {
+func (s *Store) checkServiceNodes(ws memdb.WatchSet, serviceName string, connect, nodeMetaInherit bool, entMeta *structs.EnterpriseMeta) (uint64, structs.CheckServiceNodes, error) {
 	tx := s.db.Txn(false)
 	defer tx.Abort()
 
-	return checkServiceNodesTxn(tx, ws, serviceName, connect, entMeta)
+	return checkServiceNodesTxn(tx, ws, serviceName, connect, nodeMetaInherit, entMeta)
 }
 
-func checkServiceNodesTxn(tx *txn, ws memdb.WatchSet, serviceName string, connect bool, entMeta *structs.EnterpriseMeta) (uint64, structs.CheckServiceNodes, error) {
+func checkServiceNodesTxn(tx *txn, ws memdb.WatchSet, serviceName string, connect, nodeMetaInherit bool, entMeta *structs.EnterpriseMeta) (uint64, structs.CheckServiceNodes, error) {
 	// Function for lookup
 	index := "service"
 	if connect {
@@ -2223,7 +2230,7 @@ func checkServiceNodesTxn(tx *txn, ws memdb.WatchSet, serviceName string, connec
 		ws.Add(iter.WatchCh())
 	}
 
-	return parseCheckServiceNodes(tx, fallbackWS, idx, results, err)
+	return parseCheckServiceNodes(tx, fallbackWS, idx, results, nodeMetaInherit, err)
 }
 
 // CheckServiceTagNodes is used to query all nodes and checks for a given
@@ -2252,7 +2259,7 @@ func (s *Store) CheckServiceTagNodes(ws memdb.WatchSet, serviceName string, tags
 
 	// Get the table index.
 	idx := maxIndexForService(tx, serviceName, serviceExists, true, entMeta)
-	return parseCheckServiceNodes(tx, ws, idx, results, err)
+	return parseCheckServiceNodes(tx, ws, idx, results, false, err)
 }
 
 // GatewayServices is used to query all services associated with a gateway
@@ -2278,9 +2285,35 @@ func (s *Store) GatewayServices(ws memdb.WatchSet, gateway string, entMeta *stru
 // parseCheckServiceNodes is used to parse through a given set of services,
 // and query for an associated node and a set of checks. This is the inner
 // method used to return a rich set of results from a more simple query.
+// nodeMetaInheritPrefix is prepended to node metadata keys copied into a
+// service instance's metadata by mergeNodeMetaIntoServiceMeta, so they can't
+// collide with metadata set directly on the service.
+const nodeMetaInheritPrefix = "node-meta."
+
+// mergeNodeMetaIntoServiceMeta copies the node's metadata into the service's
+// metadata, prefixed with nodeMetaInheritPrefix, so that callers who only
+// have the service instance (e.g. for locality/zone info) don't need to
+// separately join against the node. svc must not be a direct reference into
+// the state store since it will be mutated.
+func mergeNodeMetaIntoServiceMeta(node *structs.Node, svc *structs.NodeService) {
+	if len(node.Meta) == 0 {
+		return
+	}
+
+	meta := make(map[string]string, len(svc.Meta)+len(node.Meta))
+	for k, v := range svc.Meta {
+		meta[k] = v
+	}
+	for k, v := range node.Meta {
+		meta[nodeMetaInheritPrefix+k] = v
+	}
+	svc.Meta = meta
+}
+
 func parseCheckServiceNodes(
 	tx *txn, ws memdb.WatchSet, idx uint64,
 	services structs.ServiceNodes,
+	nodeMetaInherit bool,
 	err error) (uint64, structs.CheckServiceNodes, error) {
 	if err != nil {
 		return 0, nil, err
@@ -2345,10 +2378,15 @@ func parseCheckServiceNodes(
 			checks = append(checks, check.(*structs.HealthCheck))
 		}
 
+		svc := sn.ToNodeService()
+		if nodeMetaInherit {
+			mergeNodeMetaIntoServiceMeta(node, svc)
+		}
+
 		// Append to the results.
 		results = append(results, structs.CheckServiceNode{
 			Node:    node,
-			Service: sn.ToNodeService(),
+			Service: svc,
 			Checks:  checks,
 		})
 	}
@@ -2419,7 +2457,7 @@ func serviceDumpAllTxn(tx *txn, ws memdb.WatchSet, entMeta *structs.EnterpriseMe
 		results = append(results, sn)
 	}
 
-	return parseCheckServiceNodes(tx, nil, idx, results, err)
+	return parseCheckServiceNodes(tx, nil, idx, results, false, err)
 }
 
 func serviceDumpKindTxn(tx *txn, ws memdb.WatchSet, kind structs.ServiceKind, entMeta *structs.EnterpriseMeta) (uint64, structs.CheckServiceNodes, error) {
@@ -2440,7 +2478,7 @@ func serviceDumpKindTxn(tx *txn, ws memdb.WatchSet, kind structs.ServiceKind, en
 		results = append(results, sn)
 	}
 
-	return parseCheckServiceNodes(tx, nil, idx, results, err)
+	return parseCheckServiceNodes(tx, nil, idx, results, false, err)
 }
 
 // parseNodes takes an iterator over a set of nodes and returns a struct
@@ -3067,7 +3105,7 @@ func (s *Store) combinedServiceNodesTxn(tx *txn, ws memdb.WatchSet, names []stru
 	)
 	for _, u := range names {
 		// Collect typical then connect instances
-		idx, csn, err := checkServiceNodesTxn(tx, ws, u.Name, false, &u.EnterpriseMeta)
+		idx, csn, err := checkServiceNodesTxn(tx, ws, u.Name, false, false, &u.EnterpriseMeta)
 		if err != nil {
 			return 0, nil, err
 		}
@@ -3076,7 +3114,7 @@ func (s *Store) combinedServiceNodesTxn(tx *txn, ws memdb.WatchSet, names []stru
 		}
 		resp = append(resp, csn...)
 
-		idx, csn, err = checkServiceNodesTxn(tx, ws, u.Name, true, &u.EnterpriseMeta)
+		idx, csn, err = checkServiceNodesTxn(tx, ws, u.Name, true, false, &u.EnterpriseMeta)
 		if err != nil {
 			return 0, nil, err
 		}
@@ -26,7 +26,7 @@ func serviceHealthSnapshot(s *Store, topic stream.Topic) stream.SnapshotFunc {
 
 		connect := topic == topicServiceHealthConnect
 		// TODO(namespace-streaming): plumb entMeta through from SubscribeRequest
-		idx, nodes, err := checkServiceNodesTxn(tx, nil, req.Key, connect, nil)
+		idx, nodes, err := checkServiceNodesTxn(tx, nil, req.Key, connect, false, nil)
 		if err != nil {
 			return 0, err
 		}
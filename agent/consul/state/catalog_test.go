@@ -3747,6 +3747,25 @@ func BenchmarkCheckServiceNodes(b *testing.B) {
 	}
 }
 
+func TestStateStore_CheckServiceNodesWithNodeMeta(t *testing.T) {
+	s := testStateStore(t)
+
+	testRegisterNodeWithMeta(t, s, 0, "node1", map[string]string{"az": "us-east-1a"})
+	testRegisterService(t, s, 1, "node1", "service1")
+
+	ws := memdb.NewWatchSet()
+	_, results, err := s.CheckServiceNodesWithNodeMeta(ws, "service1", false, nil)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, "us-east-1a", results[0].Service.Meta["node-meta.az"])
+
+	// The plain (non-merging) query must not be affected.
+	_, plain, err := s.CheckServiceNodes(ws, "service1", nil)
+	require.NoError(t, err)
+	require.Len(t, plain, 1)
+	require.NotContains(t, plain[0].Service.Meta, "node-meta.az")
+}
+
 func TestStateStore_CheckServiceTagNodes(t *testing.T) {
 	s := testStateStore(t)
 
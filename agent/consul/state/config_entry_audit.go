@@ -0,0 +1,117 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/go-memdb"
+)
+
+const (
+	configEntryAuditTableName = "config-entry-audit"
+
+	// configEntryAuditMaxEntries bounds the audit log so that it can't grow
+	// without limit; once it's exceeded the oldest entries are evicted.
+	configEntryAuditMaxEntries = 1024
+)
+
+func init() {
+	registerSchema(configEntryAuditTableSchema)
+}
+
+func configEntryAuditTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: configEntryAuditTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			"id": {
+				Name:         "id",
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.UintFieldIndex{
+					Field: "Index",
+				},
+			},
+		},
+	}
+}
+
+// RecordConfigEntryAudit appends an entry to the bounded config entry and
+// intention change audit log. It is called from the FSM after a config
+// entry write has already been committed, so a failure here does not roll
+// back the change it describes.
+func (s *Store) RecordConfigEntryAudit(idx uint64, kind, name, op, author string, timestamp time.Time) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	entry := &structs.ConfigEntryAuditEntry{
+		Index:     idx,
+		Kind:      kind,
+		Name:      name,
+		Op:        op,
+		Author:    author,
+		Timestamp: timestamp,
+	}
+	if err := tx.Insert(configEntryAuditTableName, entry); err != nil {
+		return fmt.Errorf("failed inserting config entry audit record: %s", err)
+	}
+	if err := tx.Insert("index", &IndexEntry{configEntryAuditTableName, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	if err := trimConfigEntryAuditTxn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// trimConfigEntryAuditTxn evicts the oldest audit entries once the log grows
+// past configEntryAuditMaxEntries.
+func trimConfigEntryAuditTxn(tx *txn) error {
+	iter, err := tx.Get(configEntryAuditTableName, "id")
+	if err != nil {
+		return fmt.Errorf("failed config entry audit log lookup: %s", err)
+	}
+
+	var all []*structs.ConfigEntryAuditEntry
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		all = append(all, raw.(*structs.ConfigEntryAuditEntry))
+	}
+	if len(all) <= configEntryAuditMaxEntries {
+		return nil
+	}
+	// The "id" index encodes Index as a uvarint, whose byte order is not
+	// numeric order, so sort before picking the oldest entries.
+	sort.Slice(all, func(i, j int) bool { return all[i].Index < all[j].Index })
+
+	for _, entry := range all[:len(all)-configEntryAuditMaxEntries] {
+		if err := tx.Delete(configEntryAuditTableName, entry); err != nil {
+			return fmt.Errorf("failed deleting old config entry audit record: %s", err)
+		}
+	}
+	return nil
+}
+
+// ConfigEntryAuditLog returns the recorded config entry and intention
+// changes, oldest first.
+func (s *Store) ConfigEntryAuditLog(ws memdb.WatchSet) (uint64, []*structs.ConfigEntryAuditEntry, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	idx := maxIndexWatchTxn(tx, ws, configEntryAuditTableName)
+
+	iter, err := tx.Get(configEntryAuditTableName, "id")
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed config entry audit log lookup: %s", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	var results []*structs.ConfigEntryAuditEntry
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		results = append(results, raw.(*structs.ConfigEntryAuditEntry))
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+	return idx, results, nil
+}
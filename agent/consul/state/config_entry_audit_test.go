@@ -0,0 +1,56 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/agent/structs"
+	memdb "github.com/hashicorp/go-memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_ConfigEntryAuditLog(t *testing.T) {
+	s := testConfigStateStore(t)
+
+	ws := memdb.NewWatchSet()
+	idx, entries, err := s.ConfigEntryAuditLog(ws)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), idx)
+	require.Empty(t, entries)
+
+	now := time.Now()
+	require.NoError(t, s.RecordConfigEntryAudit(1, structs.ServiceIntentions, "web", "upsert", "token-accessor-1", now))
+	require.True(t, watchFired(ws))
+
+	_, entries, err = s.ConfigEntryAuditLog(nil)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, &structs.ConfigEntryAuditEntry{
+		Index:     1,
+		Kind:      structs.ServiceIntentions,
+		Name:      "web",
+		Op:        "upsert",
+		Author:    "token-accessor-1",
+		Timestamp: now,
+	}, entries[0])
+
+	require.NoError(t, s.RecordConfigEntryAudit(2, structs.ServiceIntentions, "web", "delete", "token-accessor-1", now))
+	_, entries, err = s.ConfigEntryAuditLog(nil)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+func TestStore_ConfigEntryAuditLog_Trim(t *testing.T) {
+	s := testConfigStateStore(t)
+
+	for i := 0; i < configEntryAuditMaxEntries+10; i++ {
+		require.NoError(t, s.RecordConfigEntryAudit(uint64(i+1), structs.ServiceDefaults, "web", "upsert", "token", time.Now()))
+	}
+
+	_, entries, err := s.ConfigEntryAuditLog(nil)
+	require.NoError(t, err)
+	require.Len(t, entries, configEntryAuditMaxEntries)
+	// The oldest entries should have been evicted, so the log should start
+	// just after the first 10 writes.
+	require.Equal(t, uint64(11), entries[0].Index)
+}
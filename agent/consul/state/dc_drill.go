@@ -0,0 +1,131 @@
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/go-memdb"
+)
+
+const dcDrillTableName = "dc-drills"
+
+func init() {
+	registerSchema(dcDrillTableSchema)
+}
+
+func dcDrillTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: dcDrillTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			"id": {
+				Name:         "id",
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "TargetDatacenter",
+				},
+			},
+		},
+	}
+}
+
+// EnsureDatacenterDrill starts (or replaces) a failover drill against the
+// given remote datacenter, expiring at expiresAt.
+func (s *Store) EnsureDatacenterDrill(idx uint64, targetDC string, expiresAt time.Time) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	existing, err := tx.First(dcDrillTableName, "id", targetDC)
+	if err != nil {
+		return fmt.Errorf("failed dc drill lookup: %s", err)
+	}
+
+	drill := &structs.DatacenterDrill{
+		TargetDatacenter: targetDC,
+		ExpiresAt:        expiresAt,
+	}
+	if existing != nil {
+		drill.CreateIndex = existing.(*structs.DatacenterDrill).CreateIndex
+	} else {
+		drill.CreateIndex = idx
+	}
+	drill.ModifyIndex = idx
+
+	if err := tx.Insert(dcDrillTableName, drill); err != nil {
+		return fmt.Errorf("failed inserting dc drill: %s", err)
+	}
+	if err := tx.Insert("index", &IndexEntry{dcDrillTableName, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return tx.Commit()
+}
+
+// DeleteDatacenterDrill stops a failover drill against the given remote
+// datacenter, if one is active.
+func (s *Store) DeleteDatacenterDrill(idx uint64, targetDC string) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	existing, err := tx.First(dcDrillTableName, "id", targetDC)
+	if err != nil {
+		return fmt.Errorf("failed dc drill lookup: %s", err)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	if err := tx.Delete(dcDrillTableName, existing); err != nil {
+		return fmt.Errorf("failed deleting dc drill: %s", err)
+	}
+	if err := tx.Insert("index", &IndexEntry{dcDrillTableName, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return tx.Commit()
+}
+
+// DatacenterDrills returns the active failover drills, excluding any that
+// have already expired.
+func (s *Store) DatacenterDrills(ws memdb.WatchSet) (uint64, []*structs.DatacenterDrill, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	idx := maxIndexWatchTxn(tx, ws, dcDrillTableName)
+
+	iter, err := tx.Get(dcDrillTableName, "id")
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed dc drill lookup: %s", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	now := time.Now()
+	var results []*structs.DatacenterDrill
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		drill := raw.(*structs.DatacenterDrill)
+		if drill.ExpiresAt.Before(now) {
+			continue
+		}
+		results = append(results, drill)
+	}
+	return idx, results, nil
+}
+
+// IsDatacenterDrilled returns true if the given remote datacenter currently
+// has an active, unexpired failover drill against it.
+func (s *Store) IsDatacenterDrilled(targetDC string) (bool, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	raw, err := tx.First(dcDrillTableName, "id", targetDC)
+	if err != nil {
+		return false, fmt.Errorf("failed dc drill lookup: %s", err)
+	}
+	if raw == nil {
+		return false, nil
+	}
+
+	drill := raw.(*structs.DatacenterDrill)
+	return drill.ExpiresAt.After(time.Now()), nil
+}
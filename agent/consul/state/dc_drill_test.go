@@ -0,0 +1,52 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_DatacenterDrill(t *testing.T) {
+	s := testConfigStateStore(t)
+
+	drilled, err := s.IsDatacenterDrilled("dc2")
+	require.NoError(t, err)
+	require.False(t, drilled)
+
+	expires := time.Now().Add(time.Minute)
+	require.NoError(t, s.EnsureDatacenterDrill(1, "dc2", expires))
+
+	drilled, err = s.IsDatacenterDrilled("dc2")
+	require.NoError(t, err)
+	require.True(t, drilled)
+
+	_, drills, err := s.DatacenterDrills(nil)
+	require.NoError(t, err)
+	require.Len(t, drills, 1)
+	require.Equal(t, "dc2", drills[0].TargetDatacenter)
+
+	require.NoError(t, s.DeleteDatacenterDrill(2, "dc2"))
+
+	drilled, err = s.IsDatacenterDrilled("dc2")
+	require.NoError(t, err)
+	require.False(t, drilled)
+
+	_, drills, err = s.DatacenterDrills(nil)
+	require.NoError(t, err)
+	require.Empty(t, drills)
+}
+
+func TestStore_DatacenterDrill_Expired(t *testing.T) {
+	s := testConfigStateStore(t)
+
+	require.NoError(t, s.EnsureDatacenterDrill(1, "dc2", time.Now().Add(-time.Minute)))
+
+	drilled, err := s.IsDatacenterDrilled("dc2")
+	require.NoError(t, err)
+	require.False(t, drilled)
+
+	_, drills, err := s.DatacenterDrills(nil)
+	require.NoError(t, err)
+	require.Empty(t, drills)
+}
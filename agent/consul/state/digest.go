@@ -0,0 +1,50 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// DigestTables is the default set of memdb tables hashed by TableHashes.
+// These were chosen because they hold the bulk of a typical cluster's
+// catalog and KV state, making them the tables most likely to reveal silent
+// divergence between a leader and its followers.
+var DigestTables = []string{"nodes", "services", "checks", "kvs", "sessions"}
+
+// TableHashes computes a content hash for each of the given memdb tables, so
+// that callers can compare the results between servers to detect silent FSM
+// divergence (see Server.runStateDigestVerifier). Each row in a table is
+// visited in the table's "id" index order, which memdb's radix tree already
+// returns in a stable, deterministic order, so two stores with identical
+// contents always produce identical hashes regardless of insertion order.
+func (s *Store) TableHashes(tables []string) (map[string][]byte, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	out := make(map[string][]byte, len(tables))
+	for _, table := range tables {
+		hash, err := blake2b.New256(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := tx.Get(table, "id")
+		if err != nil {
+			return nil, fmt.Errorf("failed %s lookup: %s", table, err)
+		}
+
+		// Rows are encoded as JSON, which writes map keys in sorted order,
+		// so map fields such as Node.Meta hash the same on every server.
+		enc := json.NewEncoder(hash)
+		for row := rows.Next(); row != nil; row = rows.Next() {
+			if err := enc.Encode(row); err != nil {
+				return nil, fmt.Errorf("failed hashing %s: %s", table, err)
+			}
+		}
+
+		out[table] = hash.Sum(nil)
+	}
+	return out, nil
+}
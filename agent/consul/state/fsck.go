@@ -0,0 +1,192 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// Fsck scans the catalog, session, ACL, and config entry tables for
+// references to objects that no longer exist, such as a service instance
+// registered against a node that's since been deregistered. It's a
+// read-only, point-in-time check rather than a blocking query.
+func (s *Store) Fsck(entMeta *structs.EnterpriseMeta) ([]structs.FSCKResult, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	var results []structs.FSCKResult
+
+	serviceResults, err := fsckServicesTxn(tx, entMeta)
+	if err != nil {
+		return nil, fmt.Errorf("failed service invariant check: %s", err)
+	}
+	results = append(results, serviceResults...)
+
+	checkResults, err := fsckChecksTxn(tx, entMeta)
+	if err != nil {
+		return nil, fmt.Errorf("failed check invariant check: %s", err)
+	}
+	results = append(results, checkResults...)
+
+	sessionResults, err := fsckSessionsTxn(tx, entMeta)
+	if err != nil {
+		return nil, fmt.Errorf("failed session invariant check: %s", err)
+	}
+	results = append(results, sessionResults...)
+
+	tokenResults, err := fsckACLTokensTxn(tx, entMeta)
+	if err != nil {
+		return nil, fmt.Errorf("failed ACL token invariant check: %s", err)
+	}
+	results = append(results, tokenResults...)
+
+	configEntryResults, err := fsckConfigEntriesTxn(tx, entMeta)
+	if err != nil {
+		return nil, fmt.Errorf("failed config entry invariant check: %s", err)
+	}
+	results = append(results, configEntryResults...)
+
+	return results, nil
+}
+
+func fsckServicesTxn(tx ReadTxn, entMeta *structs.EnterpriseMeta) ([]structs.FSCKResult, error) {
+	services, err := getWithTxn(tx, "services", "id_prefix", "", entMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []structs.FSCKResult
+	for raw := services.Next(); raw != nil; raw = services.Next() {
+		sn := raw.(*structs.ServiceNode)
+
+		node, err := tx.First("nodes", "id", sn.Node)
+		if err != nil {
+			return nil, err
+		}
+		if node == nil {
+			results = append(results, structs.FSCKResult{
+				Category:  structs.FSCKServiceMissingNode,
+				Resource:  fmt.Sprintf("%s/%s", sn.Node, sn.ServiceID),
+				Reference: sn.Node,
+			})
+		}
+	}
+	return results, nil
+}
+
+func fsckChecksTxn(tx ReadTxn, entMeta *structs.EnterpriseMeta) ([]structs.FSCKResult, error) {
+	checks, err := getWithTxn(tx, "checks", "id_prefix", "", entMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []structs.FSCKResult
+	for raw := checks.Next(); raw != nil; raw = checks.Next() {
+		hc := raw.(*structs.HealthCheck)
+		if hc.ServiceID == "" {
+			continue
+		}
+
+		svc, err := tx.First("services", "id", hc.Node, hc.ServiceID)
+		if err != nil {
+			return nil, err
+		}
+		if svc == nil {
+			results = append(results, structs.FSCKResult{
+				Category:  structs.FSCKCheckMissingService,
+				Resource:  fmt.Sprintf("%s/%s", hc.Node, hc.CheckID),
+				Reference: fmt.Sprintf("%s/%s", hc.Node, hc.ServiceID),
+			})
+		}
+	}
+	return results, nil
+}
+
+func fsckSessionsTxn(tx ReadTxn, entMeta *structs.EnterpriseMeta) ([]structs.FSCKResult, error) {
+	sessions, err := getWithTxn(tx, "sessions", "id_prefix", "", entMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []structs.FSCKResult
+	for raw := sessions.Next(); raw != nil; raw = sessions.Next() {
+		session := raw.(*structs.Session)
+
+		node, err := tx.First("nodes", "id", session.Node)
+		if err != nil {
+			return nil, err
+		}
+		if node == nil {
+			results = append(results, structs.FSCKResult{
+				Category:   structs.FSCKSessionMissingNode,
+				Resource:   session.ID,
+				Reference:  session.Node,
+				Repairable: true,
+			})
+		}
+	}
+	return results, nil
+}
+
+func fsckACLTokensTxn(tx *txn, entMeta *structs.EnterpriseMeta) ([]structs.FSCKResult, error) {
+	tokens, err := aclTokenListAll(tx, entMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []structs.FSCKResult
+	for raw := tokens.Next(); raw != nil; raw = tokens.Next() {
+		token := raw.(*structs.ACLToken)
+
+		for _, link := range token.Policies {
+			policy, err := tx.First("acl-policies", "id", link.ID)
+			if err != nil {
+				return nil, err
+			}
+			if policy == nil {
+				results = append(results, structs.FSCKResult{
+					Category:  structs.FSCKTokenMissingPolicy,
+					Resource:  token.AccessorID,
+					Reference: link.ID,
+				})
+			}
+		}
+	}
+	return results, nil
+}
+
+func fsckConfigEntriesTxn(tx ReadTxn, entMeta *structs.EnterpriseMeta) ([]structs.FSCKResult, error) {
+	_, entries, err := configEntriesByKindTxn(tx, nil, structs.ServiceIntentions, entMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []structs.FSCKResult
+	for _, entry := range entries {
+		ixn, ok := entry.(*structs.ServiceIntentionsConfigEntry)
+		if !ok || ixn.Name == structs.WildcardSpecifier {
+			continue
+		}
+
+		exists, err := serviceNameExistsTxn(tx, ixn.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			results = append(results, structs.FSCKResult{
+				Category:  structs.FSCKConfigEntryMissingService,
+				Resource:  fmt.Sprintf("%s/%s", ixn.GetKind(), ixn.Name),
+				Reference: ixn.Name,
+			})
+		}
+	}
+	return results, nil
+}
+
+func serviceNameExistsTxn(tx ReadTxn, name string) (bool, error) {
+	svc, err := tx.First("services", "service", name)
+	if err != nil {
+		return false, err
+	}
+	return svc != nil, nil
+}
@@ -0,0 +1,76 @@
+package state
+
+import (
+	"github.com/hashicorp/consul/agent/consul/stream"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto/pbsubscribe"
+)
+
+// EventPayloadIntention is used as the Payload for a stream.Event to
+// indicate changes to an Intention for the IntentionMatch topic.
+type EventPayloadIntention struct {
+	Op    pbsubscribe.CatalogOp
+	Value *structs.Intention
+}
+
+// IntentionEventsFromChanges returns all the IntentionMatch events that
+// should be emitted given a set of changes to the connect-intentions table.
+func IntentionEventsFromChanges(tx ReadTxn, changes Changes) ([]stream.Event, error) {
+	var events []stream.Event
+
+	for _, change := range changes.Changes {
+		if change.Table != intentionsTableName {
+			continue
+		}
+
+		op := pbsubscribe.CatalogOp_Register
+		if change.Deleted() {
+			op = pbsubscribe.CatalogOp_Deregister
+		}
+		ixn := changeObject(change).(*structs.Intention)
+
+		events = append(events, stream.Event{
+			Topic: topicIntentionMatch,
+			Key:   ixn.DestinationName,
+			Index: changes.Index,
+			Payload: EventPayloadIntention{
+				Op:    op,
+				Value: ixn,
+			},
+		})
+	}
+
+	return events, nil
+}
+
+// intentionMatchSnapshot returns a stream.SnapshotFunc that provides a
+// snapshot of stream.Events for all intentions scoped to the destination
+// service named by req.Key.
+func intentionMatchSnapshot(s *Store) stream.SnapshotFunc {
+	return func(req stream.SubscribeRequest, buf stream.SnapshotAppender) (index uint64, err error) {
+		tx := s.db.Txn(false)
+		defer tx.Abort()
+
+		idx := maxIndexTxn(tx, intentionsTableName)
+
+		iter, err := tx.Get(intentionsTableName, "destination", structs.IntentionDefaultNamespace, req.Key)
+		if err != nil {
+			return 0, err
+		}
+
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			ixn := raw.(*structs.Intention)
+			buf.Append([]stream.Event{{
+				Topic: topicIntentionMatch,
+				Key:   ixn.DestinationName,
+				Index: idx,
+				Payload: EventPayloadIntention{
+					Op:    pbsubscribe.CatalogOp_Register,
+					Value: ixn,
+				},
+			}})
+		}
+
+		return idx, nil
+	}
+}
@@ -0,0 +1,73 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto/pbsubscribe"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntentionEventsFromChanges(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Mutate  func(tx *txn) error
+		WantOp  pbsubscribe.CatalogOp
+		WantKey string
+	}{
+		{
+			Name: "create",
+			Mutate: func(tx *txn) error {
+				return legacyIntentionSetTxn(tx, tx.Index, newTestIntention("web"))
+			},
+			WantOp:  pbsubscribe.CatalogOp_Register,
+			WantKey: "web",
+		},
+		{
+			Name: "delete",
+			Mutate: func(tx *txn) error {
+				return legacyIntentionDeleteTxn(tx, tx.Index, newTestIntention("web").ID)
+			},
+			WantOp:  pbsubscribe.CatalogOp_Deregister,
+			WantKey: "web",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			s := testStateStore(t)
+
+			if tc.Name == "delete" {
+				setupTx := s.db.WriteTxn(10)
+				require.NoError(t, legacyIntentionSetTxn(setupTx, 10, newTestIntention("web")))
+				setupTx.Txn.Commit()
+			}
+
+			tx := s.db.WriteTxn(100)
+			require.NoError(t, tc.Mutate(tx))
+
+			events, err := IntentionEventsFromChanges(tx, Changes{Index: 100, Changes: tx.Changes()})
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+
+			require.Equal(t, topicIntentionMatch, events[0].Topic)
+			require.Equal(t, tc.WantKey, events[0].Key)
+
+			payload, ok := events[0].Payload.(EventPayloadIntention)
+			require.True(t, ok)
+			require.Equal(t, tc.WantOp, payload.Op)
+		})
+	}
+}
+
+func newTestIntention(destinationName string) *structs.Intention {
+	return &structs.Intention{
+		ID:              "4dcd8e54-0e5d-11eb-8f73-cfde50608e92",
+		SourceNS:        structs.IntentionDefaultNamespace,
+		SourceName:      "api",
+		DestinationNS:   structs.IntentionDefaultNamespace,
+		DestinationName: destinationName,
+		Action:          structs.IntentionActionAllow,
+	}
+}
@@ -158,6 +158,7 @@ func (tx *txn) Commit() error {
 var (
 	topicServiceHealth        = pbsubscribe.Topic_ServiceHealth
 	topicServiceHealthConnect = pbsubscribe.Topic_ServiceHealthConnect
+	topicIntentionMatch       = pbsubscribe.Topic_IntentionMatch
 )
 
 func processDBChanges(tx ReadTxn, changes Changes) ([]stream.Event, error) {
@@ -165,6 +166,7 @@ func processDBChanges(tx ReadTxn, changes Changes) ([]stream.Event, error) {
 	fns := []func(tx ReadTxn, changes Changes) ([]stream.Event, error){
 		aclChangeUnsubscribeEvent,
 		ServiceHealthEventsFromChanges,
+		IntentionEventsFromChanges,
 		// TODO: add other table handlers here.
 	}
 	for _, fn := range fns {
@@ -181,5 +183,6 @@ func newSnapshotHandlers(s *Store) stream.SnapshotHandlers {
 	return stream.SnapshotHandlers{
 		topicServiceHealth:        serviceHealthSnapshot(s, topicServiceHealth),
 		topicServiceHealthConnect: serviceHealthSnapshot(s, topicServiceHealthConnect),
+		topicIntentionMatch:       intentionMatchSnapshot(s),
 	}
 }
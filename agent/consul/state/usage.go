@@ -9,6 +9,13 @@ import (
 
 const (
 	serviceNamesUsageTable = "service-names"
+
+	// kvUsageBytesID and checkUsageOutputBytesID track the total size in
+	// bytes of the largest variable-length fields in the kvs and checks
+	// tables, to give operators visibility into how much of the state
+	// store's memory footprint those tables account for.
+	kvUsageBytesID          = "kv-bytes"
+	checkUsageOutputBytesID = "check-output-bytes"
 )
 
 // usageTableSchema returns a new table schema used for tracking various indexes
@@ -72,6 +79,10 @@ func updateUsage(tx WriteTxn, changes Changes) error {
 		switch change.Table {
 		case "nodes":
 			usageDeltas[change.Table] += delta
+		case "kvs":
+			usageDeltas[kvUsageBytesID] += kvValueByteDelta(change)
+		case "checks":
+			usageDeltas[checkUsageOutputBytesID] += checkOutputByteDelta(change)
 		case "services":
 			svc := changeObject(change).(*structs.ServiceNode)
 			usageDeltas[change.Table] += delta
@@ -144,6 +155,36 @@ func updateUsage(tx WriteTxn, changes Changes) error {
 	return writeUsageDeltas(tx, idx, usageDeltas)
 }
 
+// kvValueByteDelta returns the change in total bytes stored in the kvs
+// table's Value fields caused by the given change.
+func kvValueByteDelta(change memdb.Change) int {
+	switch {
+	case change.Created():
+		return len(changeObject(change).(*structs.DirEntry).Value)
+	case change.Deleted():
+		return -len(changeObject(change).(*structs.DirEntry).Value)
+	default:
+		before := change.Before.(*structs.DirEntry)
+		after := change.After.(*structs.DirEntry)
+		return len(after.Value) - len(before.Value)
+	}
+}
+
+// checkOutputByteDelta returns the change in total bytes stored in the
+// checks table's Output fields caused by the given change.
+func checkOutputByteDelta(change memdb.Change) int {
+	switch {
+	case change.Created():
+		return len(changeObject(change).(*structs.HealthCheck).Output)
+	case change.Deleted():
+		return -len(changeObject(change).(*structs.HealthCheck).Output)
+	default:
+		before := change.Before.(*structs.HealthCheck)
+		after := change.After.(*structs.HealthCheck)
+		return len(after.Output) - len(before.Output)
+	}
+}
+
 // serviceNameChanged returns a boolean that indicates whether the
 // provided change resulted in an update to the service's service name.
 func serviceNameChanged(change memdb.Change) bool {
@@ -208,6 +249,32 @@ func (s *Store) NodeCount() (uint64, int, error) {
 	return nodeUsage.Index, nodeUsage.Count, nil
 }
 
+// KVUsage returns the latest seen Raft index and the total number of bytes
+// stored across all Value fields in the kvs table.
+func (s *Store) KVUsage() (uint64, uint64, error) {
+	tx := s.db.ReadTxn()
+	defer tx.Abort()
+
+	kvUsage, err := firstUsageEntry(tx, kvUsageBytesID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed kv usage lookup: %s", err)
+	}
+	return kvUsage.Index, uint64(kvUsage.Count), nil
+}
+
+// CheckOutputUsage returns the latest seen Raft index and the total number
+// of bytes stored across all Output fields in the checks table.
+func (s *Store) CheckOutputUsage() (uint64, uint64, error) {
+	tx := s.db.ReadTxn()
+	defer tx.Abort()
+
+	checkUsage, err := firstUsageEntry(tx, checkUsageOutputBytesID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed check output usage lookup: %s", err)
+	}
+	return checkUsage.Index, uint64(checkUsage.Count), nil
+}
+
 // ServiceUsage returns the latest seen Raft index, a compiled set of service
 // usage data, and any errors.
 func (s *Store) ServiceUsage() (uint64, ServiceUsage, error) {
@@ -55,6 +55,60 @@ func TestStateStore_Usage_ServiceUsageEmpty(t *testing.T) {
 	require.Equal(t, usage.ServiceInstances, 0)
 }
 
+func TestStateStore_Usage_KVUsage(t *testing.T) {
+	s := testStateStore(t)
+
+	idx, kvBytes, err := s.KVUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), idx)
+	require.Equal(t, uint64(0), kvBytes)
+
+	require.NoError(t, s.KVSSet(1, &structs.DirEntry{Key: "foo", Value: []byte("hello")}))
+	require.NoError(t, s.KVSSet(2, &structs.DirEntry{Key: "bar", Value: []byte("world!")}))
+
+	_, kvBytes, err = s.KVUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(len("hello")+len("world!")), kvBytes)
+
+	require.NoError(t, s.KVSSet(3, &structs.DirEntry{Key: "foo", Value: []byte("hi")}))
+
+	_, kvBytes, err = s.KVUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(len("hi")+len("world!")), kvBytes)
+
+	require.NoError(t, s.KVSDelete(4, "bar", nil))
+
+	_, kvBytes, err = s.KVUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(len("hi")), kvBytes)
+}
+
+func TestStateStore_Usage_CheckOutputUsage(t *testing.T) {
+	s := testStateStore(t)
+	testRegisterNode(t, s, 0, "node1")
+
+	idx, outputBytes, err := s.CheckOutputUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), idx)
+	require.Equal(t, uint64(0), outputBytes)
+
+	require.NoError(t, s.EnsureCheck(1, &structs.HealthCheck{
+		Node:    "node1",
+		CheckID: "check1",
+		Output:  "passing",
+	}))
+
+	_, outputBytes, err = s.CheckOutputUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(len("passing")), outputBytes)
+
+	require.NoError(t, s.DeleteCheck(2, "node1", "check1", nil))
+
+	_, outputBytes, err = s.CheckOutputUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), outputBytes)
+}
+
 func TestStateStore_Usage_Restore(t *testing.T) {
 	s := testStateStore(t)
 	restore := s.Restore()
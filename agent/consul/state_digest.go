@@ -0,0 +1,61 @@
+package consul
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// stateDigestInterval controls how often each server recomputes its FSM
+// table hashes for the Operator.StateDigest RPC.
+const stateDigestInterval = 30 * time.Second
+
+// runStateDigestVerifier is a long running routine, started on every server
+// (not just the leader), that periodically hashes a handful of FSM tables
+// and stores the result for retrieval via the Operator.StateDigest RPC.
+// Comparing the digests reported by each server lets operators catch silent
+// divergence between the leader and its followers before it surfaces as
+// mysterious inconsistency.
+func (s *Server) runStateDigestVerifier() {
+	if err := s.updateStateDigest(); err != nil {
+		s.logger.Warn("failed to compute state digest", "error", err)
+	}
+
+	for {
+		select {
+		case <-time.After(stateDigestInterval):
+			if err := s.updateStateDigest(); err != nil {
+				s.logger.Warn("failed to compute state digest", "error", err)
+			}
+		case <-s.shutdownCh:
+			return
+		}
+	}
+}
+
+func (s *Server) updateStateDigest() error {
+	store := s.fsm.State()
+
+	hashes, err := store.TableHashes(state.DigestTables)
+	if err != nil {
+		return err
+	}
+
+	tables := make(map[string]string, len(hashes))
+	for table, hash := range hashes {
+		tables[table] = fmt.Sprintf("%x", hash)
+	}
+
+	digest := &structs.StateDigest{
+		Time:   time.Now(),
+		Index:  s.raft.AppliedIndex(),
+		Tables: tables,
+	}
+
+	s.lastStateDigestLock.Lock()
+	s.lastStateDigest = digest
+	s.lastStateDigestLock.Unlock()
+	return nil
+}
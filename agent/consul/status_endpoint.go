@@ -56,6 +56,15 @@ func (s *Status) Peers(args *structs.DCSpecificRequest, reply *[]string) error {
 	return nil
 }
 
+// AppliedIndex returns the Raft index most recently applied to this
+// server's FSM. It's used to fan out convergence checks to every server in
+// the datacenter without forwarding through the leader, since the point is
+// to learn each server's own view of how far it has caught up.
+func (s *Status) AppliedIndex(args struct{}, reply *uint64) error {
+	*reply = s.server.raft.AppliedIndex()
+	return nil
+}
+
 // Used by Autopilot to query the raft stats of the local server.
 func (s *Status) RaftStats(args struct{}, reply *autopilot.ServerStats) error {
 	stats := s.server.raft.Stats()
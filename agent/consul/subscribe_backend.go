@@ -41,3 +41,13 @@ func (s subscribeBackend) Forward(dc string, f func(*grpc.ClientConn) error) (ha
 func (s subscribeBackend) Subscribe(req *stream.SubscribeRequest) (*stream.Subscription, error) {
 	return s.srv.fsm.State().EventPublisher().Subscribe(req)
 }
+
+// LocalSubscribe returns a stream.Subscription sourced from this server's
+// own local state store. Unlike the gRPC Subscribe RPC it does not forward
+// requests targeting a remote datacenter, since callers (such as the
+// /v1/agent/subscribe HTTP endpoint) are only expected to use it against a
+// server in the local datacenter. Callers are responsible for applying
+// subscribe.EnforceACL to returned events themselves.
+func (s *Server) LocalSubscribe(req *stream.SubscribeRequest) (*stream.Subscription, error) {
+	return s.fsm.State().EventPublisher().Subscribe(req)
+}
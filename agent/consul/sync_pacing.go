@@ -0,0 +1,112 @@
+package consul
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// syncPacingCheckInterval is how often the leader recomputes and, if
+	// changed, republishes its anti-entropy pacing hint.
+	syncPacingCheckInterval = 5 * time.Second
+
+	// syncPacingWindow is the trailing window of LAN joins used to detect
+	// a reconnect storm, e.g. right after a partition heals.
+	syncPacingWindow = 30 * time.Second
+
+	// syncPacingJoinThreshold is the number of joins within the window
+	// before the leader starts advertising a non-zero pacing hint.
+	syncPacingJoinThreshold = 20
+
+	// syncPacingMaxHint caps how long a reconnecting agent is asked to
+	// stagger its full sync, regardless of how large the reconnect storm
+	// is.
+	syncPacingMaxHint = 30 * time.Second
+)
+
+// syncPacingTracker counts recent LAN member joins so the leader can tell
+// whether a mass reconnect is underway.
+type syncPacingTracker struct {
+	mu        sync.Mutex
+	joinTimes []time.Time
+}
+
+func newSyncPacingTracker() *syncPacingTracker {
+	return &syncPacingTracker{}
+}
+
+// recordJoins notes that n members just joined the LAN Serf cluster.
+func (t *syncPacingTracker) recordJoins(n int) {
+	if n <= 0 {
+		return
+	}
+	now := time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i := 0; i < n; i++ {
+		t.joinTimes = append(t.joinTimes, now)
+	}
+}
+
+// hint prunes joins older than syncPacingWindow and returns a pacing hint
+// proportional to how many joins remain, or zero if there's no reconnect
+// storm to pace out.
+func (t *syncPacingTracker) hint() time.Duration {
+	cutoff := time.Now().Add(-syncPacingWindow)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	i := 0
+	for i < len(t.joinTimes) && t.joinTimes[i].Before(cutoff) {
+		i++
+	}
+	t.joinTimes = t.joinTimes[i:]
+
+	count := len(t.joinTimes)
+	if count < syncPacingJoinThreshold {
+		return 0
+	}
+
+	hint := time.Duration(count-syncPacingJoinThreshold+1) * time.Second
+	if hint > syncPacingMaxHint {
+		hint = syncPacingMaxHint
+	}
+	return hint
+}
+
+// startSyncPacing begins advertising an anti-entropy pacing hint derived
+// from recent LAN reconnect volume. Only the leader tracks and publishes
+// this, since it's the one that would otherwise get hammered by every
+// agent's full sync landing at once.
+func (s *Server) startSyncPacing() {
+	s.leaderRoutineManager.Start(syncPacingRoutineName, s.runSyncPacing)
+}
+
+func (s *Server) stopSyncPacing() {
+	s.leaderRoutineManager.Stop(syncPacingRoutineName)
+
+	// Clear the advertised hint so agents don't keep staggering against a
+	// stale value once we're no longer the one tracking it.
+	s.updateSerfTags("sync_pace_ms", "0")
+}
+
+func (s *Server) runSyncPacing(ctx context.Context) error {
+	ticker := time.NewTicker(syncPacingCheckInterval)
+	defer ticker.Stop()
+
+	lastHint := time.Duration(-1) // force the first publish
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if hint := s.syncPacing.hint(); hint != lastHint {
+				s.updateSerfTags("sync_pace_ms", strconv.FormatInt(hint.Milliseconds(), 10))
+				lastHint = hint
+			}
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package consul
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncPacingTracker_Hint(t *testing.T) {
+	tr := newSyncPacingTracker()
+
+	require.Zero(t, tr.hint(), "no joins recorded yet")
+
+	tr.recordJoins(syncPacingJoinThreshold - 1)
+	require.Zero(t, tr.hint(), "below the reconnect-storm threshold")
+
+	tr.recordJoins(1)
+	require.Equal(t, time.Second, tr.hint())
+
+	tr.recordJoins(10 * syncPacingJoinThreshold)
+	require.Equal(t, syncPacingMaxHint, tr.hint(), "hint is capped")
+}
+
+func TestSyncPacingTracker_PrunesOldJoins(t *testing.T) {
+	tr := newSyncPacingTracker()
+	tr.joinTimes = make([]time.Time, syncPacingJoinThreshold)
+	for i := range tr.joinTimes {
+		tr.joinTimes[i] = time.Now().Add(-syncPacingWindow - time.Second)
+	}
+
+	require.Zero(t, tr.hint(), "stale joins outside the window shouldn't count")
+	require.Empty(t, tr.joinTimes, "stale joins should be pruned")
+}
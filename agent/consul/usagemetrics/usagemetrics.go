@@ -120,4 +120,24 @@ func (u *UsageMetricsReporter) runOnce() {
 	}
 
 	u.emitServiceUsage(serviceUsage)
+
+	_, kvBytes, err := state.KVUsage()
+	if err != nil {
+		u.logger.Warn("failed to retrieve KV usage from state store", "error", err)
+	}
+	metrics.SetGaugeWithLabels(
+		[]string{"consul", "state", "kv_bytes"},
+		float32(kvBytes),
+		u.metricLabels,
+	)
+
+	_, checkOutputBytes, err := state.CheckOutputUsage()
+	if err != nil {
+		u.logger.Warn("failed to retrieve check output usage from state store", "error", err)
+	}
+	metrics.SetGaugeWithLabels(
+		[]string{"consul", "state", "check_output_bytes"},
+		float32(checkOutputBytes),
+		u.metricLabels,
+	)
 }
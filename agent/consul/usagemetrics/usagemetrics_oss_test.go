@@ -44,6 +44,20 @@ func TestUsageReporter_emitServiceUsage_OSS(t *testing.T) {
 						{Name: "datacenter", Value: "dc1"},
 					},
 				},
+				"consul.usage.test.consul.state.kv_bytes;datacenter=dc1": {
+					Name:  "consul.usage.test.consul.state.kv_bytes",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+					},
+				},
+				"consul.usage.test.consul.state.check_output_bytes;datacenter=dc1": {
+					Name:  "consul.usage.test.consul.state.check_output_bytes",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+					},
+				},
 			},
 		},
 		"nodes-and-services": {
@@ -78,6 +92,20 @@ func TestUsageReporter_emitServiceUsage_OSS(t *testing.T) {
 						{Name: "datacenter", Value: "dc1"},
 					},
 				},
+				"consul.usage.test.consul.state.kv_bytes;datacenter=dc1": {
+					Name:  "consul.usage.test.consul.state.kv_bytes",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+					},
+				},
+				"consul.usage.test.consul.state.check_output_bytes;datacenter=dc1": {
+					Name:  "consul.usage.test.consul.state.check_output_bytes",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+					},
+				},
 			},
 		},
 	}
@@ -29,18 +29,44 @@ const (
 
 type MeshGatewayResolver func(datacenter string) string
 
+// NewTransport returns a Transport that intercepts cross-datacenter gossip
+// and tunnels it over a TLS connection authenticated with the agent's own
+// RPC certificates, dialed via a mesh gateway resolved by gwResolver.
 func NewTransport(
 	tlsConfigurator *tlsutil.Configurator,
 	transport memberlist.NodeAwareTransport,
 	datacenter string,
 	gwResolver MeshGatewayResolver,
 ) (*Transport, error) {
-	if tlsConfigurator == nil {
-		return nil, errors.New("wanfed: tlsConfigurator is nil")
-	}
 	if gwResolver == nil {
 		return nil, errors.New("wanfed: gwResolver is nil")
 	}
+	return newTransport(tlsConfigurator, transport, datacenter, gwResolver)
+}
+
+// NewDirectTransport returns a Transport that tunnels all WAN gossip over a
+// TLS connection authenticated with the agent's own RPC certificates, dialed
+// directly against each peer's advertised address. Unlike NewTransport, this
+// does not require a mesh gateway and is used for plain WAN federation where
+// gossip should be secured with TLS instead of relying solely on the shared
+// gossip encryption keyring.
+func NewDirectTransport(
+	tlsConfigurator *tlsutil.Configurator,
+	transport memberlist.NodeAwareTransport,
+	datacenter string,
+) (*Transport, error) {
+	return newTransport(tlsConfigurator, transport, datacenter, nil)
+}
+
+func newTransport(
+	tlsConfigurator *tlsutil.Configurator,
+	transport memberlist.NodeAwareTransport,
+	datacenter string,
+	gwResolver MeshGatewayResolver,
+) (*Transport, error) {
+	if tlsConfigurator == nil {
+		return nil, errors.New("wanfed: tlsConfigurator is nil")
+	}
 
 	cp, err := newConnPool(GossipPacketMaxIdleTime)
 	if err != nil {
@@ -89,36 +115,35 @@ func (t *Transport) WriteToAddress(b []byte, addr memberlist.Address) (time.Time
 		return time.Time{}, err
 	}
 
-	if dc != t.datacenter {
-		gwAddr := t.gwResolver(dc)
-		if gwAddr == "" {
-			return time.Time{}, structs.ErrDCNotAvailable
-		}
-
-		dialFunc := func() (net.Conn, error) {
-			return t.dial(dc, node, pool.ALPN_WANGossipPacket, gwAddr)
-		}
-		conn, err := t.pool.AcquireOrDial(addr.Name, dialFunc)
-		if err != nil {
-			return time.Time{}, err
-		}
-		defer conn.ReturnOrClose()
+	dialAddr, wrap, err := t.wrapAddr(dc, addr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !wrap {
+		return t.NodeAwareTransport.WriteToAddress(b, addr)
+	}
 
-		// Send the length first.
-		if err := binary.Write(conn, binary.BigEndian, uint32(len(b))); err != nil {
-			conn.MarkFailed()
-			return time.Time{}, err
-		}
+	dialFunc := func() (net.Conn, error) {
+		return t.dial(dc, node, pool.ALPN_WANGossipPacket, dialAddr)
+	}
+	conn, err := t.pool.AcquireOrDial(addr.Name, dialFunc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer conn.ReturnOrClose()
 
-		if _, err = conn.Write(b); err != nil {
-			conn.MarkFailed()
-			return time.Time{}, err
-		}
+	// Send the length first.
+	if err := binary.Write(conn, binary.BigEndian, uint32(len(b))); err != nil {
+		conn.MarkFailed()
+		return time.Time{}, err
+	}
 
-		return time.Now(), nil
+	if _, err = conn.Write(b); err != nil {
+		conn.MarkFailed()
+		return time.Time{}, err
 	}
 
-	return t.NodeAwareTransport.WriteToAddress(b, addr)
+	return time.Now(), nil
 }
 
 // DialAddressTimeout implements memberlist.NodeAwareTransport.
@@ -128,16 +153,37 @@ func (t *Transport) DialAddressTimeout(addr memberlist.Address, timeout time.Dur
 		return nil, err
 	}
 
-	if dc != t.datacenter {
-		gwAddr := t.gwResolver(dc)
-		if gwAddr == "" {
-			return nil, structs.ErrDCNotAvailable
-		}
+	dialAddr, wrap, err := t.wrapAddr(dc, addr)
+	if err != nil {
+		return nil, err
+	}
+	if !wrap {
+		return t.NodeAwareTransport.DialAddressTimeout(addr, timeout)
+	}
+
+	return t.dial(dc, node, pool.ALPN_WANGossipStream, dialAddr)
+}
 
-		return t.dial(dc, node, pool.ALPN_WANGossipStream, gwAddr)
+// wrapAddr determines whether gossip traffic to addr should be tunneled over
+// TLS, and if so the address it should be dialed at. When gwResolver is set
+// (mesh gateway federation) only cross-datacenter traffic is wrapped, dialed
+// via the mesh gateway resolved for dc. When gwResolver is nil (direct
+// gossip-over-TLS federation) all traffic is wrapped and dialed directly at
+// its advertised address.
+func (t *Transport) wrapAddr(dc string, addr memberlist.Address) (dialAddr string, wrap bool, err error) {
+	if t.gwResolver == nil {
+		return addr.Addr, true, nil
 	}
 
-	return t.NodeAwareTransport.DialAddressTimeout(addr, timeout)
+	if dc == t.datacenter {
+		return "", false, nil
+	}
+
+	gwAddr := t.gwResolver(dc)
+	if gwAddr == "" {
+		return "", false, structs.ErrDCNotAvailable
+	}
+	return gwAddr, true, nil
 }
 
 // NOTE: There is a close mirror of this method in agent/pool/pool.go:DialTimeoutWithRPCType
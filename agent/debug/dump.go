@@ -0,0 +1,92 @@
+package debug
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+)
+
+// dumpSubdir is the directory under the data directory where profile dumps
+// are written.
+const dumpSubdir = "debug"
+
+// dumpRetention is how many dumps of each profile kind are kept on disk;
+// older ones are pruned each time a new dump is taken.
+const dumpRetention = 10
+
+// dumpProfileKinds are the runtime/pprof profiles captured by WriteProfiles.
+var dumpProfileKinds = []string{"heap", "goroutine", "mutex"}
+
+// WriteProfiles writes heap, goroutine, and mutex profiles to dataDir/debug
+// with timestamped filenames, and prunes dumps beyond the retention limit.
+// It returns the paths that were written. A failure to capture one profile
+// doesn't prevent the others from being captured.
+func WriteProfiles(dataDir string, logger hclog.Logger) ([]string, error) {
+	dumpDir := filepath.Join(dataDir, dumpSubdir)
+	if err := os.MkdirAll(dumpDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create debug dump directory: %w", err)
+	}
+
+	ts := time.Now().UTC().Format("20060102T150405Z")
+
+	var written []string
+	for _, kind := range dumpProfileKinds {
+		path := filepath.Join(dumpDir, fmt.Sprintf("%s-%s.pprof", kind, ts))
+		if err := writeProfile(kind, path); err != nil {
+			if logger != nil {
+				logger.Warn("failed to write debug profile", "profile", kind, "error", err)
+			}
+			continue
+		}
+		written = append(written, path)
+	}
+
+	if err := pruneProfiles(dumpDir); err != nil && logger != nil {
+		logger.Warn("failed to prune old debug dumps", "error", err)
+	}
+
+	return written, nil
+}
+
+func writeProfile(kind, path string) error {
+	p := pprof.Lookup(kind)
+	if p == nil {
+		return fmt.Errorf("unknown profile %q", kind)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return p.WriteTo(f, 0)
+}
+
+// pruneProfiles deletes the oldest dumps of each profile kind in dumpDir
+// beyond dumpRetention. Dump filenames sort lexically by timestamp, so the
+// oldest are simply the first entries once sorted.
+func pruneProfiles(dumpDir string) error {
+	for _, kind := range dumpProfileKinds {
+		matches, err := filepath.Glob(filepath.Join(dumpDir, fmt.Sprintf("%s-*.pprof", kind)))
+		if err != nil {
+			return err
+		}
+		if len(matches) <= dumpRetention {
+			continue
+		}
+
+		sort.Strings(matches)
+		for _, old := range matches[:len(matches)-dumpRetention] {
+			if err := os.Remove(old); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package debug
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteProfiles(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "consul-debug-dump")
+	require.NoError(t, err)
+	defer os.RemoveAll(dataDir)
+
+	written, err := WriteProfiles(dataDir, nil)
+	require.NoError(t, err)
+	require.Len(t, written, len(dumpProfileKinds))
+
+	for _, path := range written {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.Greater(t, info.Size(), int64(0))
+	}
+}
+
+func TestWriteProfiles_Retention(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "consul-debug-dump")
+	require.NoError(t, err)
+	defer os.RemoveAll(dataDir)
+
+	dumpDir := filepath.Join(dataDir, dumpSubdir)
+	require.NoError(t, os.MkdirAll(dumpDir, 0755))
+
+	for i := 0; i < dumpRetention+5; i++ {
+		name := fmt.Sprintf("heap-20200101T%06dZ.pprof", i)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dumpDir, name), []byte("x"), 0644))
+	}
+
+	require.NoError(t, pruneProfiles(dumpDir))
+
+	matches, err := filepath.Glob(filepath.Join(dumpDir, "heap-*.pprof"))
+	require.NoError(t, err)
+	require.Len(t, matches, dumpRetention)
+}
@@ -0,0 +1,85 @@
+// Package exec implements a go-discover provider that shells out to an
+// external binary to resolve join addresses. It exists so that users on
+// platforms without a built-in cloud provider can plug in their own address
+// resolution logic without forking Consul.
+package exec
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Provider implements the go-discover Provider interface by running an
+// external command and treating each line it prints on stdout as a join
+// address.
+type Provider struct{}
+
+func (p *Provider) Help() string {
+	return `Exec:
+
+    provider:  "exec"
+    command:   The command to run. Required.
+    timeout:   The amount of time to wait for the command to finish, as a
+               Go duration (e.g. "10s"). Defaults to 10s.
+
+    The command is run through the shell ("/bin/sh -c" on Unix, "cmd /C" on
+    Windows) with no arguments, so it may itself be a pipeline. It must exit
+    0 and print zero or more addresses, one per line, on stdout. Blank lines
+    are ignored. Any other exit code or output format is treated as an
+    error and no addresses are returned.
+`
+}
+
+func (p *Provider) Addrs(args map[string]string, l *log.Logger) ([]string, error) {
+	if args["provider"] != "exec" {
+		return nil, fmt.Errorf("discover-exec: invalid provider " + args["provider"])
+	}
+
+	command := args["command"]
+	if command == "" {
+		return nil, fmt.Errorf("discover-exec: command not provided")
+	}
+
+	timeout := 10 * time.Second
+	if v := args["timeout"]; v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("discover-exec: invalid timeout %q: %s", v, err)
+		}
+		timeout = d
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if l != nil {
+		l.Printf("[DEBUG] discover-exec: Running command: %s", command)
+	}
+
+	out, err := shellCommand(ctx, command).Output()
+	if err != nil {
+		return nil, fmt.Errorf("discover-exec: command failed: %s", err)
+	}
+
+	var addrs []string
+	scanner := bufio.NewScanner(strings.NewReader(string(out)))
+	for scanner.Scan() {
+		addr := strings.TrimSpace(scanner.Text())
+		if addr == "" {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("discover-exec: failed to read command output: %s", err)
+	}
+
+	if l != nil {
+		l.Printf("[DEBUG] discover-exec: Discovered addresses: %v", addrs)
+	}
+	return addrs, nil
+}
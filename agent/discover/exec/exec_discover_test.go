@@ -0,0 +1,42 @@
+package exec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_Addrs(t *testing.T) {
+	p := &Provider{}
+
+	addrs, err := p.Addrs(map[string]string{
+		"provider": "exec",
+		"command":  "printf '10.0.0.1\\n10.0.0.2\\n\\n'",
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, addrs)
+}
+
+func TestProvider_Addrs_MissingCommand(t *testing.T) {
+	p := &Provider{}
+
+	_, err := p.Addrs(map[string]string{"provider": "exec"}, nil)
+	require.Error(t, err)
+}
+
+func TestProvider_Addrs_CommandFails(t *testing.T) {
+	p := &Provider{}
+
+	_, err := p.Addrs(map[string]string{
+		"provider": "exec",
+		"command":  "exit 1",
+	}, nil)
+	require.Error(t, err)
+}
+
+func TestProvider_Addrs_WrongProvider(t *testing.T) {
+	p := &Provider{}
+
+	_, err := p.Addrs(map[string]string{"provider": "k8s"}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,13 @@
+// +build !windows
+
+package exec
+
+import (
+	"context"
+	"os/exec"
+)
+
+// shellCommand returns a command that runs command through the shell.
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	return exec.CommandContext(ctx, "/bin/sh", "-c", command)
+}
@@ -60,11 +60,16 @@ type dnsConfig struct {
 	OnlyPassing     bool
 	RecursorTimeout time.Duration
 	Recursors       []string
-	SegmentName     string
-	UDPAnswerLimit  int
-	ARecordLimit    int
-	NodeMetaTXT     bool
-	SOAConfig       dnsSOAConfig
+	// RecursorRules maps a FQDN domain to the recursors used for queries
+	// under that domain, taking priority over Recursors. Keys are FQDNs
+	// (trailing dot) so they can be compared directly against dns.Msg
+	// question names.
+	RecursorRules  map[string][]string
+	SegmentName    string
+	UDPAnswerLimit int
+	ARecordLimit   int
+	NodeMetaTXT    bool
+	SOAConfig      dnsSOAConfig
 	// TTLRadix sets service TTLs by prefix, eg: "database-*"
 	TTLRadix *radix.Tree
 	// TTLStict sets TTLs to service by full name match. It Has higher priority than TTLRadix
@@ -82,6 +87,7 @@ type serviceLookup struct {
 	MaxRecursionLevel int
 	Connect           bool
 	Ingress           bool
+	RemoteAddr        net.Addr
 	structs.EnterpriseMeta
 }
 
@@ -170,10 +176,47 @@ func GetDNSConfig(conf *config.RuntimeConfig) (*dnsConfig, error) {
 		}
 		cfg.Recursors = append(cfg.Recursors, ra)
 	}
+	if len(conf.DNSRecursorRules) > 0 {
+		cfg.RecursorRules = make(map[string][]string, len(conf.DNSRecursorRules))
+		for domain, recursors := range conf.DNSRecursorRules {
+			fqdn := dns.Fqdn(strings.ToLower(domain))
+			for _, r := range recursors {
+				ra, err := recursorAddr(r)
+				if err != nil {
+					return nil, fmt.Errorf("Invalid recursor address in rule for domain %q: %v", domain, err)
+				}
+				cfg.RecursorRules[fqdn] = append(cfg.RecursorRules[fqdn], ra)
+			}
+		}
+	}
 
 	return cfg, nil
 }
 
+// recursorsForQuestion returns the recursors to use for the given question
+// name. A RecursorRules entry is used if the name falls under its domain,
+// preferring the most specific (longest) matching domain. Otherwise the
+// default Recursors list is used.
+func (cfg *dnsConfig) recursorsForQuestion(name string) []string {
+	if len(cfg.RecursorRules) == 0 {
+		return cfg.Recursors
+	}
+
+	var best string
+	for domain := range cfg.RecursorRules {
+		if !dns.IsSubDomain(domain, name) {
+			continue
+		}
+		if len(domain) > len(best) {
+			best = domain
+		}
+	}
+	if best == "" {
+		return cfg.Recursors
+	}
+	return cfg.RecursorRules[best]
+}
+
 // GetTTLForService Find the TTL for a given service.
 // return ttl, true if found, 0, false otherwise
 func (cfg *dnsConfig) GetTTLForService(service string) (time.Duration, bool) {
@@ -223,7 +266,7 @@ func (d *DNSServer) ListenAndServe(network, addr string, notif func()) error {
 
 // toggleRecursorHandlerFromConfig enables or disables the recursor handler based on config idempotently
 func (d *DNSServer) toggleRecursorHandlerFromConfig(cfg *dnsConfig) {
-	shouldEnable := len(cfg.Recursors) > 0
+	shouldEnable := len(cfg.Recursors) > 0 || len(cfg.RecursorRules) > 0
 
 	if shouldEnable && atomic.CompareAndSwapUint32(&d.recursorEnabled, 0, 1) {
 		d.mux.HandleFunc(".", d.handleRecurse)
@@ -556,7 +599,10 @@ func (d *DNSServer) nameservers(cfg *dnsConfig, maxRecursionLevel int) (ns []dns
 		}
 		ns = append(ns, nsrr)
 
-		extra = append(extra, d.makeRecordFromNode(o.Node, dns.TypeANY, fqdn, cfg.NodeTTL, maxRecursionLevel)...)
+		// Glue records for the authoritative nameservers themselves aren't
+		// tied to a particular querying client, so no RemoteAddr is
+		// available (or needed) for TranslateAddressRules here.
+		extra = append(extra, d.makeRecordFromNode(o.Node, dns.TypeANY, fqdn, cfg.NodeTTL, maxRecursionLevel, nil)...)
 
 		// don't provide more than 3 servers
 		if len(ns) >= 3 {
@@ -660,6 +706,7 @@ func (d *DNSServer) doDispatch(network string, remoteAddr net.Addr, req, resp *d
 			Connect:           false,
 			Ingress:           false,
 			MaxRecursionLevel: maxRecursionLevel,
+			RemoteAddr:        remoteAddr,
 			EnterpriseMeta:    entMeta,
 		}
 		// Support RFC 2782 style syntax
@@ -709,6 +756,7 @@ func (d *DNSServer) doDispatch(network string, remoteAddr net.Addr, req, resp *d
 			Connect:           true,
 			Ingress:           false,
 			MaxRecursionLevel: maxRecursionLevel,
+			RemoteAddr:        remoteAddr,
 			EnterpriseMeta:    entMeta,
 		}
 		// name.connect.consul
@@ -729,6 +777,7 @@ func (d *DNSServer) doDispatch(network string, remoteAddr net.Addr, req, resp *d
 			Connect:           false,
 			Ingress:           true,
 			MaxRecursionLevel: maxRecursionLevel,
+			RemoteAddr:        remoteAddr,
 			EnterpriseMeta:    entMeta,
 		}
 		// name.ingress.consul
@@ -744,7 +793,7 @@ func (d *DNSServer) doDispatch(network string, remoteAddr net.Addr, req, resp *d
 
 		// Allow a "." in the node name, just join all the parts
 		node := strings.Join(queryParts, ".")
-		d.nodeLookup(cfg, datacenter, node, req, resp, maxRecursionLevel)
+		d.nodeLookup(cfg, datacenter, node, req, resp, maxRecursionLevel, remoteAddr)
 	case "query":
 		// ensure we have a query name
 		if len(queryParts) < 1 {
@@ -830,7 +879,7 @@ func (d *DNSServer) computeRCode(err error) int {
 }
 
 // nodeLookup is used to handle a node query
-func (d *DNSServer) nodeLookup(cfg *dnsConfig, datacenter, node string, req, resp *dns.Msg, maxRecursionLevel int) {
+func (d *DNSServer) nodeLookup(cfg *dnsConfig, datacenter, node string, req, resp *dns.Msg, maxRecursionLevel int, remoteAddr net.Addr) {
 	// Only handle ANY, A, AAAA, and TXT type requests
 	qType := req.Question[0].Qtype
 	if qType != dns.TypeANY && qType != dns.TypeA && qType != dns.TypeAAAA && qType != dns.TypeTXT {
@@ -875,7 +924,7 @@ func (d *DNSServer) nodeLookup(cfg *dnsConfig, datacenter, node string, req, res
 	q := req.Question[0]
 	// Only compute A and CNAME record if query is not TXT type
 	if qType != dns.TypeTXT {
-		records := d.makeRecordFromNode(n, q.Qtype, q.Name, cfg.NodeTTL, maxRecursionLevel)
+		records := d.makeRecordFromNode(n, q.Qtype, q.Name, cfg.NodeTTL, maxRecursionLevel, remoteAddr)
 		resp.Answer = append(resp.Answer, records...)
 	}
 
@@ -1177,7 +1226,10 @@ func (d *DNSServer) lookupServiceNodes(cfg *dnsConfig, lookup serviceLookup) (st
 	// We copy the slice to avoid modifying the result if it comes from the cache
 	nodes := make(structs.CheckServiceNodes, len(out.Nodes))
 	copy(nodes, out.Nodes)
-	out.Nodes = nodes.Filter(cfg.OnlyPassing)
+	// Draining instances are never answered with, regardless of OnlyPassing:
+	// DNS clients have no way to deprioritize an answer, so the only safe
+	// thing to do is stop sending new traffic their way entirely.
+	out.Nodes = nodes.Filter(cfg.OnlyPassing).ExcludeDraining()
 	return out, nil
 }
 
@@ -1210,9 +1262,9 @@ func (d *DNSServer) serviceLookup(cfg *dnsConfig, lookup serviceLookup, req, res
 	// Add various responses depending on the request
 	qType := req.Question[0].Qtype
 	if qType == dns.TypeSRV {
-		d.serviceSRVRecords(cfg, lookup.Datacenter, out.Nodes, req, resp, ttl, lookup.MaxRecursionLevel)
+		d.serviceSRVRecords(cfg, lookup.Datacenter, out.Nodes, req, resp, ttl, lookup.MaxRecursionLevel, lookup.RemoteAddr)
 	} else {
-		d.serviceNodeRecords(cfg, lookup.Datacenter, out.Nodes, req, resp, ttl, lookup.MaxRecursionLevel)
+		d.serviceNodeRecords(cfg, lookup.Datacenter, out.Nodes, req, resp, ttl, lookup.MaxRecursionLevel, lookup.RemoteAddr)
 	}
 
 	d.trimDNSResponse(cfg, lookup.Network, req, resp)
@@ -1329,9 +1381,9 @@ func (d *DNSServer) preparedQueryLookup(cfg *dnsConfig, network, datacenter, que
 	// Add various responses depending on the request.
 	qType := req.Question[0].Qtype
 	if qType == dns.TypeSRV {
-		d.serviceSRVRecords(cfg, out.Datacenter, out.Nodes, req, resp, ttl, maxRecursionLevel)
+		d.serviceSRVRecords(cfg, out.Datacenter, out.Nodes, req, resp, ttl, maxRecursionLevel, remoteAddr)
 	} else {
-		d.serviceNodeRecords(cfg, out.Datacenter, out.Nodes, req, resp, ttl, maxRecursionLevel)
+		d.serviceNodeRecords(cfg, out.Datacenter, out.Nodes, req, resp, ttl, maxRecursionLevel, remoteAddr)
 	}
 
 	d.trimDNSResponse(cfg, network, req, resp)
@@ -1385,7 +1437,7 @@ RPC:
 }
 
 // serviceNodeRecords is used to add the node records for a service lookup
-func (d *DNSServer) serviceNodeRecords(cfg *dnsConfig, dc string, nodes structs.CheckServiceNodes, req, resp *dns.Msg, ttl time.Duration, maxRecursionLevel int) {
+func (d *DNSServer) serviceNodeRecords(cfg *dnsConfig, dc string, nodes structs.CheckServiceNodes, req, resp *dns.Msg, ttl time.Duration, maxRecursionLevel int, remoteAddr net.Addr) {
 	handled := make(map[string]struct{})
 	var answerCNAME []dns.RR = nil
 
@@ -1393,7 +1445,7 @@ func (d *DNSServer) serviceNodeRecords(cfg *dnsConfig, dc string, nodes structs.
 	for _, node := range nodes {
 		// Add the node record
 		had_answer := false
-		records, _ := d.nodeServiceRecords(dc, node, req, ttl, cfg, maxRecursionLevel)
+		records, _ := d.nodeServiceRecords(dc, node, req, ttl, cfg, maxRecursionLevel, remoteAddr)
 		if len(records) == 0 {
 			continue
 		}
@@ -1519,7 +1571,7 @@ func makeARecord(qType uint16, ip net.IP, ttl time.Duration) dns.RR {
 // Craft dns records for a node
 // In case of an SRV query the answer will be a IN SRV and additional data will store an IN A to the node IP
 // Otherwise it will return a IN A record
-func (d *DNSServer) makeRecordFromNode(node *structs.Node, qType uint16, qName string, ttl time.Duration, maxRecursionLevel int) []dns.RR {
+func (d *DNSServer) makeRecordFromNode(node *structs.Node, qType uint16, qName string, ttl time.Duration, maxRecursionLevel int, remoteAddr net.Addr) []dns.RR {
 	addrTranslate := TranslateAddressAcceptDomain
 	if qType == dns.TypeA {
 		addrTranslate |= TranslateAddressAcceptIPv4
@@ -1529,7 +1581,7 @@ func (d *DNSServer) makeRecordFromNode(node *structs.Node, qType uint16, qName s
 		addrTranslate |= TranslateAddressAcceptAny
 	}
 
-	addr := d.agent.TranslateAddress(node.Datacenter, node.Address, node.TaggedAddresses, addrTranslate)
+	addr := d.agent.TranslateAddress(node.Datacenter, node.Address, node.TaggedAddresses, addrTranslate, remoteAddrIP(remoteAddr))
 	ip := net.ParseIP(addr)
 
 	var res []dns.RR
@@ -1689,18 +1741,62 @@ MORE_REC:
 	return answers, nil
 }
 
-func (d *DNSServer) nodeServiceRecords(dc string, node structs.CheckServiceNode, req *dns.Msg, ttl time.Duration, cfg *dnsConfig, maxRecursionLevel int) ([]dns.RR, []dns.RR) {
+// dualStackServiceRecords answers a generic (non-A/AAAA-specific) query for
+// a service whose DNSAddressPolicy is "both" with a single answer
+// containing one AAAA and one A record, so dual-stack clients doing
+// happy-eyeballs-style resolution see both families at once. The AAAA
+// record is listed first, the conventional ordering for clients that race
+// an IPv6 attempt ahead of IPv4. It only fires when the service (or
+// failing that, its node) has both a resolvable IPv4 and IPv6 address;
+// anything else - a single family, an external FQDN, or an A/AAAA/SRV
+// query that already pins down what's wanted - falls through to the
+// regular single-address path.
+func (d *DNSServer) dualStackServiceRecords(dc string, node structs.CheckServiceNode, req *dns.Msg, ttl time.Duration, remoteAddr net.Addr) (answers, extra []dns.RR, handled bool) {
+	q := req.Question[0]
+	if q.Qtype != dns.TypeANY || node.Service.DNSAddressPolicy != structs.DNSAddressPolicyBoth {
+		return nil, nil, false
+	}
+
+	remoteIP := remoteAddrIP(remoteAddr)
+	v4 := net.ParseIP(d.agent.TranslateServiceAddress(dc, node.Service.Address, node.Service.TaggedAddresses, TranslateAddressAcceptIPv4, remoteIP))
+	v6 := net.ParseIP(d.agent.TranslateServiceAddress(dc, node.Service.Address, node.Service.TaggedAddresses, TranslateAddressAcceptIPv6, remoteIP))
+	if v4 == nil && v6 == nil {
+		v4 = net.ParseIP(d.agent.TranslateAddress(node.Node.Datacenter, node.Node.Address, node.Node.TaggedAddresses, TranslateAddressAcceptIPv4, remoteIP))
+		v6 = net.ParseIP(d.agent.TranslateAddress(node.Node.Datacenter, node.Node.Address, node.Node.TaggedAddresses, TranslateAddressAcceptIPv6, remoteIP))
+	}
+	if v4 == nil || v6 == nil {
+		return nil, nil, false
+	}
+
+	aaaaRecord := makeARecord(dns.TypeAAAA, v6, ttl)
+	aRecord := makeARecord(dns.TypeA, v4, ttl)
+	aaaaRecord.Header().Name = q.Name
+	aRecord.Header().Name = q.Name
+	return []dns.RR{aaaaRecord, aRecord}, nil, true
+}
+
+func (d *DNSServer) nodeServiceRecords(dc string, node structs.CheckServiceNode, req *dns.Msg, ttl time.Duration, cfg *dnsConfig, maxRecursionLevel int, remoteAddr net.Addr) ([]dns.RR, []dns.RR) {
+	if answers, extra, handled := d.dualStackServiceRecords(dc, node, req, ttl, remoteAddr); handled {
+		return answers, extra
+	}
+
 	addrTranslate := TranslateAddressAcceptDomain
-	if req.Question[0].Qtype == dns.TypeA {
+	switch {
+	case req.Question[0].Qtype == dns.TypeA:
 		addrTranslate |= TranslateAddressAcceptIPv4
-	} else if req.Question[0].Qtype == dns.TypeAAAA {
+	case req.Question[0].Qtype == dns.TypeAAAA:
 		addrTranslate |= TranslateAddressAcceptIPv6
-	} else {
+	case node.Service.DNSAddressPolicy == structs.DNSAddressPolicyPreferIPv6:
+		addrTranslate |= TranslateAddressAcceptIPv6 | TranslateAddressAcceptAny
+	case node.Service.DNSAddressPolicy == structs.DNSAddressPolicyPreferIPv4:
+		addrTranslate |= TranslateAddressAcceptIPv4 | TranslateAddressAcceptAny
+	default:
 		addrTranslate |= TranslateAddressAcceptAny
 	}
 
-	serviceAddr := d.agent.TranslateServiceAddress(dc, node.Service.Address, node.Service.TaggedAddresses, addrTranslate)
-	nodeAddr := d.agent.TranslateAddress(node.Node.Datacenter, node.Node.Address, node.Node.TaggedAddresses, addrTranslate)
+	remoteIP := remoteAddrIP(remoteAddr)
+	serviceAddr := d.agent.TranslateServiceAddress(dc, node.Service.Address, node.Service.TaggedAddresses, addrTranslate, remoteIP)
+	nodeAddr := d.agent.TranslateAddress(node.Node.Datacenter, node.Node.Address, node.Node.TaggedAddresses, addrTranslate, remoteIP)
 	if serviceAddr == "" && nodeAddr == "" {
 		return nil, nil
 	}
@@ -1760,13 +1856,13 @@ func (d *DNSServer) generateMeta(qName string, node *structs.Node, ttl time.Dura
 }
 
 // serviceARecords is used to add the SRV records for a service lookup
-func (d *DNSServer) serviceSRVRecords(cfg *dnsConfig, dc string, nodes structs.CheckServiceNodes, req, resp *dns.Msg, ttl time.Duration, maxRecursionLevel int) {
+func (d *DNSServer) serviceSRVRecords(cfg *dnsConfig, dc string, nodes structs.CheckServiceNodes, req, resp *dns.Msg, ttl time.Duration, maxRecursionLevel int, remoteAddr net.Addr) {
 	handled := make(map[string]struct{})
 
 	for _, node := range nodes {
 		// Avoid duplicate entries, possible if a node has
 		// the same service the same port, etc.
-		serviceAddress := d.agent.TranslateServiceAddress(dc, node.Service.Address, node.Service.TaggedAddresses, TranslateAddressAcceptAny)
+		serviceAddress := d.agent.TranslateServiceAddress(dc, node.Service.Address, node.Service.TaggedAddresses, TranslateAddressAcceptAny, remoteAddrIP(remoteAddr))
 		servicePort := d.agent.TranslateServicePort(dc, node.Service.Port, node.Service.TaggedAddresses)
 		tuple := fmt.Sprintf("%s:%s:%d", node.Node.Node, serviceAddress, servicePort)
 		if _, ok := handled[tuple]; ok {
@@ -1774,7 +1870,7 @@ func (d *DNSServer) serviceSRVRecords(cfg *dnsConfig, dc string, nodes structs.C
 		}
 		handled[tuple] = struct{}{}
 
-		answers, extra := d.nodeServiceRecords(dc, node, req, ttl, cfg, maxRecursionLevel)
+		answers, extra := d.nodeServiceRecords(dc, node, req, ttl, cfg, maxRecursionLevel, remoteAddr)
 
 		resp.Answer = append(resp.Answer, answers...)
 		resp.Extra = append(resp.Extra, extra...)
@@ -1811,7 +1907,7 @@ func (d *DNSServer) handleRecurse(resp dns.ResponseWriter, req *dns.Msg) {
 	var r *dns.Msg
 	var rtt time.Duration
 	var err error
-	for _, recursor := range cfg.Recursors {
+	for _, recursor := range cfg.recursorsForQuestion(q.Name) {
 		r, rtt, err = c.Exchange(req, recursor)
 		// Check if the response is valid and has the desired Response code
 		if r != nil && (r.Rcode != dns.RcodeSuccess && r.Rcode != dns.RcodeNameError) {
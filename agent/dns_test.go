@@ -168,7 +168,7 @@ func TestDNS_Over_TCP(t *testing.T) {
 		Address:    "127.0.0.1",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -222,7 +222,7 @@ func TestDNS_NodeLookup(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -311,7 +311,7 @@ func TestDNS_CaseInsensitiveNodeLookup(t *testing.T) {
 		Address:    "127.0.0.1",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -343,7 +343,7 @@ func TestDNS_NodeLookup_PeriodName(t *testing.T) {
 		Address:    "127.0.0.1",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -383,7 +383,7 @@ func TestDNS_NodeLookup_AAAA(t *testing.T) {
 		Address:    "::4242:4242",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -500,7 +500,7 @@ func TestDNS_NodeLookup_CNAME(t *testing.T) {
 		Address:    "www.google.com",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -550,7 +550,7 @@ func TestDNS_NodeLookup_TXT(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -596,7 +596,7 @@ func TestDNS_NodeLookup_TXT_DontSuppress(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -641,7 +641,7 @@ func TestDNS_NodeLookup_ANY(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -682,7 +682,7 @@ func TestDNS_NodeLookup_ANY_DontSuppressTXT(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -723,7 +723,7 @@ func TestDNS_NodeLookup_A_SuppressTXT(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 	m := new(dns.Msg)
@@ -758,7 +758,7 @@ func TestDNS_EDNS0(t *testing.T) {
 		Address:    "127.0.0.2",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -804,7 +804,7 @@ func TestDNS_EDNS0_ECS(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.NoError(t, a.RPC("Catalog.Register", args, &out))
 	}
 
@@ -890,7 +890,7 @@ func TestDNS_ReverseLookup(t *testing.T) {
 		Address:    "127.0.0.2",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -932,7 +932,7 @@ func TestDNS_ReverseLookup_CustomDomain(t *testing.T) {
 		Address:    "127.0.0.2",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -972,7 +972,7 @@ func TestDNS_ReverseLookup_IPV6(t *testing.T) {
 		Address:    "::4242:4242",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -1019,7 +1019,7 @@ func TestDNS_ServiceReverseLookup(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -1067,7 +1067,7 @@ func TestDNS_ServiceReverseLookup_IPV6(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -1117,7 +1117,7 @@ func TestDNS_ServiceReverseLookup_CustomDomain(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -1198,7 +1198,7 @@ func TestDNS_ServiceReverseLookupNodeAddress(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -1245,7 +1245,7 @@ func TestDNS_ServiceLookupNoMultiCNAME(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.NoError(t, a.RPC("Catalog.Register", args, &out))
 	}
 
@@ -1262,7 +1262,7 @@ func TestDNS_ServiceLookupNoMultiCNAME(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -1300,7 +1300,7 @@ func TestDNS_ServiceLookupPreferNoCNAME(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.NoError(t, a.RPC("Catalog.Register", args, &out))
 	}
 
@@ -1317,7 +1317,7 @@ func TestDNS_ServiceLookupPreferNoCNAME(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -1358,7 +1358,7 @@ func TestDNS_ServiceLookupMultiAddrNoCNAME(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.NoError(t, a.RPC("Catalog.Register", args, &out))
 	}
 
@@ -1375,7 +1375,7 @@ func TestDNS_ServiceLookupMultiAddrNoCNAME(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -1394,7 +1394,7 @@ func TestDNS_ServiceLookupMultiAddrNoCNAME(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -1432,7 +1432,7 @@ func TestDNS_ServiceLookup(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -1557,7 +1557,7 @@ func TestDNS_ServiceLookupWithInternalServiceAddress(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -1605,7 +1605,7 @@ func TestDNS_ConnectServiceLookup(t *testing.T) {
 		args.Service.Proxy.DestinationServiceName = "db"
 		args.Service.Address = ""
 		args.Service.Port = 12345
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.Nil(t, a.RPC("Catalog.Register", args, &out))
 	}
 
@@ -1646,7 +1646,7 @@ func TestDNS_IngressServiceLookup(t *testing.T) {
 	// Register ingress-gateway service
 	{
 		args := structs.TestRegisterIngressGateway(t)
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.Nil(t, a.RPC("Catalog.Register", args, &out))
 	}
 
@@ -1663,7 +1663,7 @@ func TestDNS_IngressServiceLookup(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.Nil(t, a.RPC("Catalog.Register", args, &out))
 	}
 
@@ -1757,7 +1757,7 @@ func TestDNS_ExternalServiceLookup(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -1820,7 +1820,7 @@ func TestDNS_InifiniteRecursion(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -1873,7 +1873,7 @@ func TestDNS_ExternalServiceToConsulCNAMELookup(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -1891,7 +1891,7 @@ func TestDNS_ExternalServiceToConsulCNAMELookup(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -2043,7 +2043,7 @@ func TestDNS_ExternalServiceToConsulCNAMENestedLookup(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -2061,7 +2061,7 @@ func TestDNS_ExternalServiceToConsulCNAMENestedLookup(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -2079,7 +2079,7 @@ func TestDNS_ExternalServiceToConsulCNAMENestedLookup(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -2170,7 +2170,7 @@ func TestDNS_ServiceLookup_ServiceAddress_A(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -2273,7 +2273,7 @@ func TestDNS_ServiceLookup_ServiceAddress_SRV(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -2370,7 +2370,7 @@ func TestDNS_ServiceLookup_ServiceAddressIPV6(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -2602,7 +2602,7 @@ func TestDNS_ServiceLookup_WanTranslation(t *testing.T) {
 					},
 				}
 
-				var out struct{}
+				var out structs.WriteIndexResponse
 				require.NoError(t, a2.RPC("Catalog.Register", args, &out))
 			})
 
@@ -2755,7 +2755,7 @@ func TestDNS_Lookup_TaggedIPAddresses(t *testing.T) {
 				},
 			}
 
-			var out struct{}
+			var out structs.WriteIndexResponse
 			require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 			// Look up the SRV record via service and prepared query.
@@ -2841,6 +2841,56 @@ func TestDNS_Lookup_TaggedIPAddresses(t *testing.T) {
 	}
 }
 
+func TestDNS_Lookup_DNSAddressPolicy(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	args := &structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       "foo",
+		Address:    "127.0.0.1",
+		Service: &structs.NodeService{
+			Service: "db",
+			Address: "127.0.0.1",
+			Port:    8080,
+			TaggedAddresses: map[string]structs.ServiceAddress{
+				structs.TaggedAddressLANIPv6: {Address: "::1"},
+			},
+			DNSAddressPolicy: structs.DNSAddressPolicyBoth,
+		},
+	}
+
+	var out structs.WriteIndexResponse
+	require.NoError(t, a.RPC("Catalog.Register", args, &out))
+
+	m := new(dns.Msg)
+	m.SetQuestion("db.service.consul.", dns.TypeANY)
+
+	c := new(dns.Client)
+	in, _, err := c.Exchange(m, a.config.DNSAddrs[0].String())
+	require.NoError(t, err)
+	require.Len(t, in.Answer, 2)
+
+	aaaaRec, ok := in.Answer[0].(*dns.AAAA)
+	require.True(t, ok, "Bad: %#v", in.Answer[0])
+	require.Equal(t, "::1", aaaaRec.AAAA.String())
+
+	aRec, ok := in.Answer[1].(*dns.A)
+	require.True(t, ok, "Bad: %#v", in.Answer[1])
+	require.Equal(t, "127.0.0.1", aRec.A.String())
+
+	// A/AAAA-specific queries still only return the matching family.
+	m = new(dns.Msg)
+	m.SetQuestion("db.service.consul.", dns.TypeA)
+	in, _, err = c.Exchange(m, a.config.DNSAddrs[0].String())
+	require.NoError(t, err)
+	require.Len(t, in.Answer, 1)
+	_, ok = in.Answer[0].(*dns.A)
+	require.True(t, ok, "Bad: %#v", in.Answer[0])
+}
+
 func TestDNS_CaseInsensitiveServiceLookup(t *testing.T) {
 	t.Parallel()
 	a := NewTestAgent(t, "")
@@ -2860,7 +2910,7 @@ func TestDNS_CaseInsensitiveServiceLookup(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -2930,7 +2980,7 @@ func TestDNS_ServiceLookup_TagPeriod(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -3015,7 +3065,7 @@ func TestDNS_PreparedQueryNearIPEDNS(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		err := a.RPC("Catalog.Register", args, &out)
 		require.NoError(t, err)
 
@@ -3041,7 +3091,7 @@ func TestDNS_PreparedQueryNearIPEDNS(t *testing.T) {
 			Address:    "198.18.0.9",
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		err := a.RPC("Catalog.Register", args, &out)
 		require.NoError(t, err)
 
@@ -3142,7 +3192,7 @@ func TestDNS_PreparedQueryNearIP(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		err := a.RPC("Catalog.Register", args, &out)
 		require.NoError(t, err)
 
@@ -3168,7 +3218,7 @@ func TestDNS_PreparedQueryNearIP(t *testing.T) {
 			Address:    "198.18.0.9",
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		err := a.RPC("Catalog.Register", args, &out)
 		require.NoError(t, err)
 
@@ -3245,7 +3295,7 @@ func TestDNS_ServiceLookup_PreparedQueryNamePeriod(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -3325,7 +3375,7 @@ func TestDNS_ServiceLookup_Dedup(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -3428,7 +3478,7 @@ func TestDNS_ServiceLookup_Dedup_SRV(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -3604,6 +3654,58 @@ func TestDNS_Recurse_Truncation(t *testing.T) {
 	}
 }
 
+func TestDNS_Recurse_DomainRule(t *testing.T) {
+	t.Parallel()
+
+	defaultRecursor := makeRecursor(t, dns.Msg{
+		Answer: []dns.RR{dnsA("apple.com", "1.2.3.4")},
+	})
+	defer defaultRecursor.Shutdown()
+
+	corpRecursor := makeRecursor(t, dns.Msg{
+		Answer: []dns.RR{dnsA("host.corp.internal", "10.0.0.9")},
+	})
+	defer corpRecursor.Shutdown()
+
+	a := NewTestAgent(t, `
+		recursors = ["`+defaultRecursor.Addr+`"]
+		dns_config {
+			recursor_rules = [
+				{
+					domain = "corp.internal"
+					recursors = ["`+corpRecursor.Addr+`"]
+				}
+			]
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	c := new(dns.Client)
+
+	// A query under corp.internal should be resolved by the scoped recursor.
+	m := new(dns.Msg)
+	m.SetQuestion("host.corp.internal.", dns.TypeANY)
+	in, _, err := c.Exchange(m, a.DNSAddr())
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if len(in.Answer) != 1 || in.Answer[0].(*dns.A).A.String() != "10.0.0.9" {
+		t.Fatalf("bad: %#v", in)
+	}
+
+	// A query outside corp.internal should fall back to the default recursor.
+	m = new(dns.Msg)
+	m.SetQuestion("apple.com.", dns.TypeANY)
+	in, _, err = c.Exchange(m, a.DNSAddr())
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if len(in.Answer) != 1 || in.Answer[0].(*dns.A).A.String() != "1.2.3.4" {
+		t.Fatalf("bad: %#v", in)
+	}
+}
+
 func TestDNS_RecursorTimeout(t *testing.T) {
 	t.Parallel()
 	serverClientTimeout := 3 * time.Second
@@ -3681,7 +3783,7 @@ func TestDNS_ServiceLookup_FilterCritical(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -3837,7 +3939,7 @@ func TestDNS_ServiceLookup_OnlyFailing(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -3955,7 +4057,7 @@ func TestDNS_ServiceLookup_OnlyPassing(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -4087,7 +4189,7 @@ func TestDNS_ServiceLookup_Randomize(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -4224,7 +4326,7 @@ func TestDNS_TCP_and_UDP_Truncate(t *testing.T) {
 				},
 			}
 
-			var out struct{}
+			var out structs.WriteIndexResponse
 			if err := a.RPC("Catalog.Register", args, &out); err != nil {
 				t.Fatalf("err: %v", err)
 			}
@@ -4327,7 +4429,7 @@ func TestDNS_ServiceLookup_Truncate(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -4399,7 +4501,7 @@ func TestDNS_ServiceLookup_LargeResponses(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -4506,7 +4608,7 @@ func testDNSServiceLookupResponseLimits(t *testing.T, answerLimit int, qType uin
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			return false, fmt.Errorf("err: %v", err)
 		}
@@ -4596,7 +4698,7 @@ func checkDNSService(t *testing.T, generateNumNodes int, aRecordLimit int, qType
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			return fmt.Errorf("err: %v", err)
 		}
@@ -4822,7 +4924,7 @@ func TestDNS_ServiceLookup_CNAME(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -4920,7 +5022,7 @@ func TestDNS_ServiceLookup_ServiceAddress_CNAME(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -5017,7 +5119,7 @@ func TestDNS_NodeLookup_TTL(t *testing.T) {
 		Address:    "127.0.0.1",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -5145,7 +5247,7 @@ func TestDNS_ServiceLookup_TTL(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -5224,7 +5326,7 @@ func TestDNS_PreparedQuery_TTL(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -5359,7 +5461,7 @@ func TestDNS_PreparedQuery_Failover(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a2.RPC("Catalog.Register", args, &out); err != nil {
 			r.Fatalf("err: %v", err)
 		}
@@ -5443,7 +5545,7 @@ func TestDNS_ServiceLookup_SRV_RFC(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -5518,7 +5620,7 @@ func TestDNS_ServiceLookup_SRV_RFC_TCP_Default(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -5607,7 +5709,7 @@ func TestDNS_ServiceLookup_FilterACL(t *testing.T) {
 				},
 				WriteRequest: structs.WriteRequest{Token: "root"},
 			}
-			var out struct{}
+			var out structs.WriteIndexResponse
 			if err := a.RPC("Catalog.Register", args, &out); err != nil {
 				t.Fatalf("err: %v", err)
 			}
@@ -5646,7 +5748,7 @@ func TestDNS_ServiceLookup_MetaTXT(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -5693,7 +5795,7 @@ func TestDNS_ServiceLookup_SuppressTXT(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -5898,7 +6000,7 @@ func TestDNS_NonExistingLookupEmptyAorAAAA(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -6040,7 +6142,7 @@ func TestDNS_AltDomains_Service(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -6794,7 +6896,7 @@ func TestDNS_Compression_Query(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -6874,7 +6976,7 @@ func TestDNS_Compression_ReverseLookup(t *testing.T) {
 		Node:       "foo2",
 		Address:    "127.0.0.2",
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
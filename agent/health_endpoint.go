@@ -159,6 +159,30 @@ RETRY_ONCE:
 	return out.HealthChecks, nil
 }
 
+// HealthSummary returns, per service, counts of passing/warning/critical
+// instances and the max index, without the full per-instance payloads that
+// /v1/health/service/<service> returns.
+func (s *HTTPHandlers) HealthSummary(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DCSpecificRequest
+	if err := s.parseEntMetaNoWildcard(req, &args.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var out structs.IndexedServiceHealthSummaries
+	defer setMeta(resp, &out.QueryMeta)
+	if err := s.agent.RPC("Health.Summary", &args, &out); err != nil {
+		return nil, err
+	}
+
+	if out.Summaries == nil {
+		out.Summaries = make([]*structs.ServiceHealthSummary, 0)
+	}
+	return out.Summaries, nil
+}
+
 // HealthIngressServiceNodes should return "all the healthy ingress gateway instances
 // that I can use to access this connect-enabled service without mTLS".
 func (s *HTTPHandlers) HealthIngressServiceNodes(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
@@ -197,6 +221,14 @@ func (s *HTTPHandlers) healthServiceNodes(resp http.ResponseWriter, req *http.Re
 		args.TagFilter = true
 	}
 
+	if mergeNodeMeta, err := getBoolQueryParam(params, "merge-node-meta"); err == nil {
+		args.MergeNodeMeta = mergeNodeMeta
+	} else {
+		resp.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(resp, "Invalid value for ?merge-node-meta")
+		return nil, nil
+	}
+
 	// Determine the prefix
 	var prefix string
 	switch healthType {
@@ -248,7 +280,7 @@ func (s *HTTPHandlers) healthServiceNodes(resp http.ResponseWriter, req *http.Re
 	}
 
 	// Translate addresses after filtering so we don't waste effort.
-	s.agent.TranslateAddresses(args.Datacenter, out.Nodes, TranslateAddressAcceptAny)
+	s.agent.TranslateAddresses(args.Datacenter, out.Nodes, TranslateAddressAcceptAny, s.remoteIP(req))
 
 	// Use empty list instead of nil
 	if out.Nodes == nil {
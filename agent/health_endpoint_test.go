@@ -84,7 +84,7 @@ func TestHealthChecksInState_NodeMetaFilter(t *testing.T) {
 			Status: api.HealthCritical,
 		},
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -124,7 +124,7 @@ func TestHealthChecksInState_Filter(t *testing.T) {
 			Status: api.HealthCritical,
 		},
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 	args = &structs.RegisterRequest{
@@ -170,7 +170,7 @@ func TestHealthChecksInState_DistanceSort(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -280,7 +280,7 @@ func TestHealthNodeChecks_Filtering(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 	// Create a second check
@@ -340,7 +340,7 @@ func TestHealthServiceChecks(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err = a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -396,7 +396,7 @@ func TestHealthServiceChecks_NodeMetaFilter(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err = a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -445,7 +445,7 @@ func TestHealthServiceChecks_Filtering(t *testing.T) {
 		SkipNodeUpdate: true,
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 	// Create a new node, service and check
@@ -499,7 +499,7 @@ func TestHealthServiceChecks_DistanceSort(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -606,7 +606,7 @@ func TestHealthServiceNodes(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -715,7 +715,7 @@ func TestHealthServiceNodes_NodeMetaFilter(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -764,7 +764,7 @@ func TestHealthServiceNodes_Filter(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 	// Create a new node, service and check
@@ -819,7 +819,7 @@ func TestHealthServiceNodes_DistanceSort(t *testing.T) {
 		},
 	}
 	testrpc.WaitForLeader(t, a.RPC, dc)
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -897,7 +897,7 @@ func TestHealthServiceNodes_PassingFilter(t *testing.T) {
 	}
 
 	retry.Run(t, func(r *retry.R) {
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			r.Fatalf("err: %v", err)
 		}
@@ -1005,7 +1005,7 @@ func TestHealthServiceNodes_CheckType(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 	req, _ = http.NewRequest("GET", "/v1/health/service/consul?dc=dc1", nil)
@@ -1073,7 +1073,7 @@ func TestHealthServiceNodes_WanTranslation(t *testing.T) {
 			},
 		}
 
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.NoError(t, a2.RPC("Catalog.Register", args, &out))
 	}
 
@@ -1122,7 +1122,7 @@ func TestHealthConnectServiceNodes(t *testing.T) {
 
 	// Register
 	args := structs.TestRegisterRequestProxy(t)
-	var out struct{}
+	var out structs.WriteIndexResponse
 	assert.Nil(a.RPC("Catalog.Register", args, &out))
 
 	// Request
@@ -1149,7 +1149,7 @@ func TestHealthIngressServiceNodes(t *testing.T) {
 	// Register gateway
 	gatewayArgs := structs.TestRegisterIngressGateway(t)
 	gatewayArgs.Service.Address = "127.0.0.27"
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", gatewayArgs, &out))
 
 	args := structs.TestRegisterRequest(t)
@@ -1219,7 +1219,7 @@ func TestHealthConnectServiceNodes_Filter(t *testing.T) {
 	// Register
 	args := structs.TestRegisterRequestProxy(t)
 	args.Service.Address = "127.0.0.55"
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 	args = structs.TestRegisterRequestProxy(t)
@@ -1261,7 +1261,7 @@ func TestHealthConnectServiceNodes_PassingFilter(t *testing.T) {
 		ServiceID: args.Service.Service,
 		Status:    api.HealthCritical,
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	assert.Nil(t, a.RPC("Catalog.Register", args, &out))
 
 	t.Run("bc_no_query_value", func(t *testing.T) {
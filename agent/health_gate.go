@@ -0,0 +1,77 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+
+	cachetype "github.com/hashicorp/consul/agent/cache-types"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+// checkMinHealthyInstances enforces any MinHealthyInstances guard set by a
+// service-defaults config entry for sid's service. It returns an error if
+// the service is at or below that threshold, since the caller is about to
+// deregister the instance or otherwise take it out of service (e.g. entering
+// maintenance mode). force bypasses the guard entirely, for operators who
+// know a rolling deploy's replacement is already on its way.
+func (a *Agent) checkMinHealthyInstances(sid structs.ServiceID, token string, force bool) error {
+	if force {
+		return nil
+	}
+
+	ns := a.State.Service(sid)
+	if ns == nil {
+		// Nothing registered to guard.
+		return nil
+	}
+
+	req := makeConfigRequestForService(a, ns, token)
+	raw, _, err := a.cache.Get(context.Background(), cachetype.ResolvedServiceConfigName, req)
+	if err != nil {
+		return fmt.Errorf("could not resolve service_defaults config for service %q: %v", ns.Service, err)
+	}
+	defaults, ok := raw.(*structs.ServiceConfigResponse)
+	if !ok {
+		return fmt.Errorf("internal error: response type not correct")
+	}
+	if defaults.MinHealthyInstances <= 0 {
+		return nil
+	}
+
+	out, _, err := a.rpcClientHealth.ServiceNodes(context.Background(), structs.ServiceSpecificRequest{
+		Datacenter:  a.config.Datacenter,
+		ServiceName: ns.Service,
+	})
+	if err != nil {
+		return fmt.Errorf("could not determine current healthy instance count for service %q: %v", ns.Service, err)
+	}
+
+	passing := 0
+	for _, node := range out.Nodes {
+		if node.Node.Node == a.config.NodeName && node.Service.ID == ns.ID {
+			// This is the instance being removed/marked unhealthy; its
+			// current state is about to change so it doesn't count either
+			// way.
+			continue
+		}
+
+		instancePassing := true
+		for _, chk := range node.Checks {
+			if chk.Status != api.HealthPassing {
+				instancePassing = false
+				break
+			}
+		}
+		if instancePassing {
+			passing++
+		}
+	}
+
+	if passing < defaults.MinHealthyInstances {
+		return fmt.Errorf(
+			"rejecting request: only %d healthy instance(s) of %q would remain, below the configured minimum of %d; pass ?force=true to override",
+			passing, ns.Service, defaults.MinHealthyInstances)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package agent
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -85,6 +86,7 @@ type HTTPHandlers struct {
 	configReloaders []ConfigReloader
 	h               http.Handler
 	metricsProxyCfg atomic.Value
+	idempotency     *idempotencyCache
 }
 
 // endpoint is a Consul-specific HTTP handler that takes the usual arguments in
@@ -210,7 +212,7 @@ func (s *HTTPHandlers) handler(enableDebug bool) http.Handler {
 
 		var gzipHandler http.Handler
 		minSize := gziphandler.DefaultMinSize
-		if pattern == "/v1/agent/monitor" {
+		if pattern == "/v1/agent/monitor" || pattern == "/v1/agent/subscribe" {
 			minSize = 0
 		}
 		gzipWrapper, err := gziphandler.GzipHandlerWithOpts(gziphandler.MinSize(minSize))
@@ -343,7 +345,8 @@ func (s *HTTPHandlers) nodeName() string {
 // results in:
 //
 // /v1/acl/clone/foo?token=bar -> /v1/acl/clone/<hidden>?token=bar
-//                                ^---- $1 ----^^- $2 -^^-- $3 --^
+//
+//	^---- $1 ----^^- $2 -^^-- $3 --^
 //
 // And then the loop that looks for parameters called "token" does the last
 // step to get to the final redacted form.
@@ -488,6 +491,44 @@ func (s *HTTPHandlers) wrap(handler endpoint, methods []string) http.HandlerFunc
 			}
 		}
 
+		// A client-supplied idempotency key lets a retried write replay the
+		// original response instead of re-applying the side effect.
+		idempotencyKey := ""
+		var idempotencyToken string
+		var idempotencyBodyHash string
+		if isIdempotentReplayable(req.Method) {
+			if key := req.Header.Get(IdempotencyKeyHeader); key != "" {
+				var body []byte
+				if req.Body != nil {
+					var err error
+					body, err = io.ReadAll(req.Body)
+					if err != nil {
+						handleErr(err)
+						return
+					}
+					req.Body.Close()
+				}
+				req.Body = io.NopCloser(bytes.NewReader(body))
+
+				idempotencyKey = key
+				idempotencyBodyHash = hashIdempotencyBody(body)
+				s.parseTokenInternal(req, &idempotencyToken)
+				if entry, ok := s.idempotency.get(idempotencyToken, idempotencyKey); ok &&
+					entry.method == req.Method && entry.path == req.URL.Path {
+					if entry.bodyHash != idempotencyBodyHash {
+						resp.WriteHeader(http.StatusConflict)
+						fmt.Fprintf(resp, "Idempotency key %q was already used with a different request body", idempotencyKey)
+						return
+					}
+					resp.Header().Set("Content-Type", entry.contentType)
+					resp.Header().Set("X-Consul-Idempotency-Replayed", "true")
+					resp.WriteHeader(entry.statusCode)
+					resp.Write(entry.body)
+					return
+				}
+			}
+		}
+
 		if !methodFound {
 			err = MethodNotAllowedError{req.Method, append([]string{"OPTIONS"}, methods...)}
 		} else {
@@ -514,7 +555,22 @@ func (s *HTTPHandlers) wrap(handler endpoint, methods []string) http.HandlerFunc
 				return
 			}
 		}
+		remember := func(body []byte) {
+			if idempotencyKey == "" || httpCode >= 400 {
+				return
+			}
+			s.idempotency.put(idempotencyToken, idempotencyKey, &idempotencyEntry{
+				method:      req.Method,
+				path:        req.URL.Path,
+				bodyHash:    idempotencyBodyHash,
+				statusCode:  httpCode,
+				contentType: contentType,
+				body:        body,
+			})
+		}
+
 		if obj == nil {
+			remember(nil)
 			return
 		}
 		var buf []byte
@@ -531,6 +587,7 @@ func (s *HTTPHandlers) wrap(handler endpoint, methods []string) http.HandlerFunc
 				}
 			}
 		}
+		remember(buf)
 		resp.Header().Set("Content-Type", contentType)
 		resp.WriteHeader(httpCode)
 		resp.Write(buf)
@@ -1028,6 +1085,17 @@ func (s *HTTPHandlers) parse(resp http.ResponseWriter, req *http.Request, dc *st
 	return s.parseInternal(resp, req, dc, b)
 }
 
+// remoteIP returns the IP of the client making req, for evaluating
+// TranslateAddressRules. It returns nil if the remote address can't be
+// parsed, e.g. in tests that set a bogus RemoteAddr.
+func (s *HTTPHandlers) remoteIP(req *http.Request) net.IP {
+	ipStr, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(ipStr)
+}
+
 func (s *HTTPHandlers) checkWriteAccess(req *http.Request) error {
 	if req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions {
 		return nil
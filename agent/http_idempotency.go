@@ -0,0 +1,121 @@
+package agent
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// IdempotencyKeyHeader is the HTTP header clients may set on mutating
+// requests so that a retried request replays the original response
+// instead of re-applying the write. Keys are only remembered per ACL
+// token, so two tokens may safely reuse the same key.
+const IdempotencyKeyHeader = "X-Consul-Idempotency-Key"
+
+const (
+	// idempotencyCacheTokens bounds the number of distinct tokens tracked
+	// at once; the least recently used token's keys are evicted first.
+	idempotencyCacheTokens = 512
+
+	// idempotencyCacheKeysPerToken bounds how many keys are remembered per
+	// token so a single token can't grow the cache without bound.
+	idempotencyCacheKeysPerToken = 128
+)
+
+// idempotencyEntry is the replayed HTTP response for a previously seen
+// idempotency key.
+type idempotencyEntry struct {
+	method      string
+	path        string
+	bodyHash    string
+	statusCode  int
+	contentType string
+	body        []byte
+}
+
+// hashIdempotencyBody returns a digest of a request body for comparison
+// against the body an idempotency key was first used with, so that reusing
+// a key with a different body can be detected instead of silently
+// replaying the response to the original one.
+func hashIdempotencyBody(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// idempotencyCache remembers the result of recent mutating requests, keyed
+// by ACL token and the client-supplied idempotency key, so that
+// network-flaky retries of registration, KV, and config entry writes don't
+// double-apply. It's local to this agent, not replicated, so a retry that
+// lands on a different server still re-applies; the common case of a
+// client retrying against the same agent is what this protects.
+type idempotencyCache struct {
+	lock   sync.Mutex
+	tokens *lru.Cache // token (string) -> *lru.Cache of key (string) -> *idempotencyEntry
+}
+
+func newIdempotencyCache() *idempotencyCache {
+	tokens, err := lru.New(idempotencyCacheTokens)
+	if err != nil {
+		// Only returns an error for a non-positive size, which is a
+		// programmer error given the constant above.
+		panic(err)
+	}
+	return &idempotencyCache{tokens: tokens}
+}
+
+// get returns the cached entry for the token/key pair, if any. The caller
+// is responsible for checking that method, path, and bodyHash match before
+// replaying it, since the same key is scoped to a single token, not a
+// single endpoint or request body.
+func (c *idempotencyCache) get(token, key string) (*idempotencyEntry, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	raw, ok := c.tokens.Get(token)
+	if !ok {
+		return nil, false
+	}
+	v, ok := raw.(*lru.Cache).Get(key)
+	if !ok {
+		return nil, false
+	}
+	return v.(*idempotencyEntry), true
+}
+
+func (c *idempotencyCache) put(token, key string, entry *idempotencyEntry) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	raw, ok := c.tokens.Get(token)
+	var keys *lru.Cache
+	if ok {
+		keys = raw.(*lru.Cache)
+	} else {
+		// Only errors on a non-positive size.
+		keys, _ = lru.New(idempotencyCacheKeysPerToken)
+		c.tokens.Add(token, keys)
+	}
+	keys.Add(key, entry)
+}
+
+// isIdempotentReplayable reports whether method is one we're willing to
+// cache and replay. Idempotency keys only make sense for requests that
+// mutate state; replaying a GET would just be a worse cache.
+func isIdempotentReplayable(method string) bool {
+	switch method {
+	case "PUT", "POST", "PATCH", "DELETE":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,62 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyCache(t *testing.T) {
+	c := newIdempotencyCache()
+
+	entry := &idempotencyEntry{method: "PUT", path: "/v1/kv/foo", statusCode: 200, body: []byte("true")}
+	_, ok := c.get("tok", "key1")
+	require.False(t, ok)
+
+	c.put("tok", "key1", entry)
+	got, ok := c.get("tok", "key1")
+	require.True(t, ok)
+	require.Equal(t, entry, got)
+
+	// A different token doesn't see another token's keys.
+	_, ok = c.get("other", "key1")
+	require.False(t, ok)
+
+	// A nil cache (idempotency keys not configured) is a no-op.
+	var nilCache *idempotencyCache
+	nilCache.put("tok", "key1", entry)
+	_, ok = nilCache.get("tok", "key1")
+	require.False(t, ok)
+}
+
+func TestIdempotencyCache_EvictsKeysPerToken(t *testing.T) {
+	c := newIdempotencyCache()
+
+	for i := 0; i < idempotencyCacheKeysPerToken+1; i++ {
+		c.put("tok", string(rune('a'+i)), &idempotencyEntry{statusCode: 200})
+	}
+
+	// The least recently used key ("a") should have been evicted once the
+	// per-token cache exceeded its bound.
+	_, ok := c.get("tok", "a")
+	require.False(t, ok)
+
+	_, ok = c.get("tok", string(rune('a'+idempotencyCacheKeysPerToken)))
+	require.True(t, ok)
+}
+
+func TestHashIdempotencyBody(t *testing.T) {
+	require.Equal(t, hashIdempotencyBody([]byte("foo")), hashIdempotencyBody([]byte("foo")))
+	require.NotEqual(t, hashIdempotencyBody([]byte("foo")), hashIdempotencyBody([]byte("bar")))
+	require.NotEqual(t, hashIdempotencyBody(nil), hashIdempotencyBody([]byte("foo")))
+}
+
+func TestIsIdempotentReplayable(t *testing.T) {
+	require.True(t, isIdempotentReplayable("PUT"))
+	require.True(t, isIdempotentReplayable("POST"))
+	require.True(t, isIdempotentReplayable("PATCH"))
+	require.True(t, isIdempotentReplayable("DELETE"))
+	require.False(t, isIdempotentReplayable("GET"))
+	require.False(t, isIdempotentReplayable("HEAD"))
+	require.False(t, isIdempotentReplayable("OPTIONS"))
+}
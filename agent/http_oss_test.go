@@ -23,7 +23,7 @@ var extraTestEndpoints = map[string][]string{
 }
 
 // These endpoints are ignored in unit testing for response codes
-var ignoredEndpoints = []string{"/v1/status/peers", "/v1/agent/monitor", "/v1/agent/reload"}
+var ignoredEndpoints = []string{"/v1/status/peers", "/v1/agent/monitor", "/v1/agent/reload", "/v1/agent/subscribe"}
 
 // These have custom logic
 var customEndpoints = []string{"/v1/query", "/v1/query/"}
@@ -33,10 +33,17 @@ func init() {
 	registerEndpoint("/v1/acl/token/", []string{"GET", "PUT", "DELETE"}, (*HTTPHandlers).ACLTokenCRUD)
 	registerEndpoint("/v1/agent/token/", []string{"PUT"}, (*HTTPHandlers).AgentToken)
 	registerEndpoint("/v1/agent/self", []string{"GET"}, (*HTTPHandlers).AgentSelf)
+	registerEndpoint("/v1/agent/ready", []string{"GET"}, (*HTTPHandlers).AgentReady)
+	registerEndpoint("/v1/agent/live", []string{"GET"}, (*HTTPHandlers).AgentLive)
 	registerEndpoint("/v1/agent/host", []string{"GET"}, (*HTTPHandlers).AgentHost)
+	registerEndpoint("/v1/agent/debug/dump", []string{"POST"}, (*HTTPHandlers).AgentDebugDump)
+	registerEndpoint("/v1/agent/debug/gossip", []string{"GET"}, (*HTTPHandlers).AgentDebugGossip)
+	registerEndpoint("/v1/agent/xds/config-status", []string{"GET"}, (*HTTPHandlers).AgentXDSConfigStatus)
 	registerEndpoint("/v1/agent/maintenance", []string{"PUT"}, (*HTTPHandlers).AgentNodeMaintenance)
 	registerEndpoint("/v1/agent/reload", []string{"PUT"}, (*HTTPHandlers).AgentReload)
 	registerEndpoint("/v1/agent/monitor", []string{"GET"}, (*HTTPHandlers).AgentMonitor)
+	registerEndpoint("/v1/agent/subscribe", []string{"GET"}, (*HTTPHandlers).AgentSubscribe)
+	registerEndpoint("/v1/agent/convergence/", []string{"GET"}, (*HTTPHandlers).AgentConvergenceStatus)
 	registerEndpoint("/v1/agent/metrics", []string{"GET"}, (*HTTPHandlers).AgentMetrics)
 	registerEndpoint("/v1/agent/services", []string{"GET"}, (*HTTPHandlers).AgentServices)
 	registerEndpoint("/v1/agent/service/", []string{"GET"}, (*HTTPHandlers).AgentService)
@@ -59,6 +66,7 @@ func init() {
 	registerEndpoint("/v1/agent/service/register", []string{"PUT"}, (*HTTPHandlers).AgentRegisterService)
 	registerEndpoint("/v1/agent/service/deregister/", []string{"PUT"}, (*HTTPHandlers).AgentDeregisterService)
 	registerEndpoint("/v1/agent/service/maintenance/", []string{"PUT"}, (*HTTPHandlers).AgentServiceMaintenance)
+	registerEndpoint("/v1/agent/service/drain/", []string{"PUT"}, (*HTTPHandlers).AgentServiceDrain)
 	registerEndpoint("/v1/catalog/register", []string{"PUT"}, (*HTTPHandlers).CatalogRegister)
 	registerEndpoint("/v1/catalog/connect/", []string{"GET"}, (*HTTPHandlers).CatalogConnectServiceNodes)
 	registerEndpoint("/v1/catalog/deregister", []string{"PUT"}, (*HTTPHandlers).CatalogDeregister)
@@ -71,12 +79,15 @@ func init() {
 	registerEndpoint("/v1/catalog/gateway-services/", []string{"GET"}, (*HTTPHandlers).CatalogGatewayServices)
 	registerEndpoint("/v1/config/", []string{"GET", "DELETE"}, (*HTTPHandlers).Config)
 	registerEndpoint("/v1/config", []string{"PUT"}, (*HTTPHandlers).ConfigApply)
+	registerEndpoint("/v1/config/tag-conformance/", []string{"GET"}, (*HTTPHandlers).ConfigTagConformance)
 	registerEndpoint("/v1/connect/ca/configuration", []string{"GET", "PUT"}, (*HTTPHandlers).ConnectCAConfiguration)
 	registerEndpoint("/v1/connect/ca/roots", []string{"GET"}, (*HTTPHandlers).ConnectCARoots)
+	registerEndpoint("/v1/connect/ca/leaf/revoke", []string{"PUT"}, (*HTTPHandlers).ConnectCARevokeLeaf)
 	registerEndpoint("/v1/connect/intentions", []string{"GET", "POST"}, (*HTTPHandlers).IntentionEndpoint)
 	registerEndpoint("/v1/connect/intentions/match", []string{"GET"}, (*HTTPHandlers).IntentionMatch)
 	registerEndpoint("/v1/connect/intentions/check", []string{"GET"}, (*HTTPHandlers).IntentionCheck)
 	registerEndpoint("/v1/connect/intentions/exact", []string{"GET", "PUT", "DELETE"}, (*HTTPHandlers).IntentionExact)
+	registerEndpoint("/v1/connect/intentions/reconcile/", []string{"PUT"}, (*HTTPHandlers).IntentionReconcile)
 	registerEndpoint("/v1/connect/intentions/", []string{"GET", "PUT", "DELETE"}, (*HTTPHandlers).IntentionSpecific)
 	registerEndpoint("/v1/coordinate/datacenters", []string{"GET"}, (*HTTPHandlers).CoordinateDatacenters)
 	registerEndpoint("/v1/coordinate/nodes", []string{"GET"}, (*HTTPHandlers).CoordinateNodes)
@@ -94,6 +105,7 @@ func init() {
 	registerEndpoint("/v1/health/service/", []string{"GET"}, (*HTTPHandlers).HealthServiceNodes)
 	registerEndpoint("/v1/health/connect/", []string{"GET"}, (*HTTPHandlers).HealthConnectServiceNodes)
 	registerEndpoint("/v1/health/ingress/", []string{"GET"}, (*HTTPHandlers).HealthIngressServiceNodes)
+	registerEndpoint("/v1/health/summary", []string{"GET"}, (*HTTPHandlers).HealthSummary)
 	registerEndpoint("/v1/internal/ui/metrics-proxy/", []string{"GET"}, (*HTTPHandlers).UIMetricsProxy)
 	registerEndpoint("/v1/internal/ui/nodes", []string{"GET"}, (*HTTPHandlers).UINodes)
 	registerEndpoint("/v1/internal/ui/node/", []string{"GET"}, (*HTTPHandlers).UINodeInfo)
@@ -108,6 +120,17 @@ func init() {
 	registerEndpoint("/v1/operator/keyring", []string{"GET", "POST", "PUT", "DELETE"}, (*HTTPHandlers).OperatorKeyringEndpoint)
 	registerEndpoint("/v1/operator/autopilot/configuration", []string{"GET", "PUT"}, (*HTTPHandlers).OperatorAutopilotConfiguration)
 	registerEndpoint("/v1/operator/autopilot/health", []string{"GET"}, (*HTTPHandlers).OperatorServerHealth)
+	registerEndpoint("/v1/operator/features", []string{"GET"}, (*HTTPHandlers).OperatorAgentFeatures)
+	registerEndpoint("/v1/operator/feature-rollout", []string{"GET"}, (*HTTPHandlers).OperatorFeatureRollout)
+	registerEndpoint("/v1/operator/leadership", []string{"GET"}, (*HTTPHandlers).OperatorLeadership)
+	registerEndpoint("/v1/operator/state-digest", []string{"GET"}, (*HTTPHandlers).OperatorStateDigest)
+	registerEndpoint("/v1/operator/fsck", []string{"GET"}, (*HTTPHandlers).OperatorFSCK)
+	registerEndpoint("/v1/operator/audit/config-entries", []string{"GET"}, (*HTTPHandlers).OperatorConfigEntryAuditLog)
+	registerEndpoint("/v1/operator/dc-drills", []string{"GET"}, (*HTTPHandlers).OperatorDatacenterDrills)
+	registerEndpoint("/v1/operator/dc-drill", []string{"PUT", "DELETE"}, (*HTTPHandlers).OperatorDatacenterDrill)
+	registerEndpoint("/v1/operator/convergence-status", []string{"GET"}, (*HTTPHandlers).OperatorConvergenceStatus)
+	registerEndpoint("/v1/query-view", []string{"POST"}, (*HTTPHandlers).QueryViewCreate)
+	registerEndpoint("/v1/query-view/", []string{"GET"}, (*HTTPHandlers).QueryViewFetch)
 	registerEndpoint("/v1/query", []string{"GET", "POST"}, (*HTTPHandlers).PreparedQueryGeneral)
 	// specific prepared query endpoints have more complex rules for allowed methods, so
 	// the prefix is registered with no methods.
@@ -583,6 +583,108 @@ func TestHTTP_wrap_obfuscateLog(t *testing.T) {
 	}
 }
 
+func TestHTTP_wrap_idempotencyReplay(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	calls := 0
+	handler := func(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	req, _ := http.NewRequest("PUT", "/v1/kv/foo", nil)
+	req.Header.Set(IdempotencyKeyHeader, "abc")
+
+	resp1 := httptest.NewRecorder()
+	a.srv.wrap(handler, []string{"PUT"})(resp1, req)
+	require.Equal(t, 1, calls)
+	require.Equal(t, "1", resp1.Body.String())
+
+	// A retry with the same idempotency key replays the first response
+	// instead of calling the handler again.
+	req2, _ := http.NewRequest("PUT", "/v1/kv/foo", nil)
+	req2.Header.Set(IdempotencyKeyHeader, "abc")
+	resp2 := httptest.NewRecorder()
+	a.srv.wrap(handler, []string{"PUT"})(resp2, req2)
+	require.Equal(t, 1, calls, "handler should not have been called again")
+	require.Equal(t, "1", resp2.Body.String())
+	require.Equal(t, "true", resp2.Header().Get("X-Consul-Idempotency-Replayed"))
+
+	// A different key is not replayed.
+	req3, _ := http.NewRequest("PUT", "/v1/kv/foo", nil)
+	req3.Header.Set(IdempotencyKeyHeader, "xyz")
+	resp3 := httptest.NewRecorder()
+	a.srv.wrap(handler, []string{"PUT"})(resp3, req3)
+	require.Equal(t, 2, calls)
+	require.Equal(t, "2", resp3.Body.String())
+
+	// The same key against a different path is not replayed either, since
+	// a stored response is only replayed for the same method and path.
+	req4, _ := http.NewRequest("PUT", "/v1/kv/bar", nil)
+	req4.Header.Set(IdempotencyKeyHeader, "abc")
+	resp4 := httptest.NewRecorder()
+	a.srv.wrap(handler, []string{"PUT"})(resp4, req4)
+	require.Equal(t, 3, calls)
+}
+
+func TestHTTP_wrap_idempotencyKey_bodyMismatch(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	calls := 0
+	handler := func(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	req, _ := http.NewRequest("PUT", "/v1/kv/foo", strings.NewReader("one"))
+	req.Header.Set(IdempotencyKeyHeader, "abc")
+	resp := httptest.NewRecorder()
+	a.srv.wrap(handler, []string{"PUT"})(resp, req)
+	require.Equal(t, 1, calls)
+
+	// Reusing the same key with a different body is rejected rather than
+	// replaying the stale response or silently re-applying the write.
+	req2, _ := http.NewRequest("PUT", "/v1/kv/foo", strings.NewReader("two"))
+	req2.Header.Set(IdempotencyKeyHeader, "abc")
+	resp2 := httptest.NewRecorder()
+	a.srv.wrap(handler, []string{"PUT"})(resp2, req2)
+	require.Equal(t, 1, calls, "handler should not have been called again")
+	require.Equal(t, http.StatusConflict, resp2.Code)
+
+	// The original body still replays normally.
+	req3, _ := http.NewRequest("PUT", "/v1/kv/foo", strings.NewReader("one"))
+	req3.Header.Set(IdempotencyKeyHeader, "abc")
+	resp3 := httptest.NewRecorder()
+	a.srv.wrap(handler, []string{"PUT"})(resp3, req3)
+	require.Equal(t, 1, calls)
+	require.Equal(t, "true", resp3.Header().Get("X-Consul-Idempotency-Replayed"))
+}
+
+func TestHTTP_wrap_idempotencyKey_errorsNotCached(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	calls := 0
+	handler := func(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+		calls++
+		return "bad", CodeWithPayloadError{StatusCode: http.StatusBadRequest, Reason: "bad"}
+	}
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest("PUT", "/v1/kv/foo", nil)
+		req.Header.Set(IdempotencyKeyHeader, "abc")
+		resp := httptest.NewRecorder()
+		a.srv.wrap(handler, []string{"PUT"})(resp, req)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+	}
+	require.Equal(t, 2, calls, "error responses should not be replayed")
+}
+
 func TestPrettyPrint(t *testing.T) {
 	t.Parallel()
 	testPrettyPrint("pretty=1", t)
@@ -0,0 +1,153 @@
+package agent
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/lib/file"
+)
+
+// persistedDeregisterIntent is a write-ahead record of a deregistration
+// that has been accepted locally but not yet confirmed synced to the
+// servers. It's written to disk before the persisted service definition
+// is purged so that, if the agent is restarted before anti-entropy gets a
+// chance to tell the servers about the removal, the pending deregistration
+// isn't silently lost along with the in-memory local state that would
+// otherwise be the only record of it.
+type persistedDeregisterIntent struct {
+	ServiceID structs.ServiceID
+	Token     string
+}
+
+// persistDeregisterIntent records that serviceID is pending deregistration
+// from the servers. It must be called before the service's persisted
+// definition is purged so the intent survives even once there's nothing
+// left in the services dir to reconstruct it from.
+func (a *Agent) persistDeregisterIntent(serviceID structs.ServiceID, token string) error {
+	wrapped := persistedDeregisterIntent{
+		ServiceID: serviceID,
+		Token:     token,
+	}
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(a.config.DataDir, deregisterIntentsDir)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	intentPath := filepath.Join(dir, serviceID.StringHash())
+	return file.WriteAtomic(intentPath, encoded)
+}
+
+// purgeDeregisterIntent removes a persisted deregistration intent, once the
+// deregistration it describes is no longer pending.
+func (a *Agent) purgeDeregisterIntent(serviceID structs.ServiceID) error {
+	intentPath := filepath.Join(a.config.DataDir, deregisterIntentsDir, serviceID.StringHash())
+	if _, err := os.Stat(intentPath); err == nil {
+		return os.Remove(intentPath)
+	}
+	return nil
+}
+
+// loadDeregisterIntents replays any deregistration intents left over from
+// before the agent last shut down, so a deregistration that was accepted
+// locally but never confirmed synced still gets retried against the
+// servers instead of being forgotten.
+func (a *Agent) loadDeregisterIntents() error {
+	dir := filepath.Join(a.config.DataDir, deregisterIntentsDir)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, fi := range files {
+		if fi.IsDir() || strings.HasSuffix(fi.Name(), "tmp") {
+			continue
+		}
+
+		intentPath := filepath.Join(dir, fi.Name())
+		buf, err := ioutil.ReadFile(intentPath)
+		if err != nil {
+			return err
+		}
+
+		var p persistedDeregisterIntent
+		if err := json.Unmarshal(buf, &p); err != nil {
+			a.logger.Error("Failed decoding deregister intent file",
+				"file", intentPath,
+				"error", err,
+			)
+			continue
+		}
+
+		a.logger.Info("replaying pending deregistration from write-ahead log",
+			"service", p.ServiceID.String(),
+		)
+		a.State.RestoreDeregisterIntent(p.ServiceID, p.Token)
+	}
+
+	return nil
+}
+
+// reapDeregisterIntents periodically purges deregistration intents whose
+// deregistration has finished syncing to the servers, so the write-ahead
+// log doesn't grow without bound.
+func (a *Agent) reapDeregisterIntents() {
+	for {
+		select {
+		case <-time.After(intentReapInterval):
+			a.reapDeregisterIntentsOnce()
+
+		case <-a.shutdownCh:
+			return
+		}
+	}
+}
+
+func (a *Agent) reapDeregisterIntentsOnce() {
+	dir := filepath.Join(a.config.DataDir, deregisterIntentsDir)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	for _, fi := range files {
+		if fi.IsDir() {
+			continue
+		}
+
+		intentPath := filepath.Join(dir, fi.Name())
+		buf, err := ioutil.ReadFile(intentPath)
+		if err != nil {
+			continue
+		}
+
+		var p persistedDeregisterIntent
+		if err := json.Unmarshal(buf, &p); err != nil {
+			continue
+		}
+
+		// Once the service is gone from local state entirely, the
+		// deregistration has either synced successfully or there's
+		// nothing left to sync; either way the intent can be dropped.
+		if !a.State.ServiceExists(p.ServiceID) {
+			if err := a.purgeDeregisterIntent(p.ServiceID); err != nil {
+				a.logger.Warn("failed purging deregister intent",
+					"service", p.ServiceID.String(),
+					"error", err,
+				)
+			}
+		}
+	}
+}
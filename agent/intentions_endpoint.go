@@ -78,6 +78,45 @@ func (s *HTTPHandlers) IntentionCreate(resp http.ResponseWriter, req *http.Reque
 	return intentionCreateResponse{reply}, nil
 }
 
+// PUT /v1/connect/intentions/reconcile/:destination
+//
+// The request body is the complete desired set of source intentions for
+// the named destination service. Any existing source not present in the
+// body is removed, any source present that doesn't already exist is
+// created, and any source present in both is updated in place, all as a
+// single transactional write.
+func (s *HTTPHandlers) IntentionReconcile(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Method != "PUT" {
+		return nil, MethodNotAllowedError{req.Method, []string{"PUT"}}
+	}
+
+	destination := strings.TrimPrefix(req.URL.Path, "/v1/connect/intentions/reconcile/")
+	if destination == "" {
+		return nil, BadRequestError{Reason: "destination service name is required"}
+	}
+
+	var entMeta structs.EnterpriseMeta
+	if err := s.parseEntMetaNoWildcard(req, &entMeta); err != nil {
+		return nil, err
+	}
+
+	args := structs.IntentionsReconcileRequest{
+		Destination: structs.NewServiceName(destination, &entMeta),
+	}
+	s.parseDC(req, &args.Datacenter)
+	s.parseToken(req, &args.Token)
+	if err := decodeBody(req.Body, &args.Sources); err != nil {
+		return nil, BadRequestError{Reason: fmt.Sprintf("Request decode failed: %v", err)}
+	}
+
+	var reply structs.IntentionsReconcileResponse
+	if err := s.agent.RPC("Intention.Reconcile", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
 func (s *HTTPHandlers) validateEnterpriseIntention(ixn *structs.Intention) error {
 	if err := s.validateEnterpriseIntentionNamespace("SourceNS", ixn.SourceNS, true); err != nil {
 		return err
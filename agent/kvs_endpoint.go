@@ -213,16 +213,17 @@ func (s *HTTPHandlers) KVSPut(resp http.ResponseWriter, req *http.Request, args
 	applyReq.DirEnt.Value = buf.Bytes()
 
 	// Make the RPC
-	var out bool
+	var out structs.KVSApplyResponse
 	if err := s.agent.RPC("KVS.Apply", &applyReq, &out); err != nil {
 		return nil, err
 	}
+	setIndex(resp, out.Index)
 
 	// Only use the out value if this was a CAS
 	if applyReq.Op == api.KVSet {
 		return true, nil
 	}
-	return out, nil
+	return out.Success, nil
 }
 
 // KVSPut handles a DELETE request
@@ -262,14 +263,15 @@ func (s *HTTPHandlers) KVSDelete(resp http.ResponseWriter, req *http.Request, ar
 	}
 
 	// Make the RPC
-	var out bool
+	var out structs.KVSApplyResponse
 	if err := s.agent.RPC("KVS.Apply", &applyReq, &out); err != nil {
 		return nil, err
 	}
+	setIndex(resp, out.Index)
 
 	// Only use the out value if this was a CAS
 	if applyReq.Op == api.KVDeleteCAS {
-		return out, nil
+		return out.Success, nil
 	}
 	return true, nil
 }
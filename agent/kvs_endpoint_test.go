@@ -38,6 +38,7 @@ func TestKVSEndpoint_PUT_GET_DELETE(t *testing.T) {
 		if res := obj.(bool); !res {
 			t.Fatalf("should work")
 		}
+		assertIndex(t, resp)
 	}
 
 	for _, key := range keys {
@@ -264,6 +264,31 @@ func (l *State) AddServiceWithChecks(service *structs.NodeService, checks []*str
 	return nil
 }
 
+// SetServiceDraining updates the Draining flag on an already-registered
+// service and requeues it for anti-entropy sync, so the change reaches the
+// catalog and is visible to xDS, DNS, and prepared queries. See
+// Agent.DrainService, the only normal caller of this.
+func (l *State) SetServiceDraining(id structs.ServiceID, draining bool) error {
+	l.Lock()
+	defer l.Unlock()
+
+	s := l.services[id]
+	if s == nil || s.Deleted {
+		return fmt.Errorf("Service %q does not exist", id)
+	}
+	if s.Service.Draining == draining {
+		return nil
+	}
+
+	ns := *s.Service
+	ns.Draining = draining
+	l.setServiceStateLocked(&ServiceState{
+		Service: &ns,
+		Token:   s.Token,
+	})
+	return nil
+}
+
 // RemoveService is used to remove a service entry from the local state.
 // The agent will make a best effort to ensure it is deregistered.
 func (l *State) RemoveService(id structs.ServiceID) error {
@@ -272,6 +297,35 @@ func (l *State) RemoveService(id structs.ServiceID) error {
 	return l.removeServiceLocked(id)
 }
 
+// RestoreDeregisterIntent re-establishes a pending deregistration for a
+// service the agent no longer has a definition for, such as one whose
+// persisted service file was already purged before the agent was
+// restarted. It marks the service deleted so the next sync still tells
+// the server to remove it, even though there's nothing left to
+// reconstruct a full NodeService from.
+//
+// If a definition for id already exists in the local state (e.g. it was
+// restored from config or a persisted file that hadn't been purged yet),
+// that takes precedence and the intent is ignored.
+func (l *State) RestoreDeregisterIntent(id structs.ServiceID, token string) {
+	l.Lock()
+	defer l.Unlock()
+
+	if _, ok := l.services[id]; ok {
+		return
+	}
+
+	l.setServiceStateLocked(&ServiceState{
+		Service: &structs.NodeService{
+			ID:             id.ID,
+			EnterpriseMeta: id.EnterpriseMeta,
+		},
+		Token:   token,
+		InSync:  false,
+		Deleted: true,
+	})
+}
+
 // RemoveServiceWithChecks removes a service and its check from the local state atomically
 func (l *State) RemoveServiceWithChecks(serviceID structs.ServiceID, checkIDs []structs.CheckID) error {
 	l.Lock()
@@ -1107,7 +1161,7 @@ func (l *State) deleteService(key structs.ServiceID) error {
 		EnterpriseMeta: key.EnterpriseMeta,
 		WriteRequest:   structs.WriteRequest{Token: st},
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	err := l.Delegate.RPC("Catalog.Deregister", &req, &out)
 	switch {
 	case err == nil || strings.Contains(err.Error(), "Unknown service"):
@@ -1156,7 +1210,7 @@ func (l *State) deleteCheck(key structs.CheckID) error {
 		EnterpriseMeta: key.EnterpriseMeta,
 		WriteRequest:   structs.WriteRequest{Token: ct},
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	err := l.Delegate.RPC("Catalog.Deregister", &req, &out)
 	switch {
 	case err == nil || strings.Contains(err.Error(), "Unknown check"):
@@ -1235,7 +1289,7 @@ func (l *State) syncService(key structs.ServiceID) error {
 		req.Checks = checks
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	err := l.Delegate.RPC("Catalog.Register", &req, &out)
 	switch {
 	case err == nil:
@@ -1297,7 +1351,7 @@ func (l *State) syncCheck(key structs.CheckID) error {
 		req.Service = s.Service
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	err := l.Delegate.RPC("Catalog.Register", &req, &out)
 	switch {
 	case err == nil:
@@ -1337,7 +1391,7 @@ func (l *State) syncNodeInfo() error {
 		NodeMeta:        l.metadata,
 		WriteRequest:    structs.WriteRequest{Token: at},
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	err := l.Delegate.RPC("Catalog.Register", &req, &out)
 	switch {
 	case err == nil:
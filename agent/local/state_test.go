@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
@@ -42,7 +43,7 @@ func TestAgentAntiEntropy_Services(t *testing.T) {
 	}
 
 	// Exists both, same (noop)
-	var out struct{}
+	var out structs.WriteIndexResponse
 	srv1 := &structs.NodeService{
 		ID:      "mysql",
 		Service: "mysql",
@@ -257,7 +258,7 @@ func TestAgentAntiEntropy_Services_ConnectProxy(t *testing.T) {
 	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
 
 	// Register node info
-	var out struct{}
+	var out structs.WriteIndexResponse
 	args := &structs.RegisterRequest{
 		Datacenter: "dc1",
 		Node:       a.Config.NodeName,
@@ -507,7 +508,7 @@ func TestAgentAntiEntropy_EnableTagOverride(t *testing.T) {
 		Node:       a.Config.NodeName,
 		Address:    "127.0.0.1",
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 
 	// register a local service with tag override enabled
 	srv1 := &structs.NodeService{
@@ -627,6 +628,80 @@ func TestAgentAntiEntropy_EnableTagOverride(t *testing.T) {
 	})
 }
 
+func TestAgentAntiEntropy_Service_Owner(t *testing.T) {
+	t.Parallel()
+	a := agent.NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	args := &structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       a.Config.NodeName,
+		Address:    "127.0.0.1",
+	}
+	var out structs.WriteIndexResponse
+
+	srv := &structs.NodeService{
+		ID:      "web",
+		Service: "web",
+		Port:    80,
+		Owner:   &structs.ServiceOwner{Team: "web", Contact: "#web-team"},
+		Weights: &structs.Weights{
+			Passing: 1,
+			Warning: 1,
+		},
+	}
+	a.State.AddService(srv, "")
+
+	// sync so the catalog and local state agree
+	if err := a.State.SyncFull(); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if err := servicesInSync(a.State, 1, structs.DefaultEnterpriseMeta()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Diverge the catalog from local state by directly registering the same
+	// service with a different Owner, as if another agent or operator had
+	// changed it out from under us.
+	diverged := new(structs.NodeService)
+	*diverged = *srv
+	diverged.Owner = &structs.ServiceOwner{Team: "other-team", Contact: "#other-team"}
+	args.Service = diverged
+	if err := a.RPC("Catalog.Register", args, &out); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	// The only difference is Owner, so unless it's part of IsSame the local
+	// state will wrongly believe it's still in sync with the catalog and
+	// SyncFull will be a no-op, leaving the catalog's Owner diverged forever.
+	if err := a.State.SyncFull(); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	req := structs.NodeSpecificRequest{
+		Datacenter: "dc1",
+		Node:       a.Config.NodeName,
+	}
+	var services structs.IndexedNodeServices
+	retry.Run(t, func(r *retry.R) {
+		if err := a.RPC("Catalog.NodeServices", &req, &services); err != nil {
+			r.Fatalf("err: %v", err)
+		}
+		got, ok := services.NodeServices.Services["web"]
+		if !ok {
+			r.Fatalf("missing service")
+		}
+		if !reflect.DeepEqual(got.Owner, srv.Owner) {
+			r.Fatalf("bad owner: %#v", got.Owner)
+		}
+
+		if err := servicesInSync(a.State, 1, structs.DefaultEnterpriseMeta()); err != nil {
+			r.Fatal(err)
+		}
+	})
+}
+
 func TestAgentAntiEntropy_Services_WithChecks(t *testing.T) {
 	t.Parallel()
 	a := agent.NewTestAgent(t, "")
@@ -918,7 +993,7 @@ func TestAgentAntiEntropy_Checks(t *testing.T) {
 	}
 
 	// Exists both, same (noop)
-	var out struct{}
+	var out structs.WriteIndexResponse
 	chk1 := &structs.HealthCheck{
 		Node:           a.Config.NodeName,
 		CheckID:        "mysql",
@@ -1109,7 +1184,7 @@ func TestAgentAntiEntropy_RemovingServiceAndCheck(t *testing.T) {
 		Address:    "127.0.0.1",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 
 	// Exists remote (delete)
 	svcID := "deleted-check-service"
@@ -1553,7 +1628,7 @@ func TestAgentAntiEntropy_Check_DeferSync(t *testing.T) {
 		Check:           eCopy,
 		WriteRequest:    structs.WriteRequest{},
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", &reg, &out); err != nil {
 		t.Fatalf("err: %s", err)
 	}
@@ -1668,7 +1743,7 @@ func TestAgentAntiEntropy_NodeInfo(t *testing.T) {
 		Node:       a.Config.NodeName,
 		Address:    "127.0.0.1",
 	}
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -2185,6 +2260,63 @@ func TestAliasNotifications_local(t *testing.T) {
 	})
 }
 
+func TestState_RestoreDeregisterIntent(t *testing.T) {
+	t.Parallel()
+	logger := hclog.New(&hclog.LoggerOptions{
+		Output: os.Stderr,
+	})
+
+	state := local.NewState(local.Config{}, logger, &token.Store{})
+	state.TriggerSyncChanges = func() {}
+
+	id := structs.NewServiceID("web", nil)
+
+	// With no existing record, the intent creates a deleted stub that
+	// will be synced as a deregistration.
+	state.RestoreDeregisterIntent(id, "fake-token-web")
+	require.True(t, state.ServiceExists(id))
+	require.Nil(t, state.Service(id))
+	require.Nil(t, state.ServiceState(id))
+
+	// A real definition for the same ID takes precedence over a
+	// replayed intent.
+	require.NoError(t, state.AddService(&structs.NodeService{
+		ID:      "web",
+		Service: "web",
+	}, "fake-token-web"))
+	state.RestoreDeregisterIntent(id, "fake-token-web")
+	require.NotNil(t, state.Service(id))
+}
+
+func TestState_SetServiceDraining(t *testing.T) {
+	t.Parallel()
+	logger := hclog.New(&hclog.LoggerOptions{
+		Output: os.Stderr,
+	})
+
+	state := local.NewState(local.Config{}, logger, &token.Store{})
+	state.TriggerSyncChanges = func() {}
+
+	id := structs.NewServiceID("web", nil)
+
+	require.Error(t, state.SetServiceDraining(id, true), "service does not exist yet")
+
+	require.NoError(t, state.AddService(&structs.NodeService{
+		ID:      "web",
+		Service: "web",
+	}, "fake-token-web"))
+
+	require.NoError(t, state.SetServiceDraining(id, true))
+	require.True(t, state.Service(id).Draining)
+
+	// Setting it again to the same value is a no-op.
+	require.NoError(t, state.SetServiceDraining(id, true))
+	require.True(t, state.Service(id).Draining)
+
+	require.NoError(t, state.SetServiceDraining(id, false))
+	require.False(t, state.Service(id).Draining)
+}
+
 // drainCh drains a channel by reading messages until it would block.
 func drainCh(ch chan struct{}) {
 	for {
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/go-version"
@@ -46,6 +47,12 @@ type Server struct {
 
 	// If true, use TLS when connecting to this server
 	UseTLS bool
+
+	// SyncPacing is a hint published by the server for how long a
+	// reconnecting agent should stagger its next full anti-entropy sync,
+	// to avoid a registration stampede after a partition heals. Zero
+	// means the server isn't under enough reconnect load to need one.
+	SyncPacing time.Duration
 }
 
 // Key returns the corresponding Key
@@ -159,6 +166,15 @@ func IsConsulServer(m serf.Member) (bool, *Server) {
 		}
 	}
 
+	var syncPacing time.Duration
+	if syncPacingStr, ok := m.Tags["sync_pace_ms"]; ok {
+		syncPacingMS, err := strconv.Atoi(syncPacingStr)
+		if err != nil {
+			return false, nil
+		}
+		syncPacing = time.Duration(syncPacingMS) * time.Millisecond
+	}
+
 	// Check if the server is a non voter
 	_, nonVoter := m.Tags["nonvoter"]
 
@@ -185,6 +201,7 @@ func IsConsulServer(m serf.Member) (bool, *Server) {
 		NonVoter:     nonVoter,
 		ACLs:         acls,
 		FeatureFlags: featureFlags,
+		SyncPacing:   syncPacing,
 	}
 	return true, parts
 }
@@ -0,0 +1,122 @@
+package agent
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// envoyPrometheusBindAddrKey is the proxy registration config key operators
+// set (normally via the `envoy_prometheus_bind_addr` bootstrap-config key
+// documented in command/connect/envoy) to have Envoy expose its own
+// /stats/prometheus endpoint. We read it back out of the local service
+// registration so we know where to scrape each connected sidecar.
+const envoyPrometheusBindAddrKey = "envoy_prometheus_bind_addr"
+
+// localAppMetricsPathKey optionally names a path on the proxy's local
+// application (scraped at LocalServiceAddress:LocalServicePort) that serves
+// that application's own Prometheus metrics, so they can be merged in too.
+const localAppMetricsPathKey = "envoy_local_app_metrics_path"
+
+// mergedMetricsScrapeTimeout bounds how long we'll wait on any single
+// sidecar or application scrape before giving up on it and moving on to the
+// rest, so one wedged proxy can't hang the whole merged endpoint.
+const mergedMetricsScrapeTimeout = 3 * time.Second
+
+// metricsScraper fetches and relabels the Prometheus metrics exposed by a
+// single connect-proxy's Envoy admin endpoint (and optionally its local
+// application), so they can be merged into the agent's own
+// /v1/agent/metrics output. This lets operators scrape one endpoint per
+// node instead of configuring a scrape target per sidecar.
+type metricsScraper struct {
+	client *http.Client
+}
+
+func newMetricsScraper() *metricsScraper {
+	return &metricsScraper{
+		client: &http.Client{Timeout: mergedMetricsScrapeTimeout},
+	}
+}
+
+// scrapeService returns the relabeled metric families exposed by svc's
+// Envoy (and, if configured, its local application), or nil if svc isn't a
+// connect-proxy with a Prometheus bind address configured.
+func (m *metricsScraper) scrapeService(svc *structs.NodeService) []byte {
+	if svc.Kind != structs.ServiceKindConnectProxy {
+		return nil
+	}
+
+	bindAddr, ok := svc.Proxy.Config[envoyPrometheusBindAddrKey].(string)
+	if !ok || bindAddr == "" {
+		return nil
+	}
+
+	serviceName := svc.Proxy.DestinationServiceName
+	if serviceName == "" {
+		serviceName = svc.Service
+	}
+
+	var buf bytes.Buffer
+	if mfs, err := m.scrapeURL(fmt.Sprintf("http://%s/stats/prometheus", bindAddr)); err == nil {
+		relabelAndEncode(&buf, mfs, serviceName, "envoy")
+	}
+
+	if path, ok := svc.Proxy.Config[localAppMetricsPathKey].(string); ok && path != "" {
+		addr := svc.Proxy.LocalServiceAddress
+		if addr == "" {
+			addr = "127.0.0.1"
+		}
+		port := svc.Proxy.LocalServicePort
+		if port == 0 {
+			port = svc.Port
+		}
+		url := fmt.Sprintf("http://%s:%d%s", addr, port, path)
+		if mfs, err := m.scrapeURL(url); err == nil {
+			relabelAndEncode(&buf, mfs, serviceName, "app")
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func (m *metricsScraper) scrapeURL(url string) (map[string]*dto.MetricFamily, error) {
+	resp, err := m.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(resp.Body)
+}
+
+// relabelAndEncode adds consul_service and consul_source labels to every
+// metric in mfs (identifying which sidecar/application they came from) and
+// writes them to buf in Prometheus text exposition format.
+func relabelAndEncode(buf *bytes.Buffer, mfs map[string]*dto.MetricFamily, serviceName, source string) {
+	serviceLabel := &dto.LabelPair{
+		Name:  strPtr("consul_service"),
+		Value: strPtr(serviceName),
+	}
+	sourceLabel := &dto.LabelPair{
+		Name:  strPtr("consul_source"),
+		Value: strPtr(source),
+	}
+
+	for _, mf := range mfs {
+		for _, metric := range mf.Metric {
+			metric.Label = append(metric.Label, serviceLabel, sourceLabel)
+		}
+		// MetricFamily protos aren't ordered by name, but that's fine since
+		// Prometheus's text format doesn't require grouping across families.
+		_, _ = expfmt.MetricFamilyToText(buf, mf)
+	}
+}
+
+func strPtr(s string) *string { return &s }
@@ -0,0 +1,84 @@
+package agent
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestMetricsScraper_ScrapeService(t *testing.T) {
+	envoyAdmin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/stats/prometheus", r.URL.Path)
+		w.Write([]byte("envoy_cluster_upstream_cx_total 42\n"))
+	}))
+	defer envoyAdmin.Close()
+
+	appMetrics := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/metrics", r.URL.Path)
+		w.Write([]byte("app_requests_total 7\n"))
+	}))
+	defer appMetrics.Close()
+
+	appAddr, appPort := splitHostPort(t, appMetrics.URL)
+
+	svc := &structs.NodeService{
+		Kind:    structs.ServiceKindConnectProxy,
+		Service: "web-sidecar-proxy",
+		Port:    21000,
+		Proxy: structs.ConnectProxyConfig{
+			DestinationServiceName: "web",
+			LocalServiceAddress:    appAddr,
+			LocalServicePort:       appPort,
+			Config: map[string]interface{}{
+				envoyPrometheusBindAddrKey: envoyAdmin.Listener.Addr().String(),
+				localAppMetricsPathKey:     "/metrics",
+			},
+		},
+	}
+
+	scraper := newMetricsScraper()
+	out := string(scraper.scrapeService(svc))
+
+	require.Contains(t, out, `envoy_cluster_upstream_cx_total{consul_service="web",consul_source="envoy"} 42`)
+	require.Contains(t, out, `app_requests_total{consul_service="web",consul_source="app"} 7`)
+}
+
+func TestMetricsScraper_ScrapeService_NotAProxy(t *testing.T) {
+	svc := &structs.NodeService{
+		Kind:    structs.ServiceKindTypical,
+		Service: "web",
+	}
+
+	scraper := newMetricsScraper()
+	require.Nil(t, scraper.scrapeService(svc))
+}
+
+func TestMetricsScraper_ScrapeService_NoPrometheusBindAddr(t *testing.T) {
+	svc := &structs.NodeService{
+		Kind:    structs.ServiceKindConnectProxy,
+		Service: "web-sidecar-proxy",
+		Proxy: structs.ConnectProxyConfig{
+			DestinationServiceName: "web",
+		},
+	}
+
+	scraper := newMetricsScraper()
+	require.Empty(t, scraper.scrapeService(svc))
+}
+
+func splitHostPort(t *testing.T, rawURL string) (string, int) {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	host, portStr, err := net.SplitHostPort(u.Host)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+	return host, port
+}
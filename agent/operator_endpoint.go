@@ -6,9 +6,11 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/consul/autopilot"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/version"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/raft"
 )
@@ -300,3 +302,221 @@ func (s *HTTPHandlers) OperatorServerHealth(resp http.ResponseWriter, req *http.
 
 	return out, nil
 }
+
+// OperatorFeatures describes the optional subsystems this agent was built
+// with and currently has enabled, along with relevant versions, so that
+// tooling can detect capabilities without probing individual endpoints and
+// interpreting 404s.
+type OperatorFeatures struct {
+	ConsulVersion string
+	ACL           OperatorACLFeature
+	Connect       bool
+	Segments      bool
+	Streaming     bool
+}
+
+// OperatorACLFeature describes the ACL subsystem's enablement and whether it
+// is running in legacy mode.
+type OperatorACLFeature struct {
+	Enabled bool
+	Legacy  bool
+}
+
+// OperatorAgentFeatures reports which optional subsystems this agent is
+// running with enabled.
+func (s *HTTPHandlers) OperatorAgentFeatures(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var token string
+	s.parseToken(req, &token)
+	rule, err := s.agent.resolveToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if rule != nil && rule.OperatorRead(nil) != acl.Allow {
+		return nil, acl.ErrPermissionDenied
+	}
+
+	cfg := s.agent.config
+	return OperatorFeatures{
+		ConsulVersion: version.GetHumanVersion(),
+		ACL: OperatorACLFeature{
+			Enabled: cfg.ACLsEnabled,
+			Legacy:  cfg.ACLsEnabled && s.agent.delegate.UseLegacyACLs(),
+		},
+		Connect:   cfg.ConnectEnabled,
+		Segments:  cfg.SegmentName != "" || len(cfg.Segments) > 0,
+		Streaming: cfg.CacheUseStreamingBackend,
+	}, nil
+}
+
+// OperatorFeatureRollout is used to inspect the cluster-wide rollout status
+// of features that are gated on every server in the datacenter supporting
+// them.
+func (s *HTTPHandlers) OperatorFeatureRollout(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DCSpecificRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.FeatureRolloutStatusResponse
+	if err := s.agent.RPC("Operator.FeatureRollout", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply.Features, nil
+}
+
+// OperatorLeadership returns the timeline of the leader's most recent
+// establishLeadership attempt, to help diagnose slow failovers.
+func (s *HTTPHandlers) OperatorLeadership(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DCSpecificRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.LeadershipStatusResponse
+	if err := s.agent.RPC("Operator.Leadership", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// OperatorStateDigest returns the FSM table hashes most recently computed by
+// the server that handles the request. Querying this against multiple
+// servers individually lets operators detect silent divergence between the
+// leader and its followers.
+func (s *HTTPHandlers) OperatorStateDigest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DCSpecificRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.StateDigestResponse
+	if err := s.agent.RPC("Operator.StateDigest", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// OperatorFSCK scans the catalog, session, ACL, and config entry tables for
+// dangling references and optionally repairs them.
+func (s *HTTPHandlers) OperatorFSCK(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.FSCKRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+	args.Repair = req.URL.Query().Get("repair") == "true"
+
+	var reply structs.FSCKResponse
+	if err := s.agent.RPC("Operator.FSCK", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply.Results, nil
+}
+
+// OperatorConfigEntryAuditLog returns the bounded config entry/intention
+// change audit log, recording who changed what and when.
+func (s *HTTPHandlers) OperatorConfigEntryAuditLog(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.ConfigEntryAuditLogRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.ConfigEntryAuditLogResponse
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC("Operator.ConfigEntryAuditLog", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply.Entries, nil
+}
+
+// OperatorConvergenceStatus reports whether every server in the datacenter
+// has applied a given Raft index, so deployment tooling can poll "has this
+// write reached everywhere" instead of sleeping an arbitrary duration. It
+// only covers servers; see structs.ConvergenceStatusResponse for how to
+// check a client agent's cache or stream.
+func (s *HTTPHandlers) OperatorConvergenceStatus(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.ConvergenceStatusRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	indexStr := req.URL.Query().Get("index")
+	if indexStr == "" {
+		return nil, BadRequestError{Reason: "Missing index"}
+	}
+	index, err := strconv.ParseUint(indexStr, 10, 64)
+	if err != nil {
+		return nil, BadRequestError{Reason: fmt.Sprintf("Invalid index: %v", err)}
+	}
+	args.Index = index
+
+	var reply structs.ConvergenceStatusResponse
+	if err := s.agent.RPC("Operator.ConvergenceStatus", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// OperatorDatacenterDrills returns the remote datacenters that currently
+// have an active failover drill against them.
+func (s *HTTPHandlers) OperatorDatacenterDrills(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DCSpecificRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.DatacenterDrillsResponse
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC("Operator.DatacenterDrills", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply.Drills, nil
+}
+
+// OperatorDatacenterDrill starts or stops a simulated failover drill
+// against a remote datacenter.
+func (s *HTTPHandlers) OperatorDatacenterDrill(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DatacenterDrillRequest
+	// ?dc names the drill's target, so the request is always handled by
+	// this agent's own datacenter rather than forwarded to the target.
+	args.Datacenter = s.agent.config.Datacenter
+	s.parseToken(req, &args.Token)
+
+	switch req.Method {
+	case "PUT":
+		args.Op = structs.DatacenterDrillStart
+	case "DELETE":
+		args.Op = structs.DatacenterDrillStop
+	default:
+		return nil, MethodNotAllowedError{req.Method, []string{"PUT", "DELETE"}}
+	}
+
+	args.TargetDatacenter = req.URL.Query().Get("dc")
+	if args.TargetDatacenter == "" {
+		return nil, BadRequestError{Reason: "Missing target datacenter"}
+	}
+
+	if args.Op == structs.DatacenterDrillStart {
+		durStr := req.URL.Query().Get("duration")
+		if durStr == "" {
+			return nil, BadRequestError{Reason: "Missing duration"}
+		}
+		dur, err := time.ParseDuration(durStr)
+		if err != nil {
+			return nil, BadRequestError{Reason: fmt.Sprintf("Error parsing duration: %v", err)}
+		}
+		args.Duration = dur
+	}
+
+	var reply struct{}
+	if err := s.agent.RPC("Operator.DatacenterDrill", &args, &reply); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
@@ -544,3 +544,107 @@ func TestOperator_ServerHealth_Unhealthy(t *testing.T) {
 		}
 	})
 }
+
+func TestOperator_AgentFeatures(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, `
+		connect { enabled = true }
+	`)
+	defer a.Shutdown()
+
+	body := bytes.NewBuffer(nil)
+	req, _ := http.NewRequest("GET", "/v1/operator/features", body)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.OperatorAgentFeatures(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.Code)
+
+	out, ok := obj.(OperatorFeatures)
+	require.True(t, ok)
+	require.NotEmpty(t, out.ConsulVersion)
+	require.True(t, out.Connect)
+	require.False(t, out.ACL.Enabled)
+	require.False(t, out.ACL.Legacy)
+}
+
+func TestOperator_FeatureRollout(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	body := bytes.NewBuffer(nil)
+	req, _ := http.NewRequest("GET", "/v1/operator/feature-rollout?dc=dc1", body)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.OperatorFeatureRollout(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.Code)
+
+	out, ok := obj.([]structs.FeatureRolloutStatus)
+	require.True(t, ok)
+	require.NotEmpty(t, out)
+}
+
+func TestOperator_Leadership(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	body := bytes.NewBuffer(nil)
+	req, _ := http.NewRequest("GET", "/v1/operator/leadership?dc=dc1", body)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.OperatorLeadership(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.Code)
+
+	out, ok := obj.(structs.LeadershipStatusResponse)
+	require.True(t, ok)
+	require.NotNil(t, out.LastTransition)
+	require.Empty(t, out.LastTransition.Error)
+	require.NotEmpty(t, out.LastTransition.Phases)
+}
+
+func TestOperator_ConvergenceStatus(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	req, _ := http.NewRequest("GET", "/v1/operator/convergence-status?dc=dc1&index=1", nil)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.OperatorConvergenceStatus(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.Code)
+
+	out, ok := obj.(structs.ConvergenceStatusResponse)
+	require.True(t, ok)
+	require.EqualValues(t, 1, out.Index)
+	require.Len(t, out.Servers, 1)
+	require.Equal(t, a.config.NodeName, out.Servers[0].Name)
+	require.True(t, out.Servers[0].Applied)
+
+	req, _ = http.NewRequest("GET", "/v1/operator/convergence-status?dc=dc1", nil)
+	resp = httptest.NewRecorder()
+	_, err = a.srv.OperatorConvergenceStatus(resp, req)
+	require.Error(t, err)
+}
+
+func TestOperator_StateDigest(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	body := bytes.NewBuffer(nil)
+	req, _ := http.NewRequest("GET", "/v1/operator/state-digest?dc=dc1", body)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.OperatorStateDigest(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.Code)
+
+	out, ok := obj.(structs.StateDigestResponse)
+	require.True(t, ok)
+	require.NotNil(t, out.Digest)
+	require.NotEmpty(t, out.Digest.Tables)
+}
@@ -162,7 +162,7 @@ func (s *HTTPHandlers) preparedQueryExecute(id string, resp http.ResponseWriter,
 	// a query can fail over to a different DC than where the execute request
 	// was sent to. That's why we use the reply's DC and not the one from
 	// the args.
-	s.agent.TranslateAddresses(reply.Datacenter, reply.Nodes, TranslateAddressAcceptAny)
+	s.agent.TranslateAddresses(reply.Datacenter, reply.Nodes, TranslateAddressAcceptAny, s.remoteIP(req))
 
 	// Use empty list instead of nil.
 	if reply.Nodes == nil {
@@ -213,6 +213,36 @@ RETRY_ONCE:
 	return reply, nil
 }
 
+// preparedQueryFailoverHistory returns the most recently recorded
+// cross-datacenter failover decisions for a prepared query.
+func (s *HTTPHandlers) preparedQueryFailoverHistory(id string, resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	args := structs.PreparedQueryFailoverHistoryRequest{
+		QueryID: id,
+	}
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.PreparedQueryFailoverHistoryResponse
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC("PreparedQuery.FailoverHistory", &args, &reply); err != nil {
+		// We have to check the string since the RPC sheds
+		// the specific error type.
+		if structs.IsErrQueryNotFound(err) {
+			resp.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(resp, err.Error())
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Use empty list instead of nil.
+	if reply.Events == nil {
+		reply.Events = make([]structs.QueryFailoverEvent, 0)
+	}
+	return reply.Events, nil
+}
+
 // preparedQueryGet returns a single prepared query.
 func (s *HTTPHandlers) preparedQueryGet(id string, resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	args := structs.PreparedQuerySpecificRequest{
@@ -303,6 +333,10 @@ func (s *HTTPHandlers) preparedQuerySpecificOptions(resp http.ResponseWriter, re
 		resp.Header().Add("Allow", strings.Join([]string{"OPTIONS", "GET"}, ","))
 		return resp
 
+	case strings.HasSuffix(path, "/failover-history"):
+		resp.Header().Add("Allow", strings.Join([]string{"OPTIONS", "GET"}, ","))
+		return resp
+
 	default:
 		resp.Header().Add("Allow", strings.Join([]string{"OPTIONS", "GET", "PUT", "DELETE"}, ","))
 		return resp
@@ -334,6 +368,13 @@ func (s *HTTPHandlers) PreparedQuerySpecific(resp http.ResponseWriter, req *http
 		id = strings.TrimSuffix(id, "/explain")
 		return s.preparedQueryExplain(id, resp, req)
 
+	case strings.HasSuffix(path, "/failover-history"):
+		if req.Method != "GET" {
+			return nil, MethodNotAllowedError{req.Method, []string{"GET"}}
+		}
+		id = strings.TrimSuffix(id, "/failover-history")
+		return s.preparedQueryFailoverHistory(id, resp, req)
+
 	default:
 		switch req.Method {
 		case "GET":
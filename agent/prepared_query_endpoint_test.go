@@ -1006,7 +1006,7 @@ func TestPreparedQuery_Integration(t *testing.T) {
 				Service: "my-service",
 			},
 		}
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			t.Fatalf("err: %v", err)
 		}
@@ -0,0 +1,58 @@
+package agent
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// QueryViewCreate registers a new server-side materialized view over a
+// service's health, filtered by a bexpr expression and optionally
+// projected down to a set of fields, and returns the handle clients should
+// use to fetch its results.
+func (s *HTTPHandlers) QueryViewCreate(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var body struct {
+		ServiceName string
+		Connect     bool
+		Filter      string
+		Fields      []string
+	}
+	if err := decodeBody(req.Body, &body); err != nil {
+		return nil, BadRequestError{Reason: err.Error()}
+	}
+
+	args := structs.QueryViewRegisterRequest{
+		ServiceName: body.ServiceName,
+		Connect:     body.Connect,
+		Filter:      body.Filter,
+		Fields:      body.Fields,
+	}
+	s.parseDC(req, &args.Datacenter)
+	s.parseToken(req, &args.Token)
+
+	var reply structs.QueryViewRegisterResponse
+	if err := s.agent.RPC("QueryView.Register", &args, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// QueryViewFetch fetches the current results of a view previously created
+// with QueryViewCreate, blocking per the usual semantics of a blocking
+// query if an index is supplied.
+func (s *HTTPHandlers) QueryViewFetch(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	args := structs.QueryViewFetchRequest{
+		Handle: strings.TrimPrefix(req.URL.Path, "/v1/query-view/"),
+	}
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.QueryViewFetchResponse
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC("QueryView.Fetch", &args, &reply); err != nil {
+		return nil, err
+	}
+	return reply.Results, nil
+}
@@ -0,0 +1,65 @@
+package agent
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryView_CreateAndFetch(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	args := &structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       "foo",
+		Address:    "127.0.0.1",
+		Service: &structs.NodeService{
+			ID:      "db",
+			Service: "db",
+			Tags:    []string{"primary"},
+		},
+		Check: &structs.HealthCheck{
+			Name:      "db connect",
+			Status:    api.HealthPassing,
+			ServiceID: "db",
+		},
+	}
+	var out struct{}
+	require.NoError(t, a.RPC("Catalog.Register", args, &out))
+
+	body, err := json.Marshal(map[string]interface{}{
+		"ServiceName": "db",
+		"Filter":      `"primary" in Service.Tags`,
+	})
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest("POST", "/v1/query-view", bytes.NewReader(body))
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.QueryViewCreate(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	created, ok := obj.(structs.QueryViewRegisterResponse)
+	require.True(t, ok)
+	require.NotEmpty(t, created.Handle)
+
+	req, _ = http.NewRequest("GET", "/v1/query-view/"+created.Handle, nil)
+	resp = httptest.NewRecorder()
+	obj, err = a.srv.QueryViewFetch(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	results, ok := obj.([]map[string]interface{})
+	require.True(t, ok)
+	require.Len(t, results, 1)
+}
@@ -0,0 +1,182 @@
+package agent
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/agent/config"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/agent/systemd"
+	"github.com/hashicorp/serf/serf"
+)
+
+// readyStage identifies a step a client agent must complete during startup
+// before it's considered ready, when gated by the wait_for_leader and/or
+// wait_for_acl options. Server agents always report readyStageComplete,
+// since readiness for them is already gated on Raft leadership elsewhere.
+type readyStage string
+
+const (
+	readyStageJoining    readyStage = "joining"
+	readyStageACLToken   readyStage = "resolving-acl-token"
+	readyStageComplete   readyStage = "complete"
+	readyStagePollPeriod            = 500 * time.Millisecond
+)
+
+// readinessGate tracks the startup stage of an agent that's configured to
+// delay readiness via wait_for_leader or wait_for_acl. It's read by the
+// agent's readiness endpoints and by Start to decide when it's safe to load
+// checks and start the proxy config manager.
+type readinessGate struct {
+	mu    sync.RWMutex
+	stage readyStage
+}
+
+// newReadinessGate returns a gate whose initial stage reflects which of the
+// wait_for_leader/wait_for_acl options are enabled. If neither is set the
+// gate starts out already complete.
+func newReadinessGate(waitForLeader, waitForACL bool) *readinessGate {
+	g := &readinessGate{stage: readyStageComplete}
+	switch {
+	case waitForLeader:
+		g.stage = readyStageJoining
+	case waitForACL:
+		g.stage = readyStageACLToken
+	}
+	return g
+}
+
+func (g *readinessGate) setStage(stage readyStage) {
+	g.mu.Lock()
+	g.stage = stage
+	g.mu.Unlock()
+}
+
+// Stage returns the current startup stage, for use by readiness endpoints.
+func (g *readinessGate) Stage() readyStage {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.stage
+}
+
+// Ready reports whether every configured gating stage has completed.
+func (g *readinessGate) Ready() bool {
+	return g.Stage() == readyStageComplete
+}
+
+// waitForStartupGates blocks, if necessary, until every startup stage this
+// agent was configured to gate on (wait_for_leader, wait_for_acl) has
+// completed, updating the readiness gate's stage as it goes. It's only
+// meaningful on client agents; Start never calls it for servers.
+func (a *Agent) waitForStartupGates(c *config.RuntimeConfig) {
+	if c.WaitForLeader {
+		a.readiness.setStage(readyStageJoining)
+		a.waitForLeader()
+	}
+	if c.WaitForACL {
+		a.readiness.setStage(readyStageACLToken)
+		a.waitForDefaultACLToken()
+	}
+	a.readiness.setStage(readyStageComplete)
+	if a.sdNotifier != nil {
+		if err := a.sdNotifier.Notify(systemd.Ready); err != nil {
+			a.logger.Debug("systemd notify failed", "error", err)
+		}
+	}
+}
+
+// waitForLeader blocks until the servers report a cluster leader, or the
+// agent starts shutting down.
+func (a *Agent) waitForLeader() {
+	for {
+		var leader string
+		args := structs.DCSpecificRequest{Datacenter: a.config.Datacenter}
+		if err := a.RPC("Status.Leader", &args, &leader); err == nil && leader != "" {
+			return
+		}
+		select {
+		case <-time.After(readyStagePollPeriod):
+		case <-a.shutdownCh:
+			return
+		}
+	}
+}
+
+// waitForDefaultACLToken blocks until the agent's default ACL token can be
+// resolved against the servers, or the agent starts shutting down. It
+// returns immediately when ACLs are disabled.
+func (a *Agent) waitForDefaultACLToken() {
+	if !a.config.ACLsEnabled {
+		return
+	}
+	for {
+		if _, err := a.resolveToken(a.tokens.AgentToken()); err == nil {
+			return
+		}
+		select {
+		case <-time.After(readyStagePollPeriod):
+		case <-a.shutdownCh:
+			return
+		}
+	}
+}
+
+// readyChecks reports the live result of each ready_check_* criterion this
+// agent is configured to evaluate, keyed by criterion name. Unlike the
+// startup gate, these run on every call to GET /v1/agent/ready, so a
+// criterion that was satisfied at startup but has since gone bad (the
+// agent lost its server connection, say) is reflected immediately.
+func (a *Agent) readyChecks(c *config.RuntimeConfig) map[string]bool {
+	checks := make(map[string]bool)
+	if c.ReadyCheckSerf {
+		checks["serf"] = a.serfIsAlive()
+	}
+	if c.ReadyCheckServer {
+		checks["server"] = a.serverIsConnected()
+	}
+	if c.ReadyCheckACL {
+		checks["acl"] = a.defaultACLTokenIsResolvable()
+	}
+	if c.ReadyCheckXDS {
+		checks["xds"] = a.xdsIsServing()
+	}
+	return checks
+}
+
+// serfIsAlive reports whether this agent's own LAN Serf membership is
+// currently alive.
+func (a *Agent) serfIsAlive() bool {
+	return a.delegate.LocalMember().Status == serf.StatusAlive
+}
+
+// serverIsConnected reports whether this agent has a usable connection to
+// a Consul server. Server agents are always connected to themselves; client
+// agents are connected if their RPC router currently knows of at least one
+// server. This deliberately doesn't require a leader to exist, unlike
+// GET /v1/status/leader, since a client can have perfectly healthy RPC
+// connectivity during a leader election.
+func (a *Agent) serverIsConnected() bool {
+	if a.config.ServerMode {
+		return true
+	}
+	known, err := strconv.ParseUint(a.delegate.Stats()["consul"]["known_servers"], 10, 64)
+	return err == nil && known > 0
+}
+
+// defaultACLTokenIsResolvable reports whether this agent's default ACL
+// token currently resolves against the servers. It's vacuously true when
+// ACLs are disabled.
+func (a *Agent) defaultACLTokenIsResolvable() bool {
+	if !a.config.ACLsEnabled {
+		return true
+	}
+	_, err := a.resolveToken(a.tokens.AgentToken())
+	return err == nil
+}
+
+// xdsIsServing reports whether this agent has started serving the xDS gRPC
+// API that connected Envoy proxies depend on.
+func (a *Agent) xdsIsServing() bool {
+	return a.grpcServer != nil
+}
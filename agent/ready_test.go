@@ -0,0 +1,24 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadinessGate(t *testing.T) {
+	g := newReadinessGate(false, false)
+	require.True(t, g.Ready())
+	require.Equal(t, readyStageComplete, g.Stage())
+
+	g = newReadinessGate(true, false)
+	require.False(t, g.Ready())
+	require.Equal(t, readyStageJoining, g.Stage())
+
+	g = newReadinessGate(false, true)
+	require.False(t, g.Ready())
+	require.Equal(t, readyStageACLToken, g.Stage())
+
+	g.setStage(readyStageComplete)
+	require.True(t, g.Ready())
+}
@@ -318,11 +318,11 @@ func (a *Agent) remoteExecWriteKey(event *remoteExecEvent, suffix string, val []
 		},
 	}
 	write.Token = a.tokens.AgentToken()
-	var success bool
-	if err := a.RPC("KVS.Apply", &write, &success); err != nil {
+	var out structs.KVSApplyResponse
+	if err := a.RPC("KVS.Apply", &write, &out); err != nil {
 		return err
 	}
-	if !success {
+	if !out.Success {
 		return fmt.Errorf("write failed")
 	}
 	return nil
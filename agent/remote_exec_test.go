@@ -387,8 +387,8 @@ func setKV(a *Agent, key string, val []byte, token string) error {
 			Token: token,
 		},
 	}
-	var success bool
-	if err := a.RPC("KVS.Apply", &write, &success); err != nil {
+	var out structs.KVSApplyResponse
+	if err := a.RPC("KVS.Apply", &write, &out); err != nil {
 		return err
 	}
 	return nil
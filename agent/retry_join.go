@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	discoverexec "github.com/hashicorp/consul/agent/discover/exec"
 	"github.com/hashicorp/consul/lib"
 	discover "github.com/hashicorp/go-discover"
 	discoverk8s "github.com/hashicorp/go-discover/provider/k8s"
@@ -109,6 +110,7 @@ func newDiscover() (*discover.Discover, error) {
 		providers[k] = v
 	}
 	providers["k8s"] = &discoverk8s.Provider{}
+	providers["exec"] = &discoverexec.Provider{}
 
 	return discover.New(
 		discover.WithUserAgent(lib.UserAgent()),
@@ -293,6 +293,20 @@ func (m *Manager) NumServers() int {
 	return len(l.servers)
 }
 
+// MaxSyncPacing returns the largest anti-entropy pacing hint advertised by
+// any known server, or zero if none have published one. Agents use this to
+// stagger a post-reconnect full sync instead of colliding with every other
+// agent that reconnected at the same time.
+func (m *Manager) MaxSyncPacing() time.Duration {
+	var max time.Duration
+	for _, server := range m.getServerList().servers {
+		if server.SyncPacing > max {
+			max = server.SyncPacing
+		}
+	}
+	return max
+}
+
 func (m *Manager) healthyServer(server *metadata.Server) bool {
 	// Check to see if the manager is trying to ping itself. This
 	// is a small optimization to avoid performing an unnecessary
@@ -7,8 +7,10 @@ import (
 )
 
 // EnforceACL takes an acl.Authorizer and returns the decision for whether the
-// event is allowed to be sent to this client or not.
-func enforceACL(authz acl.Authorizer, e stream.Event) acl.EnforcementDecision {
+// event is allowed to be sent to this client or not. It is exported so that
+// other consumers of streaming events, such as the HTTP subscribe endpoint,
+// can apply the same per-event filtering as the gRPC Subscribe RPC.
+func EnforceACL(authz acl.Authorizer, e stream.Event) acl.EnforcementDecision {
 	switch {
 	case e.IsEndOfSnapshot(), e.IsNewSnapshotToFollow():
 		return acl.Allow
@@ -17,6 +19,11 @@ func enforceACL(authz acl.Authorizer, e stream.Event) acl.EnforcementDecision {
 	switch p := e.Payload.(type) {
 	case state.EventPayloadCheckServiceNode:
 		return p.Value.CanRead(authz)
+	case state.EventPayloadIntention:
+		if p.Value.CanRead(authz) {
+			return acl.Allow
+		}
+		return acl.Deny
 	}
 	return acl.Deny
 }
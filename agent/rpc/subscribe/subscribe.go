@@ -134,7 +134,7 @@ func filterByAuth(authz acl.Authorizer, event stream.Event) (stream.Event, bool)
 		return event, true
 	}
 	fn := func(e stream.Event) bool {
-		return enforceACL(authz, e) == acl.Allow
+		return EnforceACL(authz, e) == acl.Allow
 	}
 	return event.Filter(fn)
 }
@@ -173,6 +173,13 @@ func setPayload(e *pbsubscribe.Event, payload interface{}) {
 				CheckServiceNode: pbservice.NewCheckServiceNodeFromStructs(p.Value),
 			},
 		}
+	case state.EventPayloadIntention:
+		e.Payload = &pbsubscribe.Event_IntentionMatch{
+			IntentionMatch: &pbsubscribe.IntentionMatchUpdate{
+				Op:        p.Op,
+				Intention: pbsubscribe.NewIntentionFromStructs(p.Value),
+			},
+		}
 	default:
 		panic(fmt.Sprintf("unexpected payload: %T: %#v", p, p))
 	}
@@ -446,7 +446,14 @@ type asyncRegisterRequest struct {
 }
 
 func makeConfigRequest(agent *Agent, registration *serviceRegistration) *structs.ServiceConfigRequest {
-	ns := registration.service
+	return makeConfigRequestForService(agent, registration.service, registration.token)
+}
+
+// makeConfigRequestForService builds a ServiceConfigRequest for resolving
+// the central config (service-defaults plus proxy-defaults) that applies to
+// ns. token, if non-empty, overrides the agent token used to make the
+// request.
+func makeConfigRequestForService(agent *Agent, ns *structs.NodeService, token string) *structs.ServiceConfigRequest {
 	name := ns.Service
 	var upstreams []structs.ServiceID
 
@@ -475,12 +482,58 @@ func makeConfigRequest(agent *Agent, registration *serviceRegistration) *structs
 		UpstreamIDs:    upstreams,
 		EnterpriseMeta: ns.EnterpriseMeta,
 	}
-	if registration.token != "" {
-		req.QueryOptions.Token = registration.token
+	if token != "" {
+		req.QueryOptions.Token = token
 	}
 	return req
 }
 
+// applyServiceDefaults fetches the service-defaults config entry (if any)
+// that applies to ns from the servers, and merges its Meta and check
+// Interval/Timeout defaults into ns and chkTypes in place. Values the caller
+// already set are never overridden. Unlike the continuous background merge
+// ServiceManager performs for sidecar proxies and gateways, this is a
+// one-shot lookup intended for the synchronous agent service registration
+// path, so that defaults live in the service-defaults config entry instead
+// of being repeated across every deployment manifest.
+func (a *Agent) applyServiceDefaults(ctx context.Context, token string, ns *structs.NodeService, chkTypes []*structs.CheckType) error {
+	if !a.config.EnableCentralServiceConfig {
+		return nil
+	}
+
+	req := makeConfigRequestForService(a, ns, token)
+
+	raw, _, err := a.cache.Get(ctx, cachetype.ResolvedServiceConfigName, req)
+	if err != nil {
+		return fmt.Errorf("could not resolve service_defaults config for service %q: %v", ns.Service, err)
+	}
+	defaults, ok := raw.(*structs.ServiceConfigResponse)
+	if !ok {
+		// This should never happen, but we want to protect against panics
+		return fmt.Errorf("internal error: response type not correct")
+	}
+
+	if len(defaults.Meta) > 0 && ns.Meta == nil {
+		ns.Meta = make(map[string]string, len(defaults.Meta))
+	}
+	for k, v := range defaults.Meta {
+		if _, ok := ns.Meta[k]; !ok {
+			ns.Meta[k] = v
+		}
+	}
+
+	for _, chk := range chkTypes {
+		if chk.Interval == 0 {
+			chk.Interval = defaults.CheckInterval
+		}
+		if chk.Timeout == 0 {
+			chk.Timeout = defaults.CheckTimeout
+		}
+	}
+
+	return nil
+}
+
 // mergeServiceConfig from service into defaults to produce the final effective
 // config for the watched service.
 func mergeServiceConfig(defaults *structs.ServiceConfigResponse, service *structs.NodeService) (*structs.NodeService, error) {
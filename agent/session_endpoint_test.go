@@ -83,7 +83,7 @@ func TestSessionCreate(t *testing.T) {
 	}
 
 	retry.Run(t, func(r *retry.R) {
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			r.Fatalf("err: %v", err)
 		}
@@ -141,7 +141,7 @@ func TestSessionCreate_NodeChecks(t *testing.T) {
 	}
 
 	retry.Run(t, func(r *retry.R) {
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			r.Fatalf("err: %v", err)
 		}
@@ -200,7 +200,7 @@ func TestSessionCreate_Delete(t *testing.T) {
 		},
 	}
 	retry.Run(t, func(r *retry.R) {
-		var out struct{}
+		var out structs.WriteIndexResponse
 		if err := a.RPC("Catalog.Register", args, &out); err != nil {
 			r.Fatalf("err: %v", err)
 		}
@@ -72,6 +72,19 @@ func NewBaseDeps(configLoader ConfigLoader, logOut io.Writer) (BaseDeps, error)
 		return d, fmt.Errorf("failed to setup node ID: %w", err)
 	}
 
+	if cfg.Telemetry.OTLPEndpoint != "" {
+		role := "client"
+		if cfg.ServerMode {
+			role = "server"
+		}
+		cfg.Telemetry.OTLPResourceAttributes = append([]string{
+			"service.name=consul",
+			fmt.Sprintf("node=%s", cfg.NodeName),
+			fmt.Sprintf("dc=%s", cfg.Datacenter),
+			fmt.Sprintf("role=%s", role),
+		}, cfg.Telemetry.OTLPResourceAttributes...)
+	}
+
 	d.MetricsHandler, err = lib.InitTelemetry(cfg.Telemetry)
 	if err != nil {
 		return d, fmt.Errorf("failed to initialize telemetry: %w", err)
@@ -0,0 +1,13 @@
+package agent
+
+import "os"
+
+// IsDebugDumpSignal reports whether sig is one of DebugDumpSignals.
+func IsDebugDumpSignal(sig os.Signal) bool {
+	for _, s := range DebugDumpSignals {
+		if s == sig {
+			return true
+		}
+	}
+	return false
+}
@@ -8,3 +8,7 @@ import (
 )
 
 var forwardSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// DebugDumpSignals are the OS signals that trigger a debug profile dump to
+// the data directory. SIGUSR1 isn't used for anything else in Consul.
+var DebugDumpSignals = []os.Signal{syscall.SIGUSR1}
@@ -7,3 +7,8 @@ import (
 )
 
 var forwardSignals = []os.Signal{os.Interrupt}
+
+// DebugDumpSignals are the OS signals that trigger a debug profile dump to
+// the data directory. Windows has no equivalent of SIGUSR1, so there are
+// none to register.
+var DebugDumpSignals []os.Signal
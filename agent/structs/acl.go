@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/lib"
 	"golang.org/x/crypto/blake2b"
+	"golang.org/x/sync/errgroup"
 )
 
 type ACLMode string
@@ -772,26 +773,47 @@ func (policies ACLPolicyListStubs) Sort() {
 	})
 }
 
+// resolveWithCacheConcurrency bounds how many policies resolveWithCache will
+// parse at once. Tokens can reference dozens of policies, and parsing the
+// HCL/JSON rules for each one is CPU-bound, so tokens with many policies
+// benefit from parsing them concurrently rather than one at a time on every
+// cache miss.
+const resolveWithCacheConcurrency = 16
+
 func (policies ACLPolicies) resolveWithCache(cache *ACLCaches, entConf *acl.Config) ([]*acl.Policy, error) {
-	// Parse the policies
-	parsed := make([]*acl.Policy, 0, len(policies))
-	for _, policy := range policies {
-		policy.SetHash(false)
-		cacheKey := fmt.Sprintf("%x", policy.Hash)
-		cachedPolicy := cache.GetParsedPolicy(cacheKey)
-		if cachedPolicy != nil {
-			// policies are content hashed so no need to check the age
-			parsed = append(parsed, cachedPolicy.Policy)
-			continue
-		}
+	// Parse the policies, bounded to resolveWithCacheConcurrency at a time.
+	parsed := make([]*acl.Policy, len(policies))
+
+	var g errgroup.Group
+	sem := make(chan struct{}, resolveWithCacheConcurrency)
+	for i, policy := range policies {
+		i, policy := i, policy
+
+		sem <- struct{}{}
+		g.Go(func() error {
+			defer func() { <-sem }()
+
+			policy.SetHash(false)
+			cacheKey := fmt.Sprintf("%x", policy.Hash)
+			if cachedPolicy := cache.GetParsedPolicy(cacheKey); cachedPolicy != nil {
+				// policies are content hashed so no need to check the age
+				parsed[i] = cachedPolicy.Policy
+				return nil
+			}
 
-		p, err := acl.NewPolicyFromSource(policy.ID, policy.ModifyIndex, policy.Rules, policy.Syntax, entConf, policy.EnterprisePolicyMeta())
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse %q: %v", policy.Name, err)
-		}
+			p, err := acl.NewPolicyFromSource(policy.ID, policy.ModifyIndex, policy.Rules, policy.Syntax, entConf, policy.EnterprisePolicyMeta())
+			if err != nil {
+				return fmt.Errorf("failed to parse %q: %v", policy.Name, err)
+			}
 
-		cache.PutParsedPolicy(cacheKey, p)
-		parsed = append(parsed, p)
+			cache.PutParsedPolicy(cacheKey, p)
+			parsed[i] = p
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
 	}
 
 	return parsed, nil
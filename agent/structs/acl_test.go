@@ -655,3 +655,35 @@ func TestStructs_ACLPolicies_Compile(t *testing.T) {
 		require.Equal(t, acl.Deny, authz.ACLRead(nil))
 	})
 }
+
+// manyTestPolicies returns n distinct policies, each with unique rule text
+// so that none of them collide in the parsed policy cache, to simulate a
+// token with many policies attached.
+func manyTestPolicies(n int) ACLPolicies {
+	policies := make(ACLPolicies, 0, n)
+	for i := 0; i < n; i++ {
+		policies = append(policies, &ACLPolicy{
+			ID:     fmt.Sprintf("%08x-0000-0000-0000-000000000000", i),
+			Name:   fmt.Sprintf("policy%d", i),
+			Rules:  fmt.Sprintf(`key_prefix "policy-%d/" { policy = "read" }`, i),
+			Syntax: acl.SyntaxCurrent,
+		})
+	}
+	return policies
+}
+
+func BenchmarkACLPolicies_resolveWithCache(b *testing.B) {
+	for _, n := range []int{1, 8, 30, 100} {
+		b.Run(fmt.Sprintf("%d policies", n), func(b *testing.B) {
+			policies := manyTestPolicies(n)
+			for i := 0; i < b.N; i++ {
+				cache, err := NewACLCaches(&ACLCachesConfig{ParsedPolicies: 0})
+				require.NoError(b, err)
+
+				if _, err := policies.resolveWithCache(cache, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
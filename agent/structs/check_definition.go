@@ -27,15 +27,21 @@ type CheckDefinition struct {
 	Header                         map[string][]string
 	Method                         string
 	Body                           string
+	HTTP2                          bool
+	HTTPReuseConnection            bool
 	TCP                            string
+	UDP                            string
+	ICMP                           string
 	Interval                       time.Duration
 	DockerContainerID              string
+	DockerContainerLabel           string
 	Shell                          string
 	GRPC                           string
 	GRPCUseTLS                     bool
 	TLSSkipVerify                  bool
 	AliasNode                      string
 	AliasService                   string
+	AliasServiceName               string
 	Timeout                        time.Duration
 	TTL                            time.Duration
 	SuccessBeforePassing           int
@@ -62,6 +68,7 @@ func (t *CheckDefinition) UnmarshalJSON(data []byte) (err error) {
 		ScriptArgsSnake                     []string    `json:"script_args"`
 		DeregisterCriticalServiceAfterSnake interface{} `json:"deregister_critical_service_after"`
 		DockerContainerIDSnake              string      `json:"docker_container_id"`
+		DockerContainerLabelSnake           string      `json:"docker_container_label"`
 		TLSSkipVerifySnake                  bool        `json:"tls_skip_verify"`
 		GRPCUseTLSSnake                     bool        `json:"grpc_use_tls"`
 		ServiceIDSnake                      string      `json:"service_id"`
@@ -87,6 +94,9 @@ func (t *CheckDefinition) UnmarshalJSON(data []byte) (err error) {
 	if t.DockerContainerID == "" {
 		t.DockerContainerID = aux.DockerContainerIDSnake
 	}
+	if t.DockerContainerLabel == "" {
+		t.DockerContainerLabel = aux.DockerContainerLabelSnake
+	}
 	if aux.TLSSkipVerifySnake {
 		t.TLSSkipVerify = aux.TLSSkipVerifySnake
 	}
@@ -171,16 +181,22 @@ func (c *CheckDefinition) CheckType() *CheckType {
 		ScriptArgs:                     c.ScriptArgs,
 		AliasNode:                      c.AliasNode,
 		AliasService:                   c.AliasService,
+		AliasServiceName:               c.AliasServiceName,
 		HTTP:                           c.HTTP,
 		GRPC:                           c.GRPC,
 		GRPCUseTLS:                     c.GRPCUseTLS,
 		Header:                         c.Header,
 		Method:                         c.Method,
 		Body:                           c.Body,
+		HTTP2:                          c.HTTP2,
+		HTTPReuseConnection:            c.HTTPReuseConnection,
 		OutputMaxSize:                  c.OutputMaxSize,
 		TCP:                            c.TCP,
+		UDP:                            c.UDP,
+		ICMP:                           c.ICMP,
 		Interval:                       c.Interval,
 		DockerContainerID:              c.DockerContainerID,
+		DockerContainerLabel:           c.DockerContainerLabel,
 		Shell:                          c.Shell,
 		TLSSkipVerify:                  c.TLSSkipVerify,
 		Timeout:                        c.Timeout,
@@ -12,10 +12,10 @@ import (
 type CheckTypes []*CheckType
 
 // CheckType is used to create either the CheckMonitor or the CheckTTL.
-// The following types are supported: Script, HTTP, TCP, Docker, TTL, GRPC, Alias. Script,
-// HTTP, Docker, TCP and GRPC all require Interval. Only one of the types may
+// The following types are supported: Script, HTTP, TCP, UDP, ICMP, Docker, TTL, GRPC, Alias. Script,
+// HTTP, Docker, TCP, UDP, ICMP and GRPC all require Interval. Only one of the types may
 // to be provided: TTL or Script/Interval or HTTP/Interval or TCP/Interval or
-// Docker/Interval or GRPC/Interval or AliasService.
+// UDP/Interval or ICMP/Interval or Docker/Interval or GRPC/Interval or AliasService.
 // Since types like CheckHTTP and CheckGRPC derive from CheckType, there are
 // helper conversion methods that do the reverse conversion. ie. checkHTTP.CheckType()
 type CheckType struct {
@@ -35,11 +35,17 @@ type CheckType struct {
 	Header                 map[string][]string
 	Method                 string
 	Body                   string
+	HTTP2                  bool
+	HTTPReuseConnection    bool
 	TCP                    string
+	UDP                    string
+	ICMP                   string
 	Interval               time.Duration
 	AliasNode              string
 	AliasService           string
+	AliasServiceName       string
 	DockerContainerID      string
+	DockerContainerLabel   string
 	Shell                  string
 	GRPC                   string
 	GRPCUseTLS             bool
@@ -75,6 +81,7 @@ func (t *CheckType) UnmarshalJSON(data []byte) (err error) {
 		ScriptArgsSnake                     []string    `json:"script_args"`
 		DeregisterCriticalServiceAfterSnake interface{} `json:"deregister_critical_service_after"`
 		DockerContainerIDSnake              string      `json:"docker_container_id"`
+		DockerContainerLabelSnake           string      `json:"docker_container_label"`
 		TLSSkipVerifySnake                  bool        `json:"tls_skip_verify"`
 		GRPCUseTLSSnake                     bool        `json:"grpc_use_tls"`
 
@@ -102,6 +109,9 @@ func (t *CheckType) UnmarshalJSON(data []byte) (err error) {
 	if t.DockerContainerID == "" {
 		t.DockerContainerID = aux.DockerContainerIDSnake
 	}
+	if t.DockerContainerLabel == "" {
+		t.DockerContainerLabel = aux.DockerContainerLabelSnake
+	}
 	if aux.TLSSkipVerifySnake {
 		t.TLSSkipVerify = aux.TLSSkipVerifySnake
 	}
@@ -156,13 +166,13 @@ func (t *CheckType) UnmarshalJSON(data []byte) (err error) {
 
 // Validate returns an error message if the check is invalid
 func (c *CheckType) Validate() error {
-	intervalCheck := c.IsScript() || c.HTTP != "" || c.TCP != "" || c.GRPC != ""
+	intervalCheck := c.IsScript() || c.HTTP != "" || c.TCP != "" || c.UDP != "" || c.ICMP != "" || c.GRPC != ""
 
 	if c.Interval > 0 && c.TTL > 0 {
 		return fmt.Errorf("Interval and TTL cannot both be specified")
 	}
 	if intervalCheck && c.Interval <= 0 {
-		return fmt.Errorf("Interval must be > 0 for Script, HTTP, or TCP checks")
+		return fmt.Errorf("Interval must be > 0 for Script, HTTP, TCP, UDP, or ICMP checks")
 	}
 	if intervalCheck && c.IsAlias() {
 		return fmt.Errorf("Interval cannot be set for Alias checks")
@@ -186,7 +196,7 @@ func (c *CheckType) Empty() bool {
 
 // IsAlias checks if this is an alias check.
 func (c *CheckType) IsAlias() bool {
-	return c.AliasNode != "" || c.AliasService != ""
+	return c.AliasNode != "" || c.AliasService != "" || c.AliasServiceName != ""
 }
 
 // IsScript checks if this is a check that execs some kind of script.
@@ -214,9 +224,22 @@ func (c *CheckType) IsTCP() bool {
 	return c.TCP != "" && c.Interval > 0
 }
 
-// IsDocker returns true when checking a docker container.
+// IsUDP checks if this is a UDP type
+func (c *CheckType) IsUDP() bool {
+	return c.UDP != "" && c.Interval > 0
+}
+
+// IsICMP checks if this is an ICMP type
+func (c *CheckType) IsICMP() bool {
+	return c.ICMP != "" && c.Interval > 0
+}
+
+// IsDocker returns true when checking a docker container. The container may
+// be identified by a fixed ID or by a label, which is re-resolved to the
+// current container ID on every check so the check keeps working across
+// container restarts and redeployments.
 func (c *CheckType) IsDocker() bool {
-	return c.IsScript() && c.DockerContainerID != "" && c.Interval > 0
+	return c.IsScript() && (c.DockerContainerID != "" || c.DockerContainerLabel != "") && c.Interval > 0
 }
 
 // IsGRPC checks if this is a GRPC type
@@ -234,6 +257,10 @@ func (c *CheckType) Type() string {
 		return "ttl"
 	case c.IsTCP():
 		return "tcp"
+	case c.IsUDP():
+		return "udp"
+	case c.IsICMP():
+		return "icmp"
 	case c.IsAlias():
 		return "alias"
 	case c.IsDocker():
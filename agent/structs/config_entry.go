@@ -2,6 +2,7 @@ package structs
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -92,6 +93,33 @@ type ServiceConfigEntry struct {
 	//
 	// Connect ConnectConfiguration
 
+	// TagSchema, when set, constrains the tags that instances of this
+	// service may register with. Registration requests carrying tags
+	// outside the schema are rejected by the servers; existing
+	// registrations are left alone until the next re-registration, so
+	// operators should check ConfigEntry.TagConformance before tightening
+	// an existing schema.
+	TagSchema *ServiceTagSchema `json:",omitempty" alias:"tag_schema"`
+
+	// CheckInterval and CheckTimeout default the Interval and Timeout of any
+	// check registered against a service of this name that does not set its
+	// own value, so operators can set these once instead of repeating them in
+	// every service definition.
+	CheckInterval time.Duration `json:",omitempty" alias:"check_interval"`
+	CheckTimeout  time.Duration `json:",omitempty" alias:"check_timeout"`
+
+	// MinHealthyInstances, when set above zero, guards against deregistration
+	// and maintenance-mode requests that would drop the number of passing
+	// instances of this service below the threshold. Callers can override the
+	// guard by passing force=true to those agent endpoints, since the agent
+	// can't otherwise know that a rolling deploy's replacement instance is
+	// already on its way.
+	MinHealthyInstances int `json:",omitempty" alias:"min_healthy_instances"`
+
+	// Meta is merged into the Meta of any service of this name that is
+	// registered without already setting the same key, so common metadata
+	// (e.g. team ownership) can live in one place instead of every
+	// deployment manifest.
 	Meta           map[string]string `json:",omitempty"`
 	EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
 	RaftIndex
@@ -136,7 +164,13 @@ func (e *ServiceConfigEntry) Normalize() error {
 }
 
 func (e *ServiceConfigEntry) Validate() error {
-	return validateConfigEntryMeta(e.Meta)
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+	if e.MinHealthyInstances < 0 {
+		return fmt.Errorf("MinHealthyInstances must be >= 0")
+	}
+	return e.TagSchema.Validate()
 }
 
 func (e *ServiceConfigEntry) CanRead(authz acl.Authorizer) bool {
@@ -171,6 +205,112 @@ type ConnectConfiguration struct {
 	SidecarProxy bool
 }
 
+// ServiceTagSchema constrains the set of tags a service instance may
+// register with. AllowedTags and Pattern are mutually exclusive; at most
+// one may be set.
+type ServiceTagSchema struct {
+	// AllowedTags, if non-empty, is the exact set of tags a service
+	// instance may register with. Any tag not in this list is rejected.
+	AllowedTags []string `json:",omitempty" alias:"allowed_tags"`
+
+	// Pattern, if set, is a regular expression that every tag must
+	// match. The match is unanchored: a tag conforms if any part of it
+	// matches, so ^ and $ are needed to constrain the whole tag.
+	Pattern string `json:",omitempty"`
+
+	re *regexp.Regexp
+}
+
+// Validate compiles Pattern (if set) and checks that AllowedTags and
+// Pattern aren't both configured. It is safe to call on a nil schema.
+func (s *ServiceTagSchema) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if len(s.AllowedTags) > 0 && s.Pattern != "" {
+		return fmt.Errorf("tag_schema: allowed_tags and pattern are mutually exclusive")
+	}
+	if s.Pattern != "" {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("tag_schema: invalid pattern: %v", err)
+		}
+		s.re = re
+	}
+	return nil
+}
+
+// NonConformingTags returns the subset of tags that don't satisfy the
+// schema. It returns nil if the schema is nil, unset, or every tag
+// conforms.
+func (s *ServiceTagSchema) NonConformingTags(tags []string) []string {
+	if s == nil || (len(s.AllowedTags) == 0 && s.Pattern == "") {
+		return nil
+	}
+
+	var bad []string
+	for _, tag := range tags {
+		if s.conforms(tag) {
+			continue
+		}
+		bad = append(bad, tag)
+	}
+	return bad
+}
+
+func (s *ServiceTagSchema) conforms(tag string) bool {
+	if len(s.AllowedTags) > 0 {
+		for _, allowed := range s.AllowedTags {
+			if tag == allowed {
+				return true
+			}
+		}
+		return false
+	}
+	if s.Pattern != "" {
+		re := s.re
+		if re == nil {
+			// Validate wasn't called first (e.g. a hand-built schema in
+			// a test) - fall back to compiling on demand.
+			var err error
+			re, err = regexp.Compile(s.Pattern)
+			if err != nil {
+				return false
+			}
+		}
+		return re.MatchString(tag)
+	}
+	return true
+}
+
+// ServiceTagConformanceRequest requests a report of the instances of a
+// service whose tags don't satisfy the tag schema configured on its
+// service-defaults config entry, if any. It's intended to let operators
+// check what would break before tightening or introducing a schema.
+type ServiceTagConformanceRequest struct {
+	Datacenter  string
+	ServiceName string
+	EnterpriseMeta
+	QueryOptions
+}
+
+func (r *ServiceTagConformanceRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// ServiceTagConformanceEntry describes a single service instance whose
+// registered tags don't satisfy the configured tag schema.
+type ServiceTagConformanceEntry struct {
+	Node      string
+	ServiceID string
+	Tags      []string
+}
+
+type ServiceTagConformanceResponse struct {
+	NonConforming []ServiceTagConformanceEntry
+	QueryMeta
+}
+
 // ProxyConfigEntry is the top-level struct for global proxy configuration defaults.
 type ProxyConfigEntry struct {
 	Kind        string
@@ -379,6 +519,13 @@ type ConfigEntryRequest struct {
 	Datacenter string
 	Entry      ConfigEntry
 
+	// Author and Timestamp identify who made this change and when, for the
+	// benefit of the config entry audit log. They are filled in by the
+	// leader before the request is raft-applied so that every replica
+	// records an identical audit entry.
+	Author    string
+	Timestamp time.Time
+
 	WriteRequest
 }
 
@@ -613,6 +760,21 @@ type ServiceConfigResponse struct {
 	UpstreamIDConfigs UpstreamConfigs
 	MeshGateway       MeshGatewayConfig `json:",omitempty"`
 	Expose            ExposeConfig      `json:",omitempty"`
+
+	// CheckInterval and CheckTimeout are the service-defaults defaults for
+	// any check registered against this service that does not set its own
+	// value. They are zero when no service-defaults entry sets them.
+	CheckInterval time.Duration `json:",omitempty"`
+	CheckTimeout  time.Duration `json:",omitempty"`
+
+	// MinHealthyInstances is the service-defaults minimum healthy instance
+	// guard for this service. It is zero when no service-defaults entry sets
+	// it, which means the guard is disabled.
+	MinHealthyInstances int `json:",omitempty"`
+
+	// Meta is merged into the Meta of the service being registered,
+	// without overriding any key the service already sets.
+	Meta map[string]string `json:",omitempty"`
 	QueryMeta
 }
 
@@ -620,6 +782,10 @@ func (r *ServiceConfigResponse) Reset() {
 	r.ProxyConfig = nil
 	r.UpstreamConfigs = nil
 	r.MeshGateway = MeshGatewayConfig{}
+	r.CheckInterval = 0
+	r.CheckTimeout = 0
+	r.MinHealthyInstances = 0
+	r.Meta = nil
 }
 
 // MarshalBinary writes ServiceConfigResponse as msgpack encoded. It's only here
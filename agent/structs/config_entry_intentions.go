@@ -66,18 +66,20 @@ func (e *ServiceIntentionsConfigEntry) ToIntention(src *SourceIntention) *Intent
 	}
 
 	ixn := &Intention{
-		ID:              src.LegacyID,
-		Description:     src.Description,
-		SourceNS:        src.NamespaceOrDefault(),
-		SourceName:      src.Name,
-		SourceType:      src.Type,
-		Action:          src.Action,
-		Permissions:     src.Permissions,
-		Meta:            meta,
-		Precedence:      src.Precedence,
-		DestinationNS:   e.NamespaceOrDefault(),
-		DestinationName: e.Name,
-		RaftIndex:       e.RaftIndex,
+		ID:               src.LegacyID,
+		Description:      src.Description,
+		SourceNS:         src.NamespaceOrDefault(),
+		SourceName:       src.Name,
+		SourceType:       src.Type,
+		SourceAuthMethod: src.SourceAuthMethod,
+		SourceSelector:   src.SourceSelector,
+		Action:           src.Action,
+		Permissions:      src.Permissions,
+		Meta:             meta,
+		Precedence:       src.Precedence,
+		DestinationNS:    e.NamespaceOrDefault(),
+		DestinationName:  e.Name,
+		RaftIndex:        e.RaftIndex,
 	}
 	if src.LegacyCreateTime != nil {
 		ixn.CreatedAt = *src.LegacyCreateTime
@@ -130,6 +132,10 @@ type SourceIntention struct {
 	//
 	// The source may also be a non-Consul service, as specified by SourceType.
 	//
+	// Name must be omitted when Type is IntentionSourceAuthMethod, since
+	// that kind of source is identified by SourceAuthMethod/SourceSelector
+	// instead of a service name.
+	//
 	// formerly Intention.SourceName
 	Name string
 
@@ -179,6 +185,19 @@ type SourceIntention struct {
 	// formerly Intention.SourceType
 	Type IntentionSourceType
 
+	// SourceAuthMethod is the name of the ACL auth method that a source of
+	// Type IntentionSourceAuthMethod must have logged in through. It is
+	// required (and only valid) when Type is IntentionSourceAuthMethod.
+	SourceAuthMethod string `json:",omitempty"`
+
+	// SourceSelector further narrows down which identities logged in via
+	// SourceAuthMethod this source matches. It uses the same expression
+	// syntax as ACLBindingRule.Selector and is evaluated against the
+	// identity attributes bound from that login. It is only valid when
+	// Type is IntentionSourceAuthMethod, and an empty value matches any
+	// identity that used that auth method.
+	SourceSelector string `json:",omitempty"`
+
 	// Description is a human-friendly description of this intention.
 	// It is opaque to Consul and is only stored and transferred in API
 	// requests.
@@ -507,13 +526,30 @@ func (e *ServiceIntentionsConfigEntry) validate(legacyWrite bool) error {
 	}
 
 	seenSources := make(map[ServiceName]struct{})
+	seenAuthMethodSources := make(map[string]struct{})
 	for i, src := range e.Sources {
-		if src.Name == "" {
-			return fmt.Errorf("Sources[%d].Name is required", i)
-		}
+		if src.Type == IntentionSourceAuthMethod {
+			if legacyWrite {
+				return fmt.Errorf("Sources[%d].Type must be set to 'consul' for legacy intention writes", i)
+			}
+			if src.Name != "" {
+				return fmt.Errorf("Sources[%d].Name must be omitted when Type is %q", i, IntentionSourceAuthMethod)
+			}
+			if src.SourceAuthMethod == "" {
+				return fmt.Errorf("Sources[%d].SourceAuthMethod is required when Type is %q", i, IntentionSourceAuthMethod)
+			}
+		} else {
+			if src.Name == "" {
+				return fmt.Errorf("Sources[%d].Name is required", i)
+			}
+
+			if err := validateIntentionWildcards(src.Name, &src.EnterpriseMeta); err != nil {
+				return fmt.Errorf("Sources[%d].%v", i, err)
+			}
 
-		if err := validateIntentionWildcards(src.Name, &src.EnterpriseMeta); err != nil {
-			return fmt.Errorf("Sources[%d].%v", i, err)
+			if src.SourceAuthMethod != "" || src.SourceSelector != "" {
+				return fmt.Errorf("Sources[%d].SourceAuthMethod and SourceSelector may only be set when Type is %q", i, IntentionSourceAuthMethod)
+			}
 		}
 
 		// Length of opaque values
@@ -575,9 +611,9 @@ func (e *ServiceIntentionsConfigEntry) validate(legacyWrite bool) error {
 		}
 
 		switch src.Type {
-		case IntentionSourceConsul:
+		case IntentionSourceConsul, IntentionSourceAuthMethod:
 		default:
-			return fmt.Errorf("Sources[%d].Type must be set to 'consul'", i)
+			return fmt.Errorf("Sources[%d].Type must be set to 'consul' or %q", i, IntentionSourceAuthMethod)
 		}
 
 		for j, perm := range src.Permissions {
@@ -668,11 +704,19 @@ func (e *ServiceIntentionsConfigEntry) validate(legacyWrite bool) error {
 			}
 		}
 
-		serviceName := src.SourceServiceName()
-		if _, exists := seenSources[serviceName]; exists {
-			return fmt.Errorf("Sources[%d] defines %q more than once", i, serviceName.String())
+		if src.Type == IntentionSourceAuthMethod {
+			key := src.SourceAuthMethod + "/" + src.SourceSelector
+			if _, exists := seenAuthMethodSources[key]; exists {
+				return fmt.Errorf("Sources[%d] defines auth method %q with selector %q more than once", i, src.SourceAuthMethod, src.SourceSelector)
+			}
+			seenAuthMethodSources[key] = struct{}{}
+		} else {
+			serviceName := src.SourceServiceName()
+			if _, exists := seenSources[serviceName]; exists {
+				return fmt.Errorf("Sources[%d] defines %q more than once", i, serviceName.String())
+			}
+			seenSources[serviceName] = struct{}{}
 		}
-		seenSources[serviceName] = struct{}{}
 	}
 
 	return nil
@@ -105,6 +105,82 @@ func TestServiceIntentionsConfigEntry(t *testing.T) {
 			},
 			validateErr: `Sources[0].Name: wildcard character '*' cannot be used with partial values`,
 		},
+		"auth-method source missing SourceAuthMethod": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Sources: []*SourceIntention{
+					{
+						Type:   IntentionSourceAuthMethod,
+						Action: IntentionActionAllow,
+					},
+				},
+			},
+			validateErr: `Sources[0].SourceAuthMethod is required when Type is "auth-method"`,
+		},
+		"auth-method source with Name set": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Sources: []*SourceIntention{
+					{
+						Name:             "foo",
+						Type:             IntentionSourceAuthMethod,
+						SourceAuthMethod: "minikube",
+						Action:           IntentionActionAllow,
+					},
+				},
+			},
+			validateErr: `Sources[0].Name must be omitted when Type is "auth-method"`,
+		},
+		"consul source with SourceAuthMethod set": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Sources: []*SourceIntention{
+					{
+						Name:             "foo",
+						Type:             IntentionSourceConsul,
+						SourceAuthMethod: "minikube",
+						Action:           IntentionActionAllow,
+					},
+				},
+			},
+			validateErr: `Sources[0].SourceAuthMethod and SourceSelector may only be set when Type is "auth-method"`,
+		},
+		"auth-method source is valid": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Sources: []*SourceIntention{
+					{
+						Type:             IntentionSourceAuthMethod,
+						SourceAuthMethod: "minikube",
+						SourceSelector:   `serviceaccount.namespace==default`,
+						Action:           IntentionActionAllow,
+					},
+				},
+			},
+		},
+		"duplicate auth-method sources": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Sources: []*SourceIntention{
+					{
+						Type:             IntentionSourceAuthMethod,
+						SourceAuthMethod: "minikube",
+						Action:           IntentionActionAllow,
+					},
+					{
+						Type:             IntentionSourceAuthMethod,
+						SourceAuthMethod: "minikube",
+						Action:           IntentionActionDeny,
+					},
+				},
+			},
+			validateErr: `Sources[1] defines auth method "minikube" with selector "" more than once`,
+		},
 		"description too long": {
 			entry: &ServiceIntentionsConfigEntry{
 				Kind: ServiceIntentions,
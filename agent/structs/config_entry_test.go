@@ -1330,7 +1330,112 @@ func TestConfigEntryResponseMarshalling(t *testing.T) {
 	}
 }
 
+func TestServiceConfigEntry_Validate_MinHealthyInstances(t *testing.T) {
+	cases := map[string]struct {
+		entry     *ServiceConfigEntry
+		expectErr string
+	}{
+		"zero is allowed": {
+			entry: &ServiceConfigEntry{Kind: ServiceDefaults, Name: "web"},
+		},
+		"positive is allowed": {
+			entry: &ServiceConfigEntry{Kind: ServiceDefaults, Name: "web", MinHealthyInstances: 3},
+		},
+		"negative is rejected": {
+			entry:     &ServiceConfigEntry{Kind: ServiceDefaults, Name: "web", MinHealthyInstances: -1},
+			expectErr: "MinHealthyInstances must be >= 0",
+		},
+	}
+
+	for name, tcase := range cases {
+		tcase := tcase
+		t.Run(name, func(t *testing.T) {
+			err := tcase.entry.Validate()
+			if tcase.expectErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				requireContainsLower(t, err.Error(), tcase.expectErr)
+			}
+		})
+	}
+}
+
 func requireContainsLower(t *testing.T, haystack, needle string) {
 	t.Helper()
 	require.Contains(t, strings.ToLower(haystack), strings.ToLower(needle))
 }
+
+func TestServiceTagSchema_Validate(t *testing.T) {
+	cases := map[string]struct {
+		schema *ServiceTagSchema
+		err    string
+	}{
+		"nil schema":            {schema: nil},
+		"allowed tags only":     {schema: &ServiceTagSchema{AllowedTags: []string{"primary", "canary"}}},
+		"pattern only":          {schema: &ServiceTagSchema{Pattern: `^v\d+$`}},
+		"both set": {
+			schema: &ServiceTagSchema{AllowedTags: []string{"primary"}, Pattern: `^v\d+$`},
+			err:    "mutually exclusive",
+		},
+		"bad pattern": {
+			schema: &ServiceTagSchema{Pattern: `(`},
+			err:    "invalid pattern",
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.schema.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.err)
+			}
+		})
+	}
+}
+
+func TestServiceTagSchema_NonConformingTags(t *testing.T) {
+	cases := map[string]struct {
+		schema *ServiceTagSchema
+		tags   []string
+		exp    []string
+	}{
+		"nil schema allows anything": {
+			schema: nil,
+			tags:   []string{"anything"},
+			exp:    nil,
+		},
+		"unset schema allows anything": {
+			schema: &ServiceTagSchema{},
+			tags:   []string{"anything"},
+			exp:    nil,
+		},
+		"allowed tags all conform": {
+			schema: &ServiceTagSchema{AllowedTags: []string{"primary", "canary"}},
+			tags:   []string{"primary"},
+			exp:    nil,
+		},
+		"allowed tags rejects unknown": {
+			schema: &ServiceTagSchema{AllowedTags: []string{"primary", "canary"}},
+			tags:   []string{"primary", "rogue"},
+			exp:    []string{"rogue"},
+		},
+		"pattern rejects non-matching": {
+			schema: &ServiceTagSchema{Pattern: `^v\d+$`},
+			tags:   []string{"v1", "latest"},
+			exp:    []string{"latest"},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, tc.schema.Validate())
+			require.Equal(t, tc.exp, tc.schema.NonConformingTags(tc.tags))
+		})
+	}
+}
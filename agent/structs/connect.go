@@ -15,6 +15,21 @@ type ConnectAuthorizeRequest struct {
 	// ClientCertSerial is a colon-hex-encoded of the serial number for
 	// the requesting client cert. This is used to check against revocation
 	// lists.
+	//
+	// These are mutually exclusive with SourceAuthMethod: a request
+	// identifies its source either by mTLS client certificate or by auth
+	// method login, never both.
 	ClientCertURI    string
 	ClientCertSerial string
+
+	// SourceAuthMethod is the name of the auth method the requesting
+	// client authenticated through, for callers (such as API gateways)
+	// that front a non-mTLS caller rather than presenting a Consul
+	// service identity. When set, ClientCertURI/ClientCertSerial must be
+	// empty and SourceSelectorVars should carry the identity attributes
+	// to evaluate against any matching intention's SourceSelector.
+	//
+	// See SourceAuthMethod on Intention for more details.
+	SourceAuthMethod   string            `json:",omitempty"`
+	SourceSelectorVars map[string]string `json:",omitempty"`
 }
@@ -119,6 +119,18 @@ type CARoot struct {
 	// certificate to infer the type.
 	PrivateKeyBits int
 
+	// RevokedSerials is a list of hex-encoded x509 serial numbers of leaf
+	// certificates issued by this root that have been revoked before their
+	// TTL expired. It is distributed to Connect proxies as a CRL so they can
+	// reject compromised workloads immediately rather than waiting out the
+	// leaf cert's TTL.
+	RevokedSerials []string `json:",omitempty"`
+
+	// CRLPEM is a PEM-encoded CRL signed by this root covering RevokedSerials.
+	// It is only populated when the active CA provider is able to sign a CRL
+	// on demand (see connect/ca.CRLGenerator).
+	CRLPEM string `json:",omitempty"`
+
 	RaftIndex
 }
 
@@ -221,6 +233,26 @@ func (q *CARequest) RequestDatacenter() string {
 	return q.Datacenter
 }
 
+// CARevokeLeafRequest is used to revoke a leaf certificate issued by the
+// active CA root before its TTL expires.
+type CARevokeLeafRequest struct {
+	// Datacenter is the target for this request.
+	Datacenter string
+
+	// SerialNumber is the hex-encoded x509 serial number of the leaf
+	// certificate to revoke.
+	SerialNumber string
+
+	// WriteRequest is a common struct containing ACL tokens and other
+	// write-related common elements for requests.
+	WriteRequest
+}
+
+// RequestDatacenter returns the datacenter for a given request.
+func (q *CARevokeLeafRequest) RequestDatacenter() string {
+	return q.Datacenter
+}
+
 const (
 	ConsulCAProvider = "consul"
 	VaultCAProvider  = "vault"
@@ -454,8 +486,10 @@ type VaultCAProviderConfig struct {
 
 	Address             string
 	Token               string
+	Namespace           string `mapstructure:"Namespace"`
 	RootPKIPath         string
 	IntermediatePKIPath string
+	AuthMethod          *VaultAuthMethod
 
 	CAFile        string
 	CAPath        string
@@ -465,6 +499,22 @@ type VaultCAProviderConfig struct {
 	TLSSkipVerify bool
 }
 
+// VaultAuthMethod is used to log into Vault using a non-token auth method,
+// such as AppRole or Kubernetes, instead of a long-lived static token.
+type VaultAuthMethod struct {
+	// Type is the Vault auth method type, e.g. "approle" or "kubernetes".
+	Type string
+
+	// MountPath is the path the auth method is mounted at. Defaults to
+	// "auth/<Type>" when empty.
+	MountPath string
+
+	// Params are passed as the request body to the auth method's login
+	// endpoint, e.g. role_id/secret_id for AppRole or role/jwt for
+	// Kubernetes.
+	Params map[string]interface{}
+}
+
 type AWSCAProviderConfig struct {
 	CommonCAProviderConfig `mapstructure:",squash"`
 
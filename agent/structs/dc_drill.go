@@ -0,0 +1,63 @@
+package structs
+
+import "time"
+
+// DatacenterDrillOp is the operation requested of Operator.DatacenterDrill.
+type DatacenterDrillOp string
+
+const (
+	// DatacenterDrillStart begins treating the target datacenter as
+	// unreachable for the given duration.
+	DatacenterDrillStart DatacenterDrillOp = "start"
+
+	// DatacenterDrillStop ends a drill early, regardless of how much of
+	// its duration remains.
+	DatacenterDrillStop DatacenterDrillOp = "stop"
+)
+
+// DatacenterDrillRequest starts or stops a failover drill against a single
+// remote datacenter. While a drill against a datacenter is active, servers
+// in this datacenter treat it as unreachable for prepared query failover
+// and for the other-datacenters list that mesh gateways use to discover
+// federation peers, without making any change to real networking.
+type DatacenterDrillRequest struct {
+	Op DatacenterDrillOp
+
+	// Datacenter is always the local datacenter this request targets, per
+	// the RequestDatacenter convention; it is not the drilled datacenter.
+	Datacenter string
+
+	// TargetDatacenter is the remote datacenter to simulate as unreachable.
+	TargetDatacenter string
+
+	// Duration bounds how long the drill lasts. The leader converts it to
+	// an absolute ExpiresAt before calling raftApply so that all servers
+	// agree on when the drill ends regardless of when they process the
+	// write.
+	Duration time.Duration
+
+	// ExpiresAt is set by the leader from Duration before the request is
+	// applied to the Raft log; it is not set by callers.
+	ExpiresAt time.Time
+
+	WriteRequest
+}
+
+func (r *DatacenterDrillRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// DatacenterDrill describes an in-progress failover drill against a single
+// remote datacenter.
+type DatacenterDrill struct {
+	TargetDatacenter string
+	ExpiresAt        time.Time
+
+	RaftIndex
+}
+
+// DatacenterDrillsResponse is the result of a Operator.DatacenterDrills query.
+type DatacenterDrillsResponse struct {
+	Drills []*DatacenterDrill
+	QueryMeta
+}
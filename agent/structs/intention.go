@@ -54,6 +54,12 @@ type Intention struct {
 	// SourceType is the type of the value for the source.
 	SourceType IntentionSourceType
 
+	// SourceAuthMethod and SourceSelector identify the source when
+	// SourceType is IntentionSourceAuthMethod, in which case SourceNS and
+	// SourceName are unused. See SourceIntention for details.
+	SourceAuthMethod string `json:",omitempty"`
+	SourceSelector   string `json:",omitempty"`
+
 	// Action is whether this is an allowlist or denylist intention.
 	Action IntentionAction `json:",omitempty"`
 
@@ -448,6 +454,8 @@ func (x *Intention) ToSourceIntention(legacy bool) *SourceIntention {
 		Precedence:       0,   // Ignore, let it be computed.
 		LegacyID:         x.ID,
 		Type:             x.SourceType,
+		SourceAuthMethod: x.SourceAuthMethod,
+		SourceSelector:   x.SourceSelector,
 		Description:      x.Description,
 		LegacyMeta:       x.Meta,
 		LegacyCreateTime: nil, // Ignore
@@ -474,6 +482,14 @@ type IntentionSourceType string
 const (
 	// IntentionSourceConsul is a service within the Consul catalog.
 	IntentionSourceConsul IntentionSourceType = "consul"
+
+	// IntentionSourceAuthMethod represents any workload that authenticated
+	// via a given ACL auth method, optionally narrowed down with a
+	// Selector expression evaluated against the identity bound from that
+	// login (see SourceAuthMethod/SourceSelector on Intention). Unlike
+	// IntentionSourceConsul, this kind of source isn't tied to a single
+	// catalog service name.
+	IntentionSourceAuthMethod IntentionSourceType = "auth-method"
 )
 
 // Intentions is a list of intentions.
@@ -534,6 +550,44 @@ func (q *IntentionRequest) RequestDatacenter() string {
 	return q.Datacenter
 }
 
+// IntentionsReconcileRequest declaratively replaces the complete set of
+// intention sources for a single destination service in one transactional
+// write. The caller supplies the full desired set of Sources; Intention.Reconcile
+// computes the add/update/remove diff against what is currently stored and
+// applies it as a single config entry write, so GitOps-style tooling doesn't
+// need to fetch the existing sources and diff against them itself.
+type IntentionsReconcileRequest struct {
+	// Datacenter is the target for this request.
+	Datacenter string
+
+	// Destination is the service that Sources applies to.
+	Destination ServiceName
+
+	// Sources is the complete desired set of sources for Destination. Any
+	// existing source not present here is removed, any source present here
+	// that doesn't already exist is created, and any source present in both
+	// is updated in place.
+	Sources []*SourceIntention
+
+	// WriteRequest is a common struct containing ACL tokens and other
+	// write-related common elements for requests.
+	WriteRequest
+}
+
+// RequestDatacenter returns the datacenter for a given request.
+func (q *IntentionsReconcileRequest) RequestDatacenter() string {
+	return q.Datacenter
+}
+
+// IntentionsReconcileResponse reports what Intention.Reconcile actually
+// changed, since the request only describes the desired end state rather
+// than the operations needed to reach it.
+type IntentionsReconcileResponse struct {
+	Added   []string
+	Updated []string
+	Removed []string
+}
+
 // IntentionMatchType is the target for a match request. For example,
 // matching by source will look for all intentions that match the given
 // source value.
@@ -622,11 +676,27 @@ type IntentionQueryCheck struct {
 	// SourceNS, SourceName, DestinationNS, and DestinationName are the
 	// source and namespace, respectively, for the test. These must be
 	// exact values.
+	//
+	// SourceNS and SourceName are unused when SourceType is
+	// IntentionSourceAuthMethod; use SourceAuthMethod and
+	// SourceSelectorVars instead.
 	SourceNS, SourceName           string
 	DestinationNS, DestinationName string
 
 	// SourceType is the type of the value for the source.
 	SourceType IntentionSourceType
+
+	// SourceAuthMethod is the name of the auth method the hypothetical
+	// source is being tested as having logged in through. Only used (and
+	// required) when SourceType is IntentionSourceAuthMethod.
+	SourceAuthMethod string `json:",omitempty"`
+
+	// SourceSelectorVars are the identity attributes of the hypothetical
+	// source, evaluated against any matching intention's SourceSelector
+	// expression. Only used when SourceType is IntentionSourceAuthMethod.
+	// As a flat map, keys can't contain the "." separator that selectors
+	// on nested fields (like auth method binding rules) normally use.
+	SourceSelectorVars map[string]string `json:",omitempty"`
 }
 
 // GetACLPrefix returns the prefix to look up the ACL policy for this
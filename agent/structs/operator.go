@@ -2,6 +2,7 @@ package structs
 
 import (
 	"net"
+	"time"
 
 	"github.com/hashicorp/consul/agent/consul/autopilot"
 	"github.com/hashicorp/raft"
@@ -102,3 +103,237 @@ type NetworkSegment struct {
 	// for this segment.
 	RPCListener bool
 }
+
+// FeatureRolloutStatus describes the cluster-wide activation state of a
+// single capability that requires every server in the datacenter to
+// support it before it can be turned on.
+type FeatureRolloutStatus struct {
+	// Name identifies the feature.
+	Name string
+
+	// Enabled is true once the feature has been activated in this
+	// datacenter. Once true it never reverts to false.
+	Enabled bool
+}
+
+// FeatureRolloutStatusResponse is returned when querying for the rollout
+// status of features gated on cluster-wide server support.
+type FeatureRolloutStatusResponse struct {
+	Features []FeatureRolloutStatus
+}
+
+// LeadershipTransitionPhase records how long a single named phase of
+// establishLeadership took, e.g. "barrier" or "acl-init".
+type LeadershipTransitionPhase struct {
+	Name     string
+	Duration time.Duration
+}
+
+// LeadershipTransition describes one pass through the leader establishment
+// path (the Raft barrier plus establishLeadership), for diagnosing slow
+// failovers via the Operator.Leadership RPC.
+type LeadershipTransition struct {
+	// Time is when this transition began.
+	Time time.Time
+
+	// Phases records the duration of each instrumented phase, in the order
+	// they ran. A failed transition may have fewer phases than a successful
+	// one, since it stops at the phase that errored.
+	Phases []LeadershipTransitionPhase
+
+	// Duration is the total time from the start of the Raft barrier to
+	// either establishLeadership returning or failing.
+	Duration time.Duration
+
+	// Error is the error establishLeadership returned, if any. An empty
+	// string means the transition succeeded.
+	Error string
+}
+
+// LeadershipStatusResponse is returned when querying for the timeline of
+// the most recent leadership transition on the queried server.
+type LeadershipStatusResponse struct {
+	// LastTransition is nil if this server has never attempted to
+	// establish leadership.
+	LastTransition *LeadershipTransition
+}
+
+// StateDigest records the content hashes of a server's FSM tables at a
+// point in time, for comparing against the digests reported by other
+// servers to detect silent Raft log/FSM divergence.
+type StateDigest struct {
+	// Time is when these hashes were computed.
+	Time time.Time
+
+	// Index is the Raft index the hashes were computed at.
+	Index uint64
+
+	// Tables maps table name to a hex-encoded content hash of that table.
+	Tables map[string]string
+}
+
+// StateDigestResponse is returned when querying for a server's most
+// recently computed StateDigest via the Operator.StateDigest RPC. The RPC
+// is intentionally not forwarded to the leader, since the point is to query
+// each server's own local view of the state.
+type StateDigestResponse struct {
+	// Digest is nil if this server has not yet computed a digest.
+	Digest *StateDigest
+}
+
+// FSCKRequest is the payload for the Operator.FSCK RPC, which scans the
+// catalog and ACL/session state for dangling references.
+type FSCKRequest struct {
+	Datacenter string
+
+	// Repair requests that any invariant violations that can be safely
+	// corrected automatically are fixed in place rather than only reported.
+	// Not every FSCKResultCategory is repairable; see FSCKResult.Repairable.
+	Repair bool
+
+	QueryOptions
+}
+
+func (r *FSCKRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// FSCKResultCategory identifies the kind of invariant violation a FSCKResult
+// describes.
+type FSCKResultCategory string
+
+const (
+	// FSCKServiceMissingNode is a service instance registered against a node
+	// that no longer exists in the catalog.
+	FSCKServiceMissingNode FSCKResultCategory = "service-missing-node"
+
+	// FSCKCheckMissingService is a health check associated with a service
+	// instance that no longer exists on its node.
+	FSCKCheckMissingService FSCKResultCategory = "check-missing-service"
+
+	// FSCKSessionMissingNode is a session held against a node that no
+	// longer exists in the catalog.
+	FSCKSessionMissingNode FSCKResultCategory = "session-missing-node"
+
+	// FSCKTokenMissingPolicy is an ACL token that links to a policy ID that
+	// no longer exists.
+	FSCKTokenMissingPolicy FSCKResultCategory = "token-missing-policy"
+
+	// FSCKConfigEntryMissingService is a config entry (for example
+	// service-intentions) that references a service name with no
+	// registered instances.
+	FSCKConfigEntryMissingService FSCKResultCategory = "config-entry-missing-service"
+)
+
+// FSCKResult describes a single invariant violation found by Operator.FSCK.
+type FSCKResult struct {
+	Category FSCKResultCategory
+
+	// Resource identifies the offending object, e.g. "node1/web" for a
+	// service or "service-intentions/web" for a config entry kind/name pair.
+	Resource string
+
+	// Reference identifies the missing object the resource points to, e.g.
+	// the missing node or policy ID.
+	Reference string
+
+	// Repairable is true if running FSCK with Repair set would have
+	// corrected this particular violation.
+	Repairable bool
+
+	// Repaired is true if Repair was set and this violation was corrected.
+	Repaired bool
+}
+
+// FSCKResponse is the result of a Operator.FSCK scan.
+type FSCKResponse struct {
+	Results []FSCKResult
+}
+
+// ConvergenceStatusRequest is the payload for the Operator.ConvergenceStatus
+// RPC, which reports how far each server in the datacenter has caught up to
+// a given Raft index.
+type ConvergenceStatusRequest struct {
+	Datacenter string
+
+	// Index is the Raft index deployment tooling is waiting to see applied
+	// everywhere, typically the WriteMeta.LastIndex returned by the write
+	// that triggered the deploy.
+	Index uint64
+
+	QueryOptions
+}
+
+func (r *ConvergenceStatusRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// ServerConvergenceStatus is one server's answer to a convergence check.
+type ServerConvergenceStatus struct {
+	// Name is the server's node name.
+	Name string
+
+	// AppliedIndex is the Raft index this server has applied to its FSM.
+	// It's zero if Error is set.
+	AppliedIndex uint64
+
+	// Applied is true if AppliedIndex is at least the index being checked.
+	Applied bool
+
+	// Error is set if the server couldn't be reached to ask.
+	Error string
+}
+
+// ConvergenceStatusResponse is the result of an Operator.ConvergenceStatus
+// query. It only reports on servers, which is as far as a server-side RPC
+// can see: whether any particular client agent's local cache or open
+// /v1/agent/subscribe stream has observed the index is answered separately,
+// by polling that agent's own GET /v1/agent/convergence/:index endpoint,
+// since servers have no way to reach into a client agent's local state.
+type ConvergenceStatusResponse struct {
+	// Index is the Raft index that was checked.
+	Index uint64
+
+	// Servers holds one entry per server in the datacenter.
+	Servers []ServerConvergenceStatus
+}
+
+// ConfigEntryAuditEntry records a single config entry or intention change
+// for later inspection, e.g. "who changed the service-router for payments
+// and when." The audit log is bounded, so old entries are evicted once the
+// log grows past a fixed size.
+type ConfigEntryAuditEntry struct {
+	// Index is the Raft index the change was committed at, and uniquely
+	// identifies this audit entry.
+	Index uint64
+
+	Kind string
+	Name string
+
+	// Op is either "upsert" or "delete".
+	Op string
+
+	// Author is the accessor ID of the ACL token that made the change, or
+	// "anonymous" if ACLs are disabled or no token was presented.
+	Author string
+
+	Timestamp time.Time
+}
+
+// ConfigEntryAuditLogRequest is used to query the config entry/intention
+// change audit log.
+type ConfigEntryAuditLogRequest struct {
+	Datacenter string
+	QueryOptions
+}
+
+func (r *ConfigEntryAuditLogRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// ConfigEntryAuditLogResponse is the result of a Operator.ConfigEntryAuditLog
+// query.
+type ConfigEntryAuditLogResponse struct {
+	Entries []*ConfigEntryAuditEntry
+	QueryMeta
+}
@@ -2,6 +2,7 @@ package structs
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/consul/agent/cache"
 	"github.com/hashicorp/consul/types"
@@ -333,3 +334,54 @@ type PreparedQueryExplainResponse struct {
 	// QueryMeta has freshness information about the query.
 	QueryMeta
 }
+
+// QueryFailoverEvent records the outcome of one hop tried while failing a
+// prepared query over into a remote datacenter.
+type QueryFailoverEvent struct {
+	// Datacenter is the remote datacenter that was tried.
+	Datacenter string
+
+	// Success is true if the hop returned without error. Note that an
+	// empty result set is still a success; Consul only knows to try the
+	// next datacenter when the remote RPC itself fails.
+	Success bool
+
+	// ResultCount is the number of nodes the hop returned.
+	ResultCount int
+
+	// Latency is how long the remote RPC took to complete.
+	Latency time.Duration
+
+	// Timestamp is when the hop was attempted.
+	Timestamp time.Time
+}
+
+// PreparedQueryFailoverHistoryRequest is used to fetch the most recent
+// cross-datacenter failover decisions made for a prepared query.
+type PreparedQueryFailoverHistoryRequest struct {
+	// Datacenter is the target this request is intended for.
+	Datacenter string
+
+	// QueryID is the ID of a query.
+	QueryID string
+
+	// QueryOptions (unfortunately named here) controls the consistency
+	// settings for the request.
+	QueryOptions
+}
+
+// RequestDatacenter returns the datacenter for a given request.
+func (q *PreparedQueryFailoverHistoryRequest) RequestDatacenter() string {
+	return q.Datacenter
+}
+
+// PreparedQueryFailoverHistoryResponse has the recent failover history for a
+// prepared query, oldest first. This is tracked in memory on whichever
+// server last executed the query's failover, so it's best-effort and isn't
+// replicated via Raft.
+type PreparedQueryFailoverHistoryResponse struct {
+	Events []QueryFailoverEvent
+
+	// QueryMeta has freshness information about the query.
+	QueryMeta
+}
@@ -0,0 +1,70 @@
+package structs
+
+// QueryViewRegisterRequest registers a persistent, server-side materialized
+// view of a service's health, filtered and projected according to the given
+// options. The view is maintained incrementally from the catalog's event
+// stream, so that repeated fetches of the same filter don't each have to
+// re-evaluate it against the full result set.
+type QueryViewRegisterRequest struct {
+	// Datacenter is the target this request is intended for.
+	Datacenter string
+
+	// ServiceName is the service whose health is being watched.
+	ServiceName string
+
+	// Connect, if true, watches the Connect-enabled instances of
+	// ServiceName instead of the instances of ServiceName itself.
+	Connect bool
+
+	// Filter is a bexpr expression evaluated against each
+	// structs.CheckServiceNode to decide whether it belongs in the view.
+	Filter string
+
+	// Fields, if non-empty, restricts fetched results to just these
+	// top-level fields of the JSON representation of each
+	// structs.CheckServiceNode.
+	Fields []string
+
+	QueryOptions
+}
+
+// RequestDatacenter returns the datacenter for a given request.
+func (r *QueryViewRegisterRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// QueryViewRegisterResponse is returned by a successful
+// QueryView.Register RPC.
+type QueryViewRegisterResponse struct {
+	// Handle identifies the registered view for subsequent
+	// QueryView.Fetch calls. It is only valid on the server that created
+	// it, and is lost on leadership change or server restart.
+	Handle string
+}
+
+// QueryViewFetchRequest fetches the current, or next, result of a view
+// previously registered with QueryView.Register.
+type QueryViewFetchRequest struct {
+	// Datacenter is the target this request is intended for.
+	Datacenter string
+
+	// Handle identifies the view, as returned by QueryView.Register.
+	Handle string
+
+	QueryOptions
+}
+
+// RequestDatacenter returns the datacenter for a given request.
+func (r *QueryViewFetchRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// QueryViewFetchResponse is returned by a successful QueryView.Fetch RPC.
+type QueryViewFetchResponse struct {
+	// Results holds one entry per service instance that currently passes
+	// the view's filter, projected down to the requested Fields (or the
+	// full structs.CheckServiceNode, as a map, if no Fields were given).
+	Results []map[string]interface{}
+
+	QueryMeta
+}
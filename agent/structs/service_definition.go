@@ -22,6 +22,14 @@ type ServiceDefinition struct {
 	Token             string
 	EnableTagOverride bool
 
+	// DNSAddressPolicy selects the address family DNS answers for this
+	// service prefer. See NodeService.DNSAddressPolicy for details.
+	DNSAddressPolicy string
+
+	// Owner identifies the team responsible for this service, so that
+	// alerts and mesh errors can be routed automatically. It is optional.
+	Owner *ServiceOwner
+
 	// Proxy is the configuration set for Kind = connect-proxy. It is mandatory in
 	// that case and an error to be set for any other kind. This config is part of
 	// a proxy service definition. ProxyConfig may be a more natural name here, but
@@ -69,6 +77,8 @@ func (s *ServiceDefinition) NodeService() *NodeService {
 		Port:              s.Port,
 		Weights:           s.Weights,
 		EnableTagOverride: s.EnableTagOverride,
+		DNSAddressPolicy:  s.DNSAddressPolicy,
+		Owner:             s.Owner,
 		EnterpriseMeta:    s.EnterpriseMeta,
 	}
 	ns.EnterpriseMeta.Normalize()
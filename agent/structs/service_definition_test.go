@@ -56,6 +56,20 @@ func TestAgentStructs_CheckTypes(t *testing.T) {
 	}
 }
 
+func TestServiceDefinition_NodeService_Owner(t *testing.T) {
+	sd := &ServiceDefinition{
+		Name: "web",
+		Owner: &ServiceOwner{
+			Team:    "web-team",
+			Contact: "web-team@example.com",
+			URL:     "https://runbooks.example.com/web",
+		},
+	}
+
+	ns := sd.NodeService()
+	require.Equal(t, sd.Owner, ns.Owner)
+}
+
 func TestServiceDefinitionValidate(t *testing.T) {
 	cases := []struct {
 		Name   string
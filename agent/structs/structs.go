@@ -70,6 +70,7 @@ const (
 	ChunkingStateType                           = 29
 	FederationStateRequestType                  = 30
 	SystemMetadataRequestType                   = 31
+	DatacenterDrillRequestType                  = 32
 )
 
 // if a new request type is added above it must be
@@ -110,6 +111,7 @@ var requestTypeStrings = map[MessageType]string{
 	ChunkingStateType:               "ChunkingState",
 	FederationStateRequestType:      "FederationState",
 	SystemMetadataRequestType:       "SystemMetadata",
+	DatacenterDrillRequestType:      "DatacenterDrill",
 }
 
 const (
@@ -180,6 +182,24 @@ const (
 	TaggedAddressLANIPv6 = "lan_ipv6"
 )
 
+// DNSAddressPolicy values control which address family (if any) a service's
+// DNS answers prefer when a query doesn't pin it down, such as ANY lookups.
+// They only take effect when a service registers both LAN/WAN IPv4 and IPv6
+// tagged addresses; an empty policy preserves the pre-existing behavior of
+// answering with the service's default registered address.
+const (
+	DNSAddressPolicyPreferIPv4 = "prefer_ipv4"
+	DNSAddressPolicyPreferIPv6 = "prefer_ipv6"
+	DNSAddressPolicyBoth       = "both"
+)
+
+var allowedDNSAddressPolicies = map[string]bool{
+	"":                          true,
+	DNSAddressPolicyPreferIPv4: true,
+	DNSAddressPolicyPreferIPv6: true,
+	DNSAddressPolicyBoth:       true,
+}
+
 // metaKeyFormat checks if a metadata key string is valid
 var metaKeyFormat = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`).MatchString
 
@@ -315,6 +335,14 @@ func (w *WriteRequest) SetTokenSecret(s string) {
 	w.Token = s
 }
 
+// WriteIndexResponse is embedded in write RPC replies that report the Raft
+// index the write was committed at. A client can echo that index back as
+// QueryOptions.MinQueryIndex on a later read to guarantee the read reflects
+// this write, without forcing a fully consistent (leader-verified) read.
+type WriteIndexResponse struct {
+	Index uint64
+}
+
 // QueryMeta allows a query response to include potentially
 // useful metadata about a query
 type QueryMeta struct {
@@ -574,6 +602,13 @@ type ServiceSpecificRequest struct {
 	// Ingress if true will only search for Ingress gateways for the given service.
 	Ingress bool
 
+	// MergeNodeMeta, if true, merges each returned instance's node metadata
+	// into its service metadata (prefixed with "node-meta.") so callers
+	// don't need to separately join against the node to get locality/zone
+	// info. This is computed in the state store query rather than by the
+	// caller.
+	MergeNodeMeta bool
+
 	EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
 	QueryOptions
 }
@@ -826,6 +861,9 @@ type ServiceNode struct {
 	ServiceEnableTagOverride bool
 	ServiceProxy             ConnectProxyConfig
 	ServiceConnect           ServiceConnect
+	ServiceDraining          bool          `json:",omitempty"`
+	ServiceOwner             *ServiceOwner `json:",omitempty"`
+	ServiceDNSAddressPolicy  string        `json:",omitempty"`
 
 	EnterpriseMeta `hcl:",squash" mapstructure:",squash" bexpr:"-"`
 
@@ -867,6 +905,9 @@ func (s *ServiceNode) PartialClone() *ServiceNode {
 		ServiceEnableTagOverride: s.ServiceEnableTagOverride,
 		ServiceProxy:             s.ServiceProxy,
 		ServiceConnect:           s.ServiceConnect,
+		ServiceDraining:          s.ServiceDraining,
+		ServiceOwner:             s.ServiceOwner,
+		ServiceDNSAddressPolicy:  s.ServiceDNSAddressPolicy,
 		RaftIndex: RaftIndex{
 			CreateIndex: s.CreateIndex,
 			ModifyIndex: s.ModifyIndex,
@@ -890,6 +931,9 @@ func (s *ServiceNode) ToNodeService() *NodeService {
 		EnableTagOverride: s.ServiceEnableTagOverride,
 		Proxy:             s.ServiceProxy,
 		Connect:           s.ServiceConnect,
+		Draining:          s.ServiceDraining,
+		Owner:             s.ServiceOwner,
+		DNSAddressPolicy:  s.ServiceDNSAddressPolicy,
 		EnterpriseMeta:    s.EnterpriseMeta,
 		RaftIndex: RaftIndex{
 			CreateIndex: s.CreateIndex,
@@ -936,6 +980,23 @@ type Weights struct {
 	Warning int
 }
 
+// ServiceOwner identifies the team responsible for a service and how to
+// reach them, so that alerts and mesh errors (such as denied intentions)
+// can be routed to the right team automatically instead of relying on
+// tribal knowledge or Meta conventions.
+type ServiceOwner struct {
+	// Team is the name of the team that owns this service.
+	Team string `json:",omitempty"`
+
+	// Contact is how to reach the owning team, such as an email address or
+	// a chat channel.
+	Contact string `json:",omitempty"`
+
+	// URL links to more information about the owning team, such as a
+	// runbook or an on-call schedule.
+	URL string `json:",omitempty"`
+}
+
 type ServiceNodes []*ServiceNode
 
 // ServiceKind is the kind of service being registered.
@@ -995,6 +1056,28 @@ type NodeService struct {
 	Weights           *Weights
 	EnableTagOverride bool
 
+	// DNSAddressPolicy selects which address family DNS answers for this
+	// service prefer when the query doesn't request one explicitly (e.g.
+	// ANY lookups): "prefer_ipv4", "prefer_ipv6", or "both" to return both
+	// an A and an AAAA record for happy-eyeballs-style clients. Empty
+	// preserves the default single-address behavior. It has no effect on
+	// A/AAAA queries, which already pin the family.
+	DNSAddressPolicy string `json:",omitempty"`
+
+	// Draining is true when the instance is being gracefully removed from
+	// service ahead of a planned deregistration: xDS reports it with
+	// DRAINING health so established connections can finish and new ones
+	// stop landing here, DNS omits it entirely, and prepared queries
+	// deprioritize it behind healthy instances. See the agent's
+	// /v1/agent/service/:id/drain endpoint, which is the only place this
+	// is normally set.
+	Draining bool `json:",omitempty"`
+
+	// Owner identifies the team responsible for this service, so that
+	// alerts and mesh errors can be routed automatically instead of relying
+	// on ad-hoc Meta conventions. It is optional.
+	Owner *ServiceOwner `json:",omitempty"`
+
 	// Proxy is the configuration set for Kind = connect-proxy. It is mandatory in
 	// that case and an error to be set for any other kind. This config is part of
 	// a proxy service definition. ProxyConfig may be a more natural name here, but
@@ -1141,6 +1224,12 @@ func (s *NodeService) IsGateway() bool {
 func (s *NodeService) Validate() error {
 	var result error
 
+	if !allowedDNSAddressPolicies[s.DNSAddressPolicy] {
+		result = multierror.Append(result, fmt.Errorf(
+			"DNSAddressPolicy must be empty, %q, %q, or %q",
+			DNSAddressPolicyPreferIPv4, DNSAddressPolicyPreferIPv6, DNSAddressPolicyBoth))
+	}
+
 	// ConnectProxy validation
 	if s.Kind == ServiceKindConnectProxy {
 		if strings.TrimSpace(s.Proxy.DestinationServiceName) == "" {
@@ -1294,6 +1383,9 @@ func (s *NodeService) IsSame(other *NodeService) bool {
 		s.Kind != other.Kind ||
 		!reflect.DeepEqual(s.Proxy, other.Proxy) ||
 		s.Connect != other.Connect ||
+		s.Draining != other.Draining ||
+		!reflect.DeepEqual(s.Owner, other.Owner) ||
+		s.DNSAddressPolicy != other.DNSAddressPolicy ||
 		!s.EnterpriseMeta.IsSame(&other.EnterpriseMeta) {
 		return false
 	}
@@ -1328,6 +1420,9 @@ func (s *ServiceNode) IsSameService(other *ServiceNode) bool {
 		s.ServiceEnableTagOverride != other.ServiceEnableTagOverride ||
 		!reflect.DeepEqual(s.ServiceProxy, other.ServiceProxy) ||
 		!reflect.DeepEqual(s.ServiceConnect, other.ServiceConnect) ||
+		s.ServiceDraining != other.ServiceDraining ||
+		!reflect.DeepEqual(s.ServiceOwner, other.ServiceOwner) ||
+		s.ServiceDNSAddressPolicy != other.ServiceDNSAddressPolicy ||
 		!s.EnterpriseMeta.IsSame(&other.EnterpriseMeta) {
 		return false
 	}
@@ -1363,6 +1458,9 @@ func (s *NodeService) ToServiceNode(node string) *ServiceNode {
 		ServiceEnableTagOverride: s.EnableTagOverride,
 		ServiceProxy:             s.Proxy,
 		ServiceConnect:           s.Connect,
+		ServiceDraining:          s.Draining,
+		ServiceOwner:             s.Owner,
+		ServiceDNSAddressPolicy:  s.DNSAddressPolicy,
 		EnterpriseMeta:           s.EnterpriseMeta,
 		RaftIndex: RaftIndex{
 			CreateIndex: s.CreateIndex,
@@ -1592,6 +1690,46 @@ func (c *HealthCheck) CheckType() *CheckType {
 // HealthChecks is a collection of HealthCheck structs.
 type HealthChecks []*HealthCheck
 
+// AggregatedStatus returns the "best" status for the list of health checks.
+// Because a given entry may have many service and node-level health checks
+// attached, this function determines the best representative of the status
+// as a single string using the following heuristic: maintenance > critical >
+// warning > passing.
+func (c HealthChecks) AggregatedStatus() string {
+	var passing, warning, critical, maintenance bool
+	for _, check := range c {
+		id := check.CheckID
+		if id == NodeMaint || strings.HasPrefix(string(id), ServiceMaintPrefix) {
+			maintenance = true
+			continue
+		}
+
+		switch check.Status {
+		case api.HealthPassing:
+			passing = true
+		case api.HealthWarning:
+			warning = true
+		case api.HealthCritical:
+			critical = true
+		default:
+			return ""
+		}
+	}
+
+	switch {
+	case maintenance:
+		return api.HealthMaint
+	case critical:
+		return api.HealthCritical
+	case warning:
+		return api.HealthWarning
+	case passing:
+		return api.HealthPassing
+	default:
+		return api.HealthPassing
+	}
+}
+
 // CheckServiceNode is used to provide the node, its service
 // definition, as well as a HealthCheck that is associated.
 type CheckServiceNode struct {
@@ -1709,6 +1847,39 @@ OUTER:
 	return nodes[:n]
 }
 
+// ExcludeDraining removes nodes whose service instance is draining. Note
+// that this returns the filtered results AND modifies the receiver for
+// performance, like Filter.
+func (nodes CheckServiceNodes) ExcludeDraining() CheckServiceNodes {
+	n := len(nodes)
+	for i := 0; i < n; i++ {
+		if nodes[i].Service.Draining {
+			nodes[i], nodes[n-1] = nodes[n-1], CheckServiceNode{}
+			n--
+			i--
+		}
+	}
+	return nodes[:n]
+}
+
+// DeprioritizeDraining stably partitions nodes so that draining instances
+// sort after every non-draining one, without otherwise reordering either
+// group. Unlike ExcludeDraining, draining instances are kept so they're
+// still used as a last resort, e.g. if a result limit then truncates them
+// away in favor of healthy, non-draining alternatives.
+func (nodes CheckServiceNodes) DeprioritizeDraining() CheckServiceNodes {
+	out := make(CheckServiceNodes, 0, len(nodes))
+	var draining CheckServiceNodes
+	for _, node := range nodes {
+		if node.Service.Draining {
+			draining = append(draining, node)
+		} else {
+			out = append(out, node)
+		}
+	}
+	return append(out, draining...)
+}
+
 // NodeInfo is used to dump all associated information about
 // a node. This is currently used for the UI only, as it is
 // rather expensive to generate.
@@ -1896,6 +2067,22 @@ type IndexedCheckServiceNodes struct {
 	QueryMeta
 }
 
+// ServiceHealthSummary holds the count of service instances in each health
+// status for a single service, without any of the per-instance payloads.
+type ServiceHealthSummary struct {
+	Name     string
+	Passing  int
+	Warning  int
+	Critical int
+
+	EnterpriseMeta
+}
+
+type IndexedServiceHealthSummaries struct {
+	Summaries []*ServiceHealthSummary
+	QueryMeta
+}
+
 type IndexedNodesWithGateways struct {
 	Nodes    CheckServiceNodes
 	Gateways GatewayServices
@@ -2134,6 +2321,15 @@ func (r *KVSRequest) RequestDatacenter() string {
 	return r.Datacenter
 }
 
+// KVSApplyResponse is returned by KVS.Apply. Success reports whether the
+// operation took effect (a failed CAS or lock-delay rejection reports false
+// without an error), and WriteIndexResponse carries the Raft index of the
+// write so it can be used as a read-your-writes token.
+type KVSApplyResponse struct {
+	Success bool
+	WriteIndexResponse
+}
+
 // KeyRequest is used to request a key, or key prefix
 type KeyRequest struct {
 	Datacenter string
@@ -2342,6 +2538,13 @@ func (r *EventFireRequest) RequestDatacenter() string {
 
 // EventFireResponse is used to respond to a fire request.
 type EventFireResponse struct {
+	// NumRecipients is a best-effort count of the cluster members the
+	// event was broadcast to, summed across all LAN segments. Serf's
+	// gossip broadcast is fire-and-forget, so this is not a delivery
+	// acknowledgement, just the size of the broadcast's intended
+	// audience.
+	NumRecipients int
+
 	QueryMeta
 }
 
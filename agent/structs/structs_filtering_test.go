@@ -320,6 +320,38 @@ var expectedFieldConfigNodeService bexpr.FieldConfigurations = bexpr.FieldConfig
 		StructFieldName: "ServiceConnect",
 		SubFields:       expectedFieldConfigServiceConnect,
 	},
+	"Draining": &bexpr.FieldConfiguration{
+		StructFieldName:     "Draining",
+		CoerceFn:            bexpr.CoerceBool,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual},
+	},
+	"Owner": &bexpr.FieldConfiguration{
+		StructFieldName: "Owner",
+		SubFields:       expectedFieldConfigServiceOwner,
+	},
+	"DNSAddressPolicy": &bexpr.FieldConfiguration{
+		StructFieldName:     "DNSAddressPolicy",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+}
+
+var expectedFieldConfigServiceOwner bexpr.FieldConfigurations = bexpr.FieldConfigurations{
+	"Team": &bexpr.FieldConfiguration{
+		StructFieldName:     "Team",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"Contact": &bexpr.FieldConfiguration{
+		StructFieldName:     "Contact",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"URL": &bexpr.FieldConfiguration{
+		StructFieldName:     "URL",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
 }
 
 var expectedFieldConfigServiceNode bexpr.FieldConfigurations = bexpr.FieldConfigurations{
@@ -414,6 +446,20 @@ var expectedFieldConfigServiceNode bexpr.FieldConfigurations = bexpr.FieldConfig
 		StructFieldName: "ServiceConnect",
 		SubFields:       expectedFieldConfigServiceConnect,
 	},
+	"ServiceDraining": &bexpr.FieldConfiguration{
+		StructFieldName:     "ServiceDraining",
+		CoerceFn:            bexpr.CoerceBool,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual},
+	},
+	"ServiceOwner": &bexpr.FieldConfiguration{
+		StructFieldName: "ServiceOwner",
+		SubFields:       expectedFieldConfigServiceOwner,
+	},
+	"ServiceDNSAddressPolicy": &bexpr.FieldConfiguration{
+		StructFieldName:     "ServiceDNSAddressPolicy",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
 }
 
 var expectedFieldConfigHealthCheck bexpr.FieldConfigurations = bexpr.FieldConfigurations{
@@ -561,6 +607,16 @@ var expectedFieldConfigIntention bexpr.FieldConfigurations = bexpr.FieldConfigur
 		CoerceFn:            bexpr.CoerceString,
 		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
 	},
+	"SourceAuthMethod": &bexpr.FieldConfiguration{
+		StructFieldName:     "SourceAuthMethod",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"SourceSelector": &bexpr.FieldConfiguration{
+		StructFieldName:     "SourceSelector",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
 	"Action": &bexpr.FieldConfiguration{
 		StructFieldName:     "Action",
 		CoerceFn:            bexpr.CoerceString,
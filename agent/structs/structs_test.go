@@ -1042,6 +1042,7 @@ func TestStructs_NodeService_IsSame(t *testing.T) {
 		t.Fatalf("copy should be the same, but was\n %#v\nVS\n %#v", otherServiceNode, otherServiceNodeCopy2)
 	}
 	check(func() { other.TaggedAddresses["lan"] = ServiceAddress{Address: "127.0.0.1", Port: 9999} }, func() { other.TaggedAddresses["lan"] = ServiceAddress{Address: "127.0.0.1", Port: 3456} })
+	check(func() { other.Owner = &ServiceOwner{Team: "web", Contact: "#web-team"} }, func() { other.Owner = nil })
 }
 
 func TestStructs_HealthCheck_IsSame(t *testing.T) {
@@ -1290,6 +1291,59 @@ func TestCheckServiceNodes_Filter(t *testing.T) {
 	}
 }
 
+func TestCheckServiceNodes_ExcludeDraining(t *testing.T) {
+	node1 := CheckServiceNode{
+		Node:    &Node{Node: "node1"},
+		Service: &NodeService{ID: "web1"},
+	}
+	node3 := CheckServiceNode{
+		Node:    &Node{Node: "node3"},
+		Service: &NodeService{ID: "web3"},
+	}
+	nodes := CheckServiceNodes{
+		node1,
+		{
+			Node:    &Node{Node: "node2"},
+			Service: &NodeService{ID: "web2", Draining: true},
+		},
+		node3,
+	}
+
+	filtered := nodes.ExcludeDraining()
+	require.Equal(t, CheckServiceNodes{node1, node3}, filtered)
+}
+
+func TestCheckServiceNodes_DeprioritizeDraining(t *testing.T) {
+	nodes := CheckServiceNodes{
+		CheckServiceNode{
+			Node:    &Node{Node: "node1"},
+			Service: &NodeService{ID: "web1", Draining: true},
+		},
+		CheckServiceNode{
+			Node:    &Node{Node: "node2"},
+			Service: &NodeService{ID: "web2"},
+		},
+		CheckServiceNode{
+			Node:    &Node{Node: "node3"},
+			Service: &NodeService{ID: "web3", Draining: true},
+		},
+		CheckServiceNode{
+			Node:    &Node{Node: "node4"},
+			Service: &NodeService{ID: "web4"},
+		},
+	}
+
+	reordered := nodes.DeprioritizeDraining()
+	expected := CheckServiceNodes{
+		nodes[1],
+		nodes[3],
+		nodes[0],
+		nodes[2],
+	}
+	require.Equal(t, expected, reordered)
+	require.Len(t, nodes, len(reordered), "original slice must not change length")
+}
+
 func TestCheckServiceNodes_CanRead(t *testing.T) {
 	type testCase struct {
 		name     string
@@ -2298,3 +2352,30 @@ func TestGatewayService_IsSame(t *testing.T) {
 		t.Fatalf("should be equal, was %#v VS %#v", g, other)
 	}
 }
+
+func TestHealthChecks_AggregatedStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		checks HealthChecks
+		exp    string
+	}{
+		{"empty", nil, api.HealthPassing},
+		{"passing", HealthChecks{{Status: api.HealthPassing}}, api.HealthPassing},
+		{"warning", HealthChecks{{Status: api.HealthWarning}}, api.HealthWarning},
+		{"critical", HealthChecks{{Status: api.HealthCritical}}, api.HealthCritical},
+		{
+			"maintenance",
+			HealthChecks{
+				{Status: api.HealthPassing},
+				{CheckID: NodeMaint, Status: api.HealthCritical},
+			},
+			api.HealthMaint,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, tc.checks.AggregatedStatus())
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"net"
 	"os"
+	"strconv"
+	"time"
 )
 
 const (
@@ -13,6 +15,7 @@ const (
 	Ready     = "READY=1"
 	Reloading = "RELOADING=1"
 	Stopping  = "STOPPING=1"
+	Watchdog  = "WATCHDOG=1"
 )
 
 var NotifyNoSocket = errors.New("No socket")
@@ -40,3 +43,20 @@ func (n *Notifier) Notify(state string) error {
 	_, err = conn.Write([]byte(state))
 	return err
 }
+
+// WatchdogEnabled reports whether systemd's watchdog protocol is enabled
+// for this unit, along with the interval at which WATCHDOG=1 notifications
+// must be sent to avoid being killed and restarted. systemd communicates
+// the configured WatchdogSec via the WATCHDOG_USEC environment variable;
+// per sd_notify(3), clients should notify at less than half that interval.
+func WatchdogEnabled() (time.Duration, bool) {
+	usec := os.Getenv("WATCHDOG_USEC")
+	if usec == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(usec, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return time.Duration(n) * time.Microsecond / 2, true
+}
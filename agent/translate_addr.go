@@ -29,10 +29,38 @@ func (a *Agent) TranslateServicePort(dc string, port int, taggedAddresses map[st
 	return port
 }
 
+// translateAddressRule returns the tagged address selected by the first
+// TranslateAddressRule whose SourceCIDR contains remoteIP, or ok=false if
+// remoteIP is nil, no rule matches, or the matching rule names a tagged
+// address this node/service doesn't have - in which case callers fall
+// back to the usual LAN/WAN translation logic.
+func (a *Agent) translateAddressRule(remoteIP net.IP, taggedAddress func(name string) (string, bool)) (string, bool) {
+	if remoteIP == nil {
+		return "", false
+	}
+	for _, rule := range a.config.TranslateAddressRules {
+		if rule.SourceCIDR.Contains(remoteIP) {
+			v, ok := taggedAddress(rule.TaggedAddress)
+			return v, ok && v != ""
+		}
+	}
+	return "", false
+}
+
 // TranslateServiceAddress is used to provide the final, translated address for a node,
 // depending on how the agent and the other node are configured. The dc
-// parameter is the dc the datacenter this node is from.
-func (a *Agent) TranslateServiceAddress(dc string, addr string, taggedAddresses map[string]structs.ServiceAddress, accept TranslateAddressAccept) string {
+// parameter is the dc the datacenter this node is from. remoteIP is the
+// address of the client making the query, used to evaluate
+// TranslateAddressRules; it may be nil if the caller has no such address
+// or doesn't want rule-based translation applied.
+func (a *Agent) TranslateServiceAddress(dc string, addr string, taggedAddresses map[string]structs.ServiceAddress, accept TranslateAddressAccept, remoteIP net.IP) string {
+	if v, ok := a.translateAddressRule(remoteIP, func(name string) (string, bool) {
+		v, ok := taggedAddresses[name]
+		return v.Address, ok
+	}); ok {
+		return v
+	}
+
 	def := addr
 	v4 := taggedAddresses[structs.TaggedAddressLANIPv4].Address
 	v6 := taggedAddresses[structs.TaggedAddressLANIPv6].Address
@@ -55,8 +83,18 @@ func (a *Agent) TranslateServiceAddress(dc string, addr string, taggedAddresses
 
 // TranslateAddress is used to provide the final, translated address for a node,
 // depending on how the agent and the other node are configured. The dc
-// parameter is the dc the datacenter this node is from.
-func (a *Agent) TranslateAddress(dc string, addr string, taggedAddresses map[string]string, accept TranslateAddressAccept) string {
+// parameter is the dc the datacenter this node is from. remoteIP is the
+// address of the client making the query, used to evaluate
+// TranslateAddressRules; it may be nil if the caller has no such address
+// or doesn't want rule-based translation applied.
+func (a *Agent) TranslateAddress(dc string, addr string, taggedAddresses map[string]string, accept TranslateAddressAccept, remoteIP net.IP) string {
+	if v, ok := a.translateAddressRule(remoteIP, func(name string) (string, bool) {
+		v, ok := taggedAddresses[name]
+		return v, ok
+	}); ok {
+		return v
+	}
+
 	def := addr
 	v4 := taggedAddresses[structs.TaggedAddressLANIPv4]
 	v6 := taggedAddresses[structs.TaggedAddressLANIPv6]
@@ -77,6 +115,23 @@ func (a *Agent) TranslateAddress(dc string, addr string, taggedAddresses map[str
 	return translateAddressAccept(accept, def, v4, v6)
 }
 
+// remoteAddrIP extracts the client IP from a net.Addr such as the one
+// reported by a dns.ResponseWriter, for matching against
+// TranslateAddressRules. It returns nil for address types it doesn't
+// recognize.
+func remoteAddrIP(addr net.Addr) net.IP {
+	switch v := addr.(type) {
+	case *net.UDPAddr:
+		return v.IP
+	case *net.TCPAddr:
+		return v.IP
+	case *net.IPAddr:
+		return v.IP
+	default:
+		return nil
+	}
+}
+
 func translateAddressAccept(accept TranslateAddressAccept, def, v4, v6 string) string {
 	switch {
 	case accept&TranslateAddressAcceptIPv6 > 0 && v6 != "":
@@ -103,7 +158,9 @@ func translateAddressAccept(accept TranslateAddressAccept, def, v4, v6 string) s
 // TranslateAddresses translates addresses in the given structure into the
 // final, translated address, depending on how the agent and the other node are
 // configured. The dc parameter is the datacenter this structure is from.
-func (a *Agent) TranslateAddresses(dc string, subj interface{}, accept TranslateAddressAccept) {
+// remoteIP is the address of the client making the query, used to evaluate
+// TranslateAddressRules; it may be nil.
+func (a *Agent) TranslateAddresses(dc string, subj interface{}, accept TranslateAddressAccept, remoteIP net.IP) {
 	// CAUTION - SUBTLE! An agent running on a server can, in some cases,
 	// return pointers directly into the immutable state store for
 	// performance (it's via the in-memory RPC mechanism). It's never safe
@@ -115,6 +172,12 @@ func (a *Agent) TranslateAddresses(dc string, subj interface{}, accept Translate
 	// done. This also happens to skip looking at any of the incoming
 	// structure for the common case of not needing to translate, so it will
 	// skip a lot of work if no translation needs to be done.
+	//
+	// TranslateAddressRules are evaluated inside TranslateAddress/
+	// TranslateServiceAddress below, so like TranslateWANAddrs they only
+	// take effect here for cross-datacenter lookups; same-datacenter
+	// callers that need rule-based translation (e.g. DNS) call those
+	// directly instead of going through this in-place helper.
 	if !a.config.TranslateWANAddrs || (a.config.Datacenter == dc) {
 		return
 	}
@@ -125,36 +188,36 @@ func (a *Agent) TranslateAddresses(dc string, subj interface{}, accept Translate
 	switch v := subj.(type) {
 	case structs.CheckServiceNodes:
 		for _, entry := range v {
-			entry.Node.Address = a.TranslateAddress(dc, entry.Node.Address, entry.Node.TaggedAddresses, accept)
-			entry.Service.Address = a.TranslateServiceAddress(dc, entry.Service.Address, entry.Service.TaggedAddresses, accept)
+			entry.Node.Address = a.TranslateAddress(dc, entry.Node.Address, entry.Node.TaggedAddresses, accept, remoteIP)
+			entry.Service.Address = a.TranslateServiceAddress(dc, entry.Service.Address, entry.Service.TaggedAddresses, accept, remoteIP)
 			entry.Service.Port = a.TranslateServicePort(dc, entry.Service.Port, entry.Service.TaggedAddresses)
 		}
 	case *structs.Node:
-		v.Address = a.TranslateAddress(dc, v.Address, v.TaggedAddresses, accept)
+		v.Address = a.TranslateAddress(dc, v.Address, v.TaggedAddresses, accept, remoteIP)
 	case structs.Nodes:
 		for _, node := range v {
-			node.Address = a.TranslateAddress(dc, node.Address, node.TaggedAddresses, accept)
+			node.Address = a.TranslateAddress(dc, node.Address, node.TaggedAddresses, accept, remoteIP)
 		}
 	case structs.ServiceNodes:
 		for _, entry := range v {
-			entry.Address = a.TranslateAddress(dc, entry.Address, entry.TaggedAddresses, accept)
-			entry.ServiceAddress = a.TranslateServiceAddress(dc, entry.ServiceAddress, entry.ServiceTaggedAddresses, accept)
+			entry.Address = a.TranslateAddress(dc, entry.Address, entry.TaggedAddresses, accept, remoteIP)
+			entry.ServiceAddress = a.TranslateServiceAddress(dc, entry.ServiceAddress, entry.ServiceTaggedAddresses, accept, remoteIP)
 			entry.ServicePort = a.TranslateServicePort(dc, entry.ServicePort, entry.ServiceTaggedAddresses)
 		}
 	case *structs.NodeServices:
 		if v.Node != nil {
-			v.Node.Address = a.TranslateAddress(dc, v.Node.Address, v.Node.TaggedAddresses, accept)
+			v.Node.Address = a.TranslateAddress(dc, v.Node.Address, v.Node.TaggedAddresses, accept, remoteIP)
 		}
 		for _, entry := range v.Services {
-			entry.Address = a.TranslateServiceAddress(dc, entry.Address, entry.TaggedAddresses, accept)
+			entry.Address = a.TranslateServiceAddress(dc, entry.Address, entry.TaggedAddresses, accept, remoteIP)
 			entry.Port = a.TranslateServicePort(dc, entry.Port, entry.TaggedAddresses)
 		}
 	case *structs.NodeServiceList:
 		if v.Node != nil {
-			v.Node.Address = a.TranslateAddress(dc, v.Node.Address, v.Node.TaggedAddresses, accept)
+			v.Node.Address = a.TranslateAddress(dc, v.Node.Address, v.Node.TaggedAddresses, accept, remoteIP)
 		}
 		for _, entry := range v.Services {
-			entry.Address = a.TranslateServiceAddress(dc, entry.Address, entry.TaggedAddresses, accept)
+			entry.Address = a.TranslateServiceAddress(dc, entry.Address, entry.TaggedAddresses, accept, remoteIP)
 			entry.Port = a.TranslateServicePort(dc, entry.Port, entry.TaggedAddresses)
 		}
 	default:
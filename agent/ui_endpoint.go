@@ -31,6 +31,7 @@ type ServiceSummary struct {
 	ChecksWarning     int
 	ChecksCritical    int
 	GatewayConfig     GatewayConfig
+	Owner             *structs.ServiceOwner `json:",omitempty"`
 
 	structs.EnterpriseMeta
 }
@@ -390,6 +391,9 @@ func summarizeServices(dump structs.ServiceDump, cfg *config.RuntimeConfig, dc s
 		sum.Kind = svc.Kind
 		sum.Datacenter = csn.Node.Datacenter
 		sum.InstanceCount += 1
+		if svc.Owner != nil {
+			sum.Owner = svc.Owner
+		}
 		if svc.Kind == structs.ServiceKindConnectProxy {
 			sn := structs.NewServiceName(svc.Proxy.DestinationServiceName, &svc.EnterpriseMeta)
 			hasProxy[sn] = true
@@ -81,7 +81,7 @@ func TestUiNodes(t *testing.T) {
 		Address:    "127.0.0.1",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -122,7 +122,7 @@ func TestUiNodes_Filter(t *testing.T) {
 		},
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	require.NoError(t, a.RPC("Catalog.Register", args, &out))
 
 	args = &structs.RegisterRequest{
@@ -174,7 +174,7 @@ func TestUiNodeInfo(t *testing.T) {
 		Address:    "127.0.0.1",
 	}
 
-	var out struct{}
+	var out structs.WriteIndexResponse
 	if err := a.RPC("Catalog.Register", args, &out); err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -300,7 +300,7 @@ func TestUiServices(t *testing.T) {
 	}
 
 	for _, args := range requests {
-		var out struct{}
+		var out structs.WriteIndexResponse
 		require.NoError(t, a.RPC("Catalog.Register", args, &out))
 	}
 
@@ -317,7 +317,7 @@ func TestUiServices(t *testing.T) {
 				Port:    443,
 			},
 		}
-		var regOutput struct{}
+		var regOutput structs.WriteIndexResponse
 		require.NoError(t, a.RPC("Catalog.Register", &arg, &regOutput))
 
 		args := &structs.TerminatingGatewayConfigEntry{
@@ -554,7 +554,7 @@ func TestUIGatewayServiceNodes_Terminating(t *testing.T) {
 				ServiceID: "terminating-gateway",
 			},
 		}
-		var regOutput struct{}
+		var regOutput structs.WriteIndexResponse
 		require.NoError(t, a.RPC("Catalog.Register", &arg, &regOutput))
 
 		arg = structs.RegisterRequest{
@@ -677,7 +677,7 @@ func TestUIGatewayServiceNodes_Ingress(t *testing.T) {
 				ServiceID: "ingress-gateway",
 			},
 		}
-		var regOutput struct{}
+		var regOutput structs.WriteIndexResponse
 		require.NoError(t, a.RPC("Catalog.Register", &arg, &regOutput))
 
 		arg = structs.RegisterRequest{
@@ -849,7 +849,7 @@ func TestUIGatewayIntentions(t *testing.T) {
 				ServiceID: "terminating-gateway",
 			},
 		}
-		var regOutput struct{}
+		var regOutput structs.WriteIndexResponse
 		require.NoError(t, a.RPC("Catalog.Register", &arg, &regOutput))
 
 		args := &structs.TerminatingGatewayConfigEntry{
@@ -1227,7 +1227,7 @@ func TestUIServiceTopology(t *testing.T) {
 			},
 		}
 		for _, args := range registrations {
-			var out struct{}
+			var out structs.WriteIndexResponse
 			require.NoError(t, a.RPC("Catalog.Register", args, &out))
 		}
 	}
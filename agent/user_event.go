@@ -2,8 +2,10 @@ package agent
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/go-msgpack/codec"
 	"github.com/hashicorp/go-uuid"
@@ -45,6 +47,11 @@ type UserEvent struct {
 
 	// LTime is the lamport time. Automatically generated.
 	LTime uint64 `codec:"-"`
+
+	// NumRecipients is a best-effort count of the cluster members the
+	// event was broadcast to. It is populated after the event is fired
+	// and is not part of the wire format sent to other agents.
+	NumRecipients int `codec:"-"`
 }
 
 // validateUserEventParams is used to sanity check the inputs
@@ -74,12 +81,43 @@ func validateUserEventParams(params *UserEvent) error {
 	return nil
 }
 
+// validateUserEventPayload checks the event's payload against any schema
+// registered for its name in EventPayloadSchemas. Event names with no
+// registered schema are not validated. This is a lightweight check, not a
+// full JSON Schema implementation: it only verifies that the payload is a
+// JSON object and that the schema's required fields are present as
+// top-level keys.
+func (a *Agent) validateUserEventPayload(params *UserEvent) error {
+	required, ok := a.config.EventPayloadSchemas[params.Name]
+	if !ok {
+		return nil
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(params.Payload, &decoded); err != nil {
+		return fmt.Errorf("event %q requires a JSON object payload: %v", params.Name, err)
+	}
+	var missing []string
+	for _, field := range required {
+		if _, ok := decoded[field]; !ok {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("event %q payload is missing required fields: %s", params.Name, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
 // UserEvent is used to fire an event via the Serf layer on the LAN
 func (a *Agent) UserEvent(dc, token string, params *UserEvent) error {
 	// Validate the params
 	if err := validateUserEventParams(params); err != nil {
 		return err
 	}
+	if err := a.validateUserEventPayload(params); err != nil {
+		return err
+	}
 
 	// Format message
 	var err error
@@ -105,7 +143,11 @@ func (a *Agent) UserEvent(dc, token string, params *UserEvent) error {
 	// gossip will take over anyways
 	args.AllowStale = true
 	var out structs.EventFireResponse
-	return a.RPC("Internal.EventFire", &args, &out)
+	if err := a.RPC("Internal.EventFire", &args, &out); err != nil {
+		return err
+	}
+	params.NumRecipients = out.NumRecipients
+	return nil
 }
 
 // handleEvents is used to process incoming user events
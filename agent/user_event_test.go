@@ -46,6 +46,38 @@ func TestValidateUserEventParams(t *testing.T) {
 	}
 }
 
+func TestUserEventPayloadSchema(t *testing.T) {
+	t.Parallel()
+	a := NewTestAgent(t, `
+		event_payload_schemas = [
+			{
+				name = "deploy"
+				required_fields = ["revision"]
+			}
+		]
+	`)
+	defer a.Shutdown()
+
+	// A payload missing the required field should be rejected.
+	bad := &UserEvent{Name: "deploy", Payload: []byte(`{"foo":"bar"}`)}
+	err := a.UserEvent("dc1", "root", bad)
+	if err == nil || !strings.Contains(err.Error(), "missing required fields") {
+		t.Fatalf("err: %v", err)
+	}
+
+	// A payload satisfying the schema should be accepted.
+	good := &UserEvent{Name: "deploy", Payload: []byte(`{"revision":"abc123"}`)}
+	if err := a.UserEvent("dc1", "root", good); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	// Events with no registered schema are not validated.
+	other := &UserEvent{Name: "other", Payload: []byte(`not json`)}
+	if err := a.UserEvent("dc1", "root", other); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+}
+
 func TestShouldProcessUserEvent(t *testing.T) {
 	t.Parallel()
 	a := NewTestAgent(t, "")
@@ -410,7 +410,8 @@ func (s *Server) makeUpstreamClusterForPreparedQuery(upstream structs.Upstream,
 			CircuitBreakers: &envoycluster.CircuitBreakers{
 				Thresholds: makeThresholdsIfNeeded(cfg.Limits),
 			},
-			OutlierDetection: cfg.PassiveHealthCheck.AsOutlierDetection(),
+			OutlierDetection:          cfg.PassiveHealthCheck.AsOutlierDetection(),
+			UpstreamConnectionOptions: cfg.TCPKeepalive.AsUpstreamConnectionOptions(),
 		}
 		if cfg.Protocol == "http2" || cfg.Protocol == "grpc" {
 			c.Http2ProtocolOptions = &envoycore.Http2ProtocolOptions{}
@@ -511,7 +512,8 @@ func (s *Server) makeUpstreamClustersForDiscoveryChain(
 			CircuitBreakers: &envoycluster.CircuitBreakers{
 				Thresholds: makeThresholdsIfNeeded(cfg.Limits),
 			},
-			OutlierDetection: cfg.PassiveHealthCheck.AsOutlierDetection(),
+			OutlierDetection:          cfg.PassiveHealthCheck.AsOutlierDetection(),
+			UpstreamConnectionOptions: cfg.TCPKeepalive.AsUpstreamConnectionOptions(),
 		}
 
 		var lb *structs.LoadBalancer
@@ -4,7 +4,9 @@ import (
 	"strings"
 	"time"
 
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoycluster "github.com/envoyproxy/go-control-plane/envoy/api/v2/cluster"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/consul/agent/structs"
@@ -161,6 +163,26 @@ type UpstreamLimits struct {
 	MaxConcurrentRequests *int `mapstructure:"max_concurrent_requests"`
 }
 
+// TCPKeepalive describes the TCP keepalive settings that are associated with
+// a specific upstream of a service instance. These keep long-idle upstream
+// connections that pass through stateful middleboxes like NAT gateways from
+// being silently dropped.
+type TCPKeepalive struct {
+	// KeepaliveProbes is the maximum number of keepalive probes to send
+	// before determining the connection is dead. Defaults to the OS level
+	// configuration if unset.
+	KeepaliveProbes *int `mapstructure:"keepalive_probes"`
+
+	// KeepaliveTime is the number of seconds a connection must be idle
+	// before keepalive probes start being sent. Defaults to the OS level
+	// configuration if unset.
+	KeepaliveTime *int `mapstructure:"keepalive_time"`
+
+	// KeepaliveInterval is the number of seconds between keepalive probes.
+	// Defaults to the OS level configuration if unset.
+	KeepaliveInterval *int `mapstructure:"keepalive_interval"`
+}
+
 // UpstreamConfig describes the keys we understand from
 // Connect.Proxy.Upstream[*].Config.
 type UpstreamConfig struct {
@@ -195,6 +217,10 @@ type UpstreamConfig struct {
 
 	// PassiveHealthCheck configuration
 	PassiveHealthCheck PassiveHealthCheck `mapstructure:"passive_health_check"`
+
+	// TCPKeepalive is the set of TCP keepalive options that are applied to
+	// connections made to this upstream.
+	TCPKeepalive TCPKeepalive `mapstructure:"tcp_keepalive"`
 }
 
 type PassiveHealthCheck struct {
@@ -220,6 +246,30 @@ func (p PassiveHealthCheck) AsOutlierDetection() *envoycluster.OutlierDetection
 	return od
 }
 
+// AsUpstreamConnectionOptions returns an envoy.UpstreamConnectionOptions
+// populated with a TcpKeepalive built from the values in this struct, or nil
+// if none of them are set so that Envoy's own keepalive defaults (typically
+// disabled) apply.
+func (k TCPKeepalive) AsUpstreamConnectionOptions() *envoy.UpstreamConnectionOptions {
+	var empty TCPKeepalive
+	if k == empty {
+		return nil
+	}
+
+	keepalive := &envoycore.TcpKeepalive{}
+	if k.KeepaliveProbes != nil {
+		keepalive.KeepaliveProbes = makeUint32Value(*k.KeepaliveProbes)
+	}
+	if k.KeepaliveTime != nil {
+		keepalive.KeepaliveTime = makeUint32Value(*k.KeepaliveTime)
+	}
+	if k.KeepaliveInterval != nil {
+		keepalive.KeepaliveInterval = makeUint32Value(*k.KeepaliveInterval)
+	}
+
+	return &envoy.UpstreamConnectionOptions{TcpKeepalive: keepalive}
+}
+
 func ParseUpstreamConfigNoDefaults(m map[string]interface{}) (UpstreamConfig, error) {
 	var cfg UpstreamConfig
 	config := &mapstructure.DecoderConfig{
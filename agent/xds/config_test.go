@@ -262,6 +262,25 @@ func TestParseUpstreamConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "tcp keepalive map",
+			input: map[string]interface{}{
+				"tcp_keepalive": map[string]interface{}{
+					"keepalive_probes":   3,
+					"keepalive_time":     60,
+					"keepalive_interval": 30,
+				},
+			},
+			want: UpstreamConfig{
+				ConnectTimeoutMs: 5000,
+				Protocol:         "tcp",
+				TCPKeepalive: TCPKeepalive{
+					KeepaliveProbes:   intPointer(3),
+					KeepaliveTime:     intPointer(60),
+					KeepaliveInterval: intPointer(30),
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,355 @@
+package xds
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoydisco "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// DeltaADSStream is a shorter way of referring to this thing...
+type DeltaADSStream = envoydisco.AggregatedDiscoveryService_DeltaAggregatedResourcesServer
+
+// DeltaAggregatedResources implements envoydisco.AggregatedDiscoveryServiceServer.
+// This is the incremental/delta variant of the ADS endpoint. Unlike
+// StreamAggregatedResources, which resends every resource of a type on every
+// snapshot change, this only sends the resources that actually changed (plus
+// the names of any that were removed), which matters a lot for proxies with
+// large numbers of upstreams during cluster-wide churn.
+//
+// Consul doesn't support partial resource subscription today, so every
+// request is treated as subscribing to the full set of resources of that
+// type - the saving here comes entirely from only sending the resources
+// whose content actually changed since the last send, not from limiting
+// which resources are tracked.
+func (s *Server) DeltaAggregatedResources(stream DeltaADSStream) error {
+	// a channel for receiving incoming requests
+	reqCh := make(chan *envoy.DeltaDiscoveryRequest)
+	reqStop := int32(0)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if atomic.LoadInt32(&reqStop) != 0 {
+				return
+			}
+			if err != nil {
+				close(reqCh)
+				return
+			}
+			reqCh <- req
+		}
+	}()
+
+	err := s.deltaProcess(stream, reqCh)
+	if err != nil {
+		s.Logger.Error("Error handling delta ADS stream", "error", err)
+	}
+
+	// prevents writing to a closed channel if send failed on blocked recv
+	atomic.StoreInt32(&reqStop, 1)
+
+	return err
+}
+
+func (s *Server) deltaProcess(stream DeltaADSStream, reqCh <-chan *envoy.DeltaDiscoveryRequest) error {
+	// xDS requires a unique nonce to correlate response/request pairs
+	var nonce uint64
+
+	// xDS works with versions of configs. Internally we don't have a consistent
+	// version. We could hash the config since versions don't have to be
+	// ordered as far as I can tell, but it is cheaper to increment a counter
+	// every time we observe a new config since the upstream proxycfg package only
+	// delivers updates when there are actual changes.
+	var configVersion uint64
+
+	// Loop state
+	var (
+		cfgSnap       *proxycfg.ConfigSnapshot
+		req           *envoy.DeltaDiscoveryRequest
+		node          *envoycore.Node
+		proxyFeatures supportedProxyFeatures
+		ok            bool
+		stateCh       <-chan *proxycfg.ConfigSnapshot
+		watchCancel   func()
+		proxyID       structs.ServiceID
+	)
+
+	// need to run a small state machine to get through initial authentication.
+	var state = stateInit
+
+	// Configure handlers for each type of request
+	handlers := map[string]*deltaXDSType{
+		EndpointType: {
+			typeURL:   EndpointType,
+			resources: s.endpointsFromSnapshot,
+			stream:    stream,
+		},
+		ClusterType: {
+			typeURL:   ClusterType,
+			resources: s.clustersFromSnapshot,
+			stream:    stream,
+			allowEmptyFn: func(cfgSnap *proxycfg.ConfigSnapshot) bool {
+				return cfgSnap.Kind == structs.ServiceKindMeshGateway ||
+					cfgSnap.Kind == structs.ServiceKindTerminatingGateway ||
+					cfgSnap.Kind == structs.ServiceKindIngressGateway
+			},
+		},
+		RouteType: {
+			typeURL:   RouteType,
+			resources: s.routesFromSnapshot,
+			stream:    stream,
+			allowEmptyFn: func(cfgSnap *proxycfg.ConfigSnapshot) bool {
+				return cfgSnap.Kind == structs.ServiceKindIngressGateway
+			},
+		},
+		ListenerType: {
+			typeURL:   ListenerType,
+			resources: s.listenersFromSnapshot,
+			stream:    stream,
+			allowEmptyFn: func(cfgSnap *proxycfg.ConfigSnapshot) bool {
+				return cfgSnap.Kind == structs.ServiceKindIngressGateway
+			},
+		},
+	}
+
+	var authTimer <-chan time.Time
+	extendAuthTimer := func() {
+		authTimer = time.After(s.AuthCheckFrequency)
+	}
+
+	for {
+		select {
+		case <-authTimer:
+			// It's been too long since a Discovery{Request,Response} so recheck ACLs.
+			if err := s.checkStreamACLs(stream.Context(), cfgSnap); err != nil {
+				return err
+			}
+			extendAuthTimer()
+
+		case req, ok = <-reqCh:
+			if !ok {
+				// reqCh is closed when stream.Recv errors which is how we detect client
+				// going away. AFAICT the stream.Context() is only canceled once the
+				// RPC method returns which it can't until we return from this one so
+				// there's no point in blocking on that.
+				return nil
+			}
+			if req.TypeUrl == "" {
+				return status.Errorf(codes.InvalidArgument, "type URL is required for ADS")
+			}
+
+			if node == nil && req.Node != nil {
+				node = req.Node
+				var err error
+				proxyFeatures, err = determineSupportedProxyFeatures(req.Node)
+				if err != nil {
+					s.rejections.record(req.Node.Id, envoyVersionFromNodeForLogging(req.Node), err.Error())
+					return status.Errorf(codes.InvalidArgument, err.Error())
+				}
+			}
+
+			if handler, ok := handlers[req.TypeUrl]; ok {
+				handler.Recv(req, node, proxyFeatures)
+			}
+		case cfgSnap = <-stateCh:
+			// We got a new config, update the version counter
+			configVersion++
+		}
+
+		// Trigger state machine
+		switch state {
+		case stateInit:
+			if req == nil {
+				// This can't happen (tm) since stateCh is nil until after the first req
+				// is received but lets not panic about it.
+				continue
+			}
+			// Start authentication process, we need the proxyID
+			proxyID = structs.NewServiceID(req.Node.Id, parseEnterpriseMeta(req.Node))
+
+			// Start watching config for that proxy
+			stateCh, watchCancel = s.CfgMgr.Watch(proxyID)
+			// Note that in this case we _intend_ the defer to only be triggered when
+			// this whole process method ends (i.e. when streaming RPC aborts) not at
+			// the end of the current loop iteration. We have to do it in the loop
+			// here since we can't start watching until we get to this state in the
+			// state machine.
+			defer watchCancel()
+
+			// Now wait for the config so we can check ACL
+			state = statePendingInitialConfig
+		case statePendingInitialConfig:
+			if cfgSnap == nil {
+				// Nothing we can do until we get the initial config
+				continue
+			}
+
+			// Got config, try to authenticate next.
+			state = stateRunning
+
+			// Lets actually process the config we just got or we'll mis responding
+			fallthrough
+		case stateRunning:
+			// Check ACLs on every Discovery{Request,Response}.
+			if err := s.checkStreamACLs(stream.Context(), cfgSnap); err != nil {
+				return err
+			}
+			// For the first time through the state machine, this is when the
+			// timer is first started.
+			extendAuthTimer()
+
+			// See the comment on the equivalent loop in process() - the order here
+			// is deliberate for the same reasons.
+			for _, typeURL := range []string{ClusterType, EndpointType, RouteType, ListenerType} {
+				handler := handlers[typeURL]
+				if err := handler.SendIfNew(cfgSnap, configVersion, &nonce); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// namedResource is implemented by every xDS resource type we generate
+// (Cluster, ClusterLoadAssignment, RouteConfiguration, Listener), and lets us
+// key the delta tracking maps by resource name without a type switch.
+type namedResource interface {
+	GetName() string
+}
+
+// deltaXDSType tracks per-connection, per-resource-type state needed to
+// compute an incremental (delta) response: which resource versions were last
+// sent to the client, so that only resources whose content actually
+// changed (plus any that were removed) need to be sent again.
+type deltaXDSType struct {
+	typeURL       string
+	stream        DeltaADSStream
+	req           *envoy.DeltaDiscoveryRequest
+	node          *envoycore.Node
+	proxyFeatures supportedProxyFeatures
+	lastNonce     string
+	// lastVersion is the config version that was last sent to the proxy, see
+	// the equivalent field on xDSType for why this is needed.
+	lastVersion uint64
+	// sent maps resource name to the content hash most recently sent for it,
+	// so the next send can diff against what was last sent.
+	sent         map[string]string
+	resources    func(cInfo connectionInfo, cfgSnap *proxycfg.ConfigSnapshot) ([]proto.Message, error)
+	allowEmptyFn func(cfgSnap *proxycfg.ConfigSnapshot) bool
+}
+
+func (t *deltaXDSType) Recv(req *envoy.DeltaDiscoveryRequest, node *envoycore.Node, proxyFeatures supportedProxyFeatures) {
+	if t.lastNonce == "" || t.lastNonce == req.GetResponseNonce() {
+		t.req = req
+		t.node = node
+		t.proxyFeatures = proxyFeatures
+	}
+}
+
+func (t *deltaXDSType) SendIfNew(cfgSnap *proxycfg.ConfigSnapshot, version uint64, nonce *uint64) error {
+	if t.req == nil {
+		return nil
+	}
+	if t.lastVersion >= version {
+		// Already sent this version
+		return nil
+	}
+
+	cInfo := connectionInfo{
+		Token:         tokenFromContext(t.stream.Context()),
+		ProxyFeatures: t.proxyFeatures,
+	}
+	resources, err := t.resources(cInfo, cfgSnap)
+	if err != nil {
+		return err
+	}
+
+	allowEmpty := t.allowEmptyFn != nil && t.allowEmptyFn(cfgSnap)
+	if len(resources) == 0 && !allowEmpty && len(t.sent) == 0 {
+		// Nothing to send yet, and nothing previously sent to withdraw either.
+		return nil
+	}
+
+	if t.sent == nil {
+		t.sent = make(map[string]string)
+	}
+
+	current := make(map[string]string, len(resources))
+	var changed []*envoy.Resource
+	for _, r := range resources {
+		if r == nil {
+			continue
+		}
+		named, ok := r.(namedResource)
+		if !ok {
+			return fmt.Errorf("delta xDS resource of type %T does not implement GetName", r)
+		}
+		name := named.GetName()
+
+		data, err := proto.Marshal(r)
+		if err != nil {
+			return err
+		}
+		resourceVersion := fmt.Sprintf("%x", sha256.Sum256(data))
+		current[name] = resourceVersion
+
+		if t.sent[name] == resourceVersion {
+			// Unchanged since last send - the client already has this.
+			continue
+		}
+		changed = append(changed, &envoy.Resource{
+			Name:    name,
+			Version: resourceVersion,
+			Resource: &any.Any{
+				TypeUrl: t.typeURL,
+				Value:   data,
+			},
+		})
+	}
+
+	var removed []string
+	for name := range t.sent {
+		if _, ok := current[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	t.lastVersion = version
+	t.sent = current
+
+	if len(changed) == 0 && len(removed) == 0 {
+		// Nothing actually changed for this type even though the overall
+		// config version moved on (e.g. only another type's resources
+		// changed), so there's nothing worth sending.
+		return nil
+	}
+
+	// Note we only increment nonce when we actually send - same rationale as
+	// the state-of-the-world implementation.
+	*nonce++
+	nonceStr := fmt.Sprintf("%08x", *nonce)
+	versionStr := fmt.Sprintf("%08x", version)
+
+	resp := &envoy.DeltaDiscoveryResponse{
+		SystemVersionInfo: versionStr,
+		Resources:         changed,
+		RemovedResources:  removed,
+		TypeUrl:           t.typeURL,
+		Nonce:             nonceStr,
+	}
+
+	t.lastNonce = nonceStr
+
+	return t.stream.Send(resp)
+}
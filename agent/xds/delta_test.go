@@ -0,0 +1,197 @@
+package xds
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+// TestDeltaADSStream mocks
+// discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesServer to allow
+// testing the delta ADS handler without a real gRPC connection.
+type TestDeltaADSStream struct {
+	sync.Mutex
+	ctx    context.Context
+	sendCh chan *envoy.DeltaDiscoveryResponse
+	recvCh chan *envoy.DeltaDiscoveryRequest
+}
+
+func NewTestDeltaADSStream(ctx context.Context) *TestDeltaADSStream {
+	return &TestDeltaADSStream{
+		ctx:    ctx,
+		sendCh: make(chan *envoy.DeltaDiscoveryResponse, 1),
+		recvCh: make(chan *envoy.DeltaDiscoveryRequest, 1),
+	}
+}
+
+func (s *TestDeltaADSStream) Send(r *envoy.DeltaDiscoveryResponse) error {
+	s.sendCh <- r
+	return nil
+}
+
+func (s *TestDeltaADSStream) Recv() (*envoy.DeltaDiscoveryRequest, error) {
+	r := <-s.recvCh
+	if r == nil {
+		return nil, io.EOF
+	}
+	return r, nil
+}
+
+func (s *TestDeltaADSStream) SetHeader(metadata.MD) error  { return nil }
+func (s *TestDeltaADSStream) SendHeader(metadata.MD) error { return nil }
+func (s *TestDeltaADSStream) SetTrailer(metadata.MD)       {}
+func (s *TestDeltaADSStream) Context() context.Context     { return s.ctx }
+func (s *TestDeltaADSStream) SendMsg(m interface{}) error  { return nil }
+func (s *TestDeltaADSStream) RecvMsg(m interface{}) error  { return nil }
+
+func (s *TestDeltaADSStream) sendReq(t *testing.T, typeURL, nonce string) {
+	t.Helper()
+	req := &envoy.DeltaDiscoveryRequest{
+		Node:          &envoycore.Node{Id: "web-sidecar-proxy"},
+		TypeUrl:       typeURL,
+		ResponseNonce: nonce,
+	}
+	select {
+	case s.recvCh <- req:
+	case <-time.After(50 * time.Millisecond):
+		t.Fatalf("send to stream blocked for too long")
+	}
+}
+
+func (s *TestDeltaADSStream) close() {
+	s.Lock()
+	defer s.Unlock()
+	if s.recvCh != nil {
+		close(s.recvCh)
+		s.recvCh = nil
+	}
+}
+
+func TestServer_DeltaAggregatedResources_BasicProtocol(t *testing.T) {
+	logger := testutil.Logger(t)
+	mgr := newTestManager(t)
+	aclResolve := func(id string) (acl.Authorizer, error) {
+		return acl.RootAuthorizer("manage"), nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := NewTestDeltaADSStream(ctx)
+
+	s := Server{
+		Logger:       logger,
+		CfgMgr:       mgr,
+		ResolveToken: aclResolve,
+	}
+	s.Initialize()
+
+	sid := structs.NewServiceID("web-sidecar-proxy", nil)
+	mgr.RegisterProxy(t, sid)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.DeltaAggregatedResources(stream)
+	}()
+
+	stream.sendReq(t, ClusterType, "")
+
+	snap := proxycfg.TestConfigSnapshot(t)
+	mgr.DeliverConfig(t, sid, snap)
+
+	var resp *envoy.DeltaDiscoveryResponse
+	select {
+	case resp = <-stream.sendCh:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatalf("timed out waiting for initial response")
+	}
+	require.Equal(t, ClusterType, resp.TypeUrl)
+	require.NotEmpty(t, resp.Resources)
+	require.Empty(t, resp.RemovedResources)
+
+	// ACK the response and redeliver the exact same snapshot - since nothing
+	// actually changed, no further message should be sent.
+	stream.sendReq(t, ClusterType, resp.Nonce)
+	mgr.DeliverConfig(t, sid, snap)
+
+	select {
+	case got := <-stream.sendCh:
+		t.Fatalf("expected no response for an unchanged snapshot, got %v", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	stream.close()
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(100 * time.Millisecond):
+		t.Fatalf("timed out waiting for handler to finish")
+	}
+}
+
+func TestDeltaXDSType_SendIfNew_DiffsResources(t *testing.T) {
+	stream := NewTestDeltaADSStream(context.Background())
+
+	var snapshotResources []proto.Message
+	dt := &deltaXDSType{
+		typeURL: ClusterType,
+		stream:  stream,
+		resources: func(_ connectionInfo, _ *proxycfg.ConfigSnapshot) ([]proto.Message, error) {
+			return snapshotResources, nil
+		},
+	}
+	// SendIfNew requires a request to have been received first.
+	dt.req = &envoy.DeltaDiscoveryRequest{TypeUrl: ClusterType}
+
+	cfgSnap := &proxycfg.ConfigSnapshot{Kind: structs.ServiceKindConnectProxy}
+	var nonce uint64
+
+	// Initial send: both resources are new.
+	snapshotResources = []proto.Message{
+		&envoy.Cluster{Name: "foo"},
+		&envoy.Cluster{Name: "bar"},
+	}
+	require.NoError(t, dt.SendIfNew(cfgSnap, 1, &nonce))
+	resp := <-stream.sendCh
+	require.Len(t, resp.Resources, 2)
+	require.Empty(t, resp.RemovedResources)
+
+	names := map[string]bool{}
+	for _, r := range resp.Resources {
+		names[r.Name] = true
+	}
+	require.True(t, names["foo"])
+	require.True(t, names["bar"])
+
+	// Change "foo", remove "bar": only "foo" should be resent, and "bar"
+	// should show up as removed.
+	snapshotResources = []proto.Message{
+		&envoy.Cluster{Name: "foo", AltStatName: "changed"},
+	}
+	require.NoError(t, dt.SendIfNew(cfgSnap, 2, &nonce))
+	resp = <-stream.sendCh
+	require.Len(t, resp.Resources, 1)
+	require.Equal(t, "foo", resp.Resources[0].Name)
+	require.Equal(t, []string{"bar"}, resp.RemovedResources)
+
+	// Nothing changes: no response should be sent at all.
+	require.NoError(t, dt.SendIfNew(cfgSnap, 3, &nonce))
+	select {
+	case got := <-stream.sendCh:
+		t.Fatalf("expected no response for an unchanged snapshot, got %v", got)
+	default:
+	}
+}
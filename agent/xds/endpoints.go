@@ -539,6 +539,13 @@ func calculateEndpointHealthAndWeight(
 			weight = ep.Service.Weights.Warning
 		}
 	}
+	// A draining instance is otherwise healthy, so report it as such unless
+	// checks already marked it unhealthy: established connections should
+	// keep draining gracefully rather than being cut off like an unhealthy
+	// endpoint would be.
+	if ep.Service.Draining && healthStatus == envoycore.HealthStatus_HEALTHY {
+		healthStatus = envoycore.HealthStatus_DRAINING
+	}
 	// Make weights fit Envoy's limits. A zero weight means that either Warning
 	// (likely) or Passing (weirdly) weight has been set to 0 effectively making
 	// this instance unhealthy and should not be sent traffic.
@@ -0,0 +1,74 @@
+package xds
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/agent/xds/proxysupport"
+)
+
+// maxRecentEnvoyRejections bounds how many rejected connection attempts we
+// keep around, so a misbehaving or ancient Envoy that retries in a loop
+// can't grow this unbounded.
+const maxRecentEnvoyRejections = 16
+
+// RejectedEnvoy records a single xDS connection that was refused because the
+// connecting Envoy's version isn't supported.
+type RejectedEnvoy struct {
+	ProxyID string
+	Version string
+	Reason  string
+	Time    time.Time
+}
+
+// recentRejections tracks the most recent Envoy version rejections so that
+// operators querying the agent API can see why a proxy never came up,
+// without needing to go digging through Envoy's own logs for the gRPC error.
+type recentRejections struct {
+	mu      sync.Mutex
+	entries []RejectedEnvoy
+}
+
+func (r *recentRejections) record(proxyID, version, reason string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, RejectedEnvoy{
+		ProxyID: proxyID,
+		Version: version,
+		Reason:  reason,
+		Time:    time.Now(),
+	})
+	if len(r.entries) > maxRecentEnvoyRejections {
+		r.entries = r.entries[len(r.entries)-maxRecentEnvoyRejections:]
+	}
+}
+
+func (r *recentRejections) list() []RejectedEnvoy {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make([]RejectedEnvoy, len(r.entries))
+	copy(out, r.entries)
+	return out
+}
+
+// EnvoyVersionCompatibility describes which Envoy versions this server will
+// accept, plus any recent connections it refused for being unsupported. It's
+// the data backing the XDS field of the agent's /v1/agent/self endpoint.
+type EnvoyVersionCompatibility struct {
+	SupportedVersions   []string
+	MinSupportedVersion string
+	RecentRejections    []RejectedEnvoy
+}
+
+// EnvoyVersionCompatibility reports which Envoy versions this server accepts
+// and any recent xDS connections it refused because of the connecting
+// Envoy's version.
+func (s *Server) EnvoyVersionCompatibility() EnvoyVersionCompatibility {
+	return EnvoyVersionCompatibility{
+		SupportedVersions:   proxysupport.EnvoyVersions,
+		MinSupportedVersion: minSupportedVersion.String(),
+		RecentRejections:    s.rejections.list(),
+	}
+}
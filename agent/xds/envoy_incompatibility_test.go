@@ -0,0 +1,40 @@
+package xds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+func TestRecentRejections_RecordAndCap(t *testing.T) {
+	var r recentRejections
+
+	require.Empty(t, r.list())
+
+	for i := 0; i < maxRecentEnvoyRejections+5; i++ {
+		r.record("web-sidecar-proxy", "1.9.0", "Envoy 1.9.0 is too old and is not supported by Consul")
+	}
+
+	got := r.list()
+	require.Len(t, got, maxRecentEnvoyRejections)
+	for _, rej := range got {
+		require.Equal(t, "web-sidecar-proxy", rej.ProxyID)
+		require.Equal(t, "1.9.0", rej.Version)
+		require.NotZero(t, rej.Time)
+	}
+}
+
+func TestServer_EnvoyVersionCompatibility(t *testing.T) {
+	s := Server{Logger: testutil.Logger(t)}
+	s.Initialize()
+
+	s.rejections.record("web-sidecar-proxy", "1.9.0", "too old")
+
+	compat := s.EnvoyVersionCompatibility()
+	require.NotEmpty(t, compat.SupportedVersions)
+	require.NotEmpty(t, compat.MinSupportedVersion)
+	require.Len(t, compat.RecentRejections, 1)
+	require.Equal(t, "web-sidecar-proxy", compat.RecentRejections[0].ProxyID)
+}
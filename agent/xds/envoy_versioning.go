@@ -68,6 +68,16 @@ func determineSupportedProxyFeaturesFromVersion(version *version.Version) (suppo
 	return supportedProxyFeatures{}, nil
 }
 
+// envoyVersionFromNodeForLogging returns a best-effort human readable
+// version string for a rejected node, falling back to "unknown" rather than
+// an empty string so rejection records stay readable.
+func envoyVersionFromNodeForLogging(node *envoycore.Node) string {
+	if v := determineEnvoyVersionFromNode(node); v != nil {
+		return v.String()
+	}
+	return "unknown"
+}
+
 // example: 1580db37e9a97c37e410bad0e1507ae1a0fd9e77/1.12.4/Clean/RELEASE/BoringSSL
 var buildVersionPattern = regexp.MustCompile(`^[a-f0-9]{40}/([^/]+)/Clean/RELEASE/BoringSSL$`)
 
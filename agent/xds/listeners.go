@@ -411,6 +411,8 @@ func (s *Server) injectConnectFilters(_ connectionInfo, cfgSnap *proxycfg.Config
 	authzFilter, err := makeRBACNetworkFilter(
 		cfgSnap.ConnectProxy.Intentions,
 		cfgSnap.IntentionDefaultAllow,
+		cfgSnap.Service,
+		s.IntentionMetricsCardinality,
 	)
 	if err != nil {
 		return err
@@ -569,6 +571,8 @@ func (s *Server) makePublicListener(cInfo connectionInfo, cfgSnap *proxycfg.Conf
 			opts.httpAuthzFilter, err = makeRBACHTTPFilter(
 				cfgSnap.ConnectProxy.Intentions,
 				cfgSnap.IntentionDefaultAllow,
+				cfgSnap.Service,
+				s.IntentionMetricsCardinality,
 			)
 			if err != nil {
 				return nil, err
@@ -591,6 +595,8 @@ func (s *Server) makePublicListener(cInfo connectionInfo, cfgSnap *proxycfg.Conf
 		httpAuthzFilter, err := makeRBACHTTPFilter(
 			cfgSnap.ConnectProxy.Intentions,
 			cfgSnap.IntentionDefaultAllow,
+			cfgSnap.Service,
+			s.IntentionMetricsCardinality,
 		)
 		if err != nil {
 			return nil, err
@@ -811,6 +817,8 @@ func (s *Server) makeFilterChainTerminatingGateway(
 		authFilter, err := makeRBACNetworkFilter(
 			intentions,
 			cfgSnap.IntentionDefaultAllow,
+			service.Name,
+			s.IntentionMetricsCardinality,
 		)
 		if err != nil {
 			return nil, err
@@ -837,6 +845,8 @@ func (s *Server) makeFilterChainTerminatingGateway(
 		opts.httpAuthzFilter, err = makeRBACHTTPFilter(
 			intentions,
 			cfgSnap.IntentionDefaultAllow,
+			service.Name,
+			s.IntentionMetricsCardinality,
 		)
 		if err != nil {
 			return nil, err
@@ -1288,8 +1298,26 @@ func makeCommonTLSContextFromLeaf(cfgSnap *proxycfg.ConfigSnapshot, leaf *struct
 
 	// TODO(banks): verify this actually works with Envoy (docs are not clear).
 	rootPEMS := ""
+	crlPEMs := ""
 	for _, root := range cfgSnap.Roots.Roots {
 		rootPEMS += root.RootCert
+		crlPEMs += root.CRLPEM
+	}
+
+	validationCtx := &envoyauth.CertificateValidationContext{
+		// TODO(banks): later for L7 support we may need to configure ALPN here.
+		TrustedCa: &envoycore.DataSource{
+			Specifier: &envoycore.DataSource_InlineString{
+				InlineString: rootPEMS,
+			},
+		},
+	}
+	if crlPEMs != "" {
+		validationCtx.Crl = &envoycore.DataSource{
+			Specifier: &envoycore.DataSource_InlineString{
+				InlineString: crlPEMs,
+			},
+		}
 	}
 
 	return &envoyauth.CommonTlsContext{
@@ -1309,14 +1337,7 @@ func makeCommonTLSContextFromLeaf(cfgSnap *proxycfg.ConfigSnapshot, leaf *struct
 			},
 		},
 		ValidationContextType: &envoyauth.CommonTlsContext_ValidationContext{
-			ValidationContext: &envoyauth.CertificateValidationContext{
-				// TODO(banks): later for L7 support we may need to configure ALPN here.
-				TrustedCa: &envoycore.DataSource{
-					Specifier: &envoycore.DataSource_InlineString{
-						InlineString: rootPEMS,
-					},
-				},
-			},
+			ValidationContext: validationCtx,
 		},
 	}
 }
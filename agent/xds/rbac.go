@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strings"
 
+	metrics "github.com/armon/go-metrics"
 	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	envoyhttprbac "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/rbac/v2"
@@ -12,11 +13,17 @@ import (
 	envoynetrbac "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/rbac/v2"
 	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v2"
 	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher"
+	"github.com/hashicorp/consul/agent/checks"
 	"github.com/hashicorp/consul/agent/structs"
 )
 
-func makeRBACNetworkFilter(intentions structs.Intentions, intentionDefaultAllow bool) (*envoylistener.Filter, error) {
-	rules, err := makeRBACRules(intentions, intentionDefaultAllow, false)
+func makeRBACNetworkFilter(
+	intentions structs.Intentions,
+	intentionDefaultAllow bool,
+	destination string,
+	cardinality *checks.MetricsCardinality,
+) (*envoylistener.Filter, error) {
+	rules, err := makeRBACRules(intentions, intentionDefaultAllow, false, destination, cardinality)
 	if err != nil {
 		return nil, err
 	}
@@ -28,8 +35,13 @@ func makeRBACNetworkFilter(intentions structs.Intentions, intentionDefaultAllow
 	return makeFilter("envoy.filters.network.rbac", cfg, false)
 }
 
-func makeRBACHTTPFilter(intentions structs.Intentions, intentionDefaultAllow bool) (*envoyhttp.HttpFilter, error) {
-	rules, err := makeRBACRules(intentions, intentionDefaultAllow, true)
+func makeRBACHTTPFilter(
+	intentions structs.Intentions,
+	intentionDefaultAllow bool,
+	destination string,
+	cardinality *checks.MetricsCardinality,
+) (*envoyhttp.HttpFilter, error) {
+	rules, err := makeRBACRules(intentions, intentionDefaultAllow, true, destination, cardinality)
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +53,15 @@ func makeRBACHTTPFilter(intentions structs.Intentions, intentionDefaultAllow boo
 }
 
 func intentionListToIntermediateRBACForm(intentions structs.Intentions, isHTTP bool) []*rbacIntention {
+	// Auth-method-based sources aren't identified by a SPIFFE cert URI, so
+	// there's no downstream mTLS identity for the sidecar's RBAC filter to
+	// match against here. Those intentions are enforced out-of-band by
+	// whatever authenticates the caller (for example an API gateway calling
+	// the agent authorize endpoint or the Intention.Check RPC); excluding
+	// them keeps them from being mistaken for a same-precedence intention
+	// with an empty source service name.
+	intentions = removeAuthMethodSourcedIntentions(intentions)
+
 	sort.Sort(structs.IntentionPrecedenceSorter(intentions))
 
 	// Omit any lower-precedence intentions that share the same source.
@@ -314,10 +335,10 @@ func simplifyNotSourceSlice(notSources []structs.ServiceName) []structs.ServiceN
 // Enterprise). Each intention in this flat list (sorted by precedence) can either
 // be an allow rule or a deny rule. Here’s a concrete example of this at work:
 //
-//     intern/trusted-app => billing/payment-svc : ALLOW (prec=9)
-//     intern/*           => billing/payment-svc : DENY  (prec=8)
-//     */*                => billing/payment-svc : ALLOW (prec=7)
-//     ::: ACL default policy :::                : DENY  (prec=N/A)
+//	intern/trusted-app => billing/payment-svc : ALLOW (prec=9)
+//	intern/*           => billing/payment-svc : DENY  (prec=8)
+//	*/*                => billing/payment-svc : ALLOW (prec=7)
+//	::: ACL default policy :::                : DENY  (prec=N/A)
 //
 // In contrast, Envoy lets you either configure a filter to be based on an
 // allow-list or a deny-list based on the action attribute of the RBAC rules
@@ -335,28 +356,34 @@ func simplifyNotSourceSlice(notSources []structs.ServiceName) []structs.ServiceN
 // models. For clarity I’ll rewrite the earlier example intentions in an
 // abbreviated form:
 //
-//     A         : ALLOW
-//     B         : DENY
-//     C         : ALLOW
-//     <default> : DENY
+//	A         : ALLOW
+//	B         : DENY
+//	C         : ALLOW
+//	<default> : DENY
 //
-// 1. Given that the overall intention default is set to deny, we start by
-//    choosing to build an allow-list in Envoy (this is also the variant that I find
-//    easier to think about).
-// 2. Next we traverse the list in precedence order (top down) and any DENY
-//    intentions are combined with later intentions using logical operations.
-// 3. Now that all of the intentions result in the same action (allow) we have
-//    successfully removed precedence and we can express this in as a set of Envoy
-//    RBAC policies.
+//  1. Given that the overall intention default is set to deny, we start by
+//     choosing to build an allow-list in Envoy (this is also the variant that I find
+//     easier to think about).
+//  2. Next we traverse the list in precedence order (top down) and any DENY
+//     intentions are combined with later intentions using logical operations.
+//  3. Now that all of the intentions result in the same action (allow) we have
+//     successfully removed precedence and we can express this in as a set of Envoy
+//     RBAC policies.
 //
 // After this the earlier A/B/C/default list becomes:
 //
-//     A            : ALLOW
-//     C AND NOT(B) : ALLOW
-//     <default>    : DENY
+//	A            : ALLOW
+//	C AND NOT(B) : ALLOW
+//	<default>    : DENY
 //
 // Which really is just an allow-list of [A, C AND NOT(B)]
-func makeRBACRules(intentions structs.Intentions, intentionDefaultAllow bool, isHTTP bool) (*envoyrbac.RBAC, error) {
+func makeRBACRules(
+	intentions structs.Intentions,
+	intentionDefaultAllow bool,
+	isHTTP bool,
+	destination string,
+	cardinality *checks.MetricsCardinality,
+) (*envoyrbac.RBAC, error) {
 	// Note that we DON'T explicitly validate the trust-domain matches ours.
 	//
 	// For now we don't validate the trust domain of the _destination_ at all.
@@ -391,6 +418,13 @@ func makeRBACRules(intentions structs.Intentions, intentionDefaultAllow bool, is
 	// Remove source and permissions precedence.
 	rbacIxns = removeIntentionPrecedence(rbacIxns, intentionDefaultAction)
 
+	// Count the allow/deny decisions this config generation is compiling into
+	// the RBAC policy, labeled by source and destination service, so security
+	// can monitor how many intentions deny traffic across the mesh. This
+	// counts entries compiled into policy, not decisions actually made by
+	// Envoy at connection time; Envoy's own RBAC filter stats cover that.
+	emitRBACDecisionMetrics(rbacIxns, destination, cardinality)
+
 	// For L4: we should generate one big Policy listing all Principals
 	// For L7: we should generate one Policy per Principal and list all of the Permissions
 	rbac := &envoyrbac.RBAC{
@@ -431,6 +465,25 @@ func makeRBACRules(intentions structs.Intentions, intentionDefaultAllow bool, is
 	return rbac, nil
 }
 
+func removeAuthMethodSourcedIntentions(intentions structs.Intentions) structs.Intentions {
+	var (
+		out     = make(structs.Intentions, 0, len(intentions))
+		changed = false
+	)
+	for _, ixn := range intentions {
+		if ixn.SourceType == structs.IntentionSourceAuthMethod {
+			changed = true
+			continue
+		}
+		out = append(out, ixn)
+	}
+
+	if !changed {
+		return intentions
+	}
+	return out
+}
+
 func removeSameSourceIntentions(intentions structs.Intentions) structs.Intentions {
 	if len(intentions) < 2 {
 		return intentions
@@ -695,3 +748,30 @@ func andPermissions(perms []*envoyrbac.Permission) *envoyrbac.Permission {
 		}
 	}
 }
+
+// emitRBACDecisionMetrics increments a counter per rbacIntention being
+// compiled into an RBAC policy, labeled by source and destination service
+// and whether it allows or denies traffic. L7 intentions are counted as
+// deny, matching how the agent authorize endpoint treats them. source and
+// destination are bounded by cardinality (when non-nil) so a large mesh
+// can't create unbounded metric series; beyond the cap, decisions are
+// reported under a shared "other" pair.
+func emitRBACDecisionMetrics(rbacIxns []*rbacIntention, destination string, cardinality *checks.MetricsCardinality) {
+	if cardinality == nil {
+		return
+	}
+
+	for _, rbacIxn := range rbacIxns {
+		action := "allowed"
+		if rbacIxn.Action != intentionActionAllow {
+			action = "denied"
+		}
+
+		source, dest := cardinality.LabelPair(rbacIxn.Source.String(), destination)
+		metrics.IncrCounterWithLabels([]string{"xds", "rbac", "intention", action}, 1,
+			[]metrics.Label{
+				{Name: "source", Value: source},
+				{Name: "destination", Value: dest},
+			})
+	}
+}
@@ -244,7 +244,7 @@ func TestMakeRBACNetworkAndHTTPFilters(t *testing.T) {
 		tt := tt
 		t.Run(name, func(t *testing.T) {
 			t.Run("network filter", func(t *testing.T) {
-				filter, err := makeRBACNetworkFilter(tt.intentions, tt.intentionDefaultAllow)
+				filter, err := makeRBACNetworkFilter(tt.intentions, tt.intentionDefaultAllow, "web", nil)
 				require.NoError(t, err)
 
 				gotJSON := protoToJSON(t, filter)
@@ -252,7 +252,7 @@ func TestMakeRBACNetworkAndHTTPFilters(t *testing.T) {
 				require.JSONEq(t, golden(t, filepath.Join("rbac", name), "", gotJSON), gotJSON)
 			})
 			t.Run("http filter", func(t *testing.T) {
-				filter, err := makeRBACHTTPFilter(tt.intentions, tt.intentionDefaultAllow)
+				filter, err := makeRBACHTTPFilter(tt.intentions, tt.intentionDefaultAllow, "web", nil)
 				require.NoError(t, err)
 
 				gotJSON := protoToJSON(t, filter)
@@ -331,6 +331,59 @@ func TestRemoveSameSourceIntentions(t *testing.T) {
 	}
 }
 
+func TestRemoveAuthMethodSourcedIntentions(t *testing.T) {
+	testIntention := func(t *testing.T, sourceType structs.IntentionSourceType, src, dst string) *structs.Intention {
+		t.Helper()
+		ixn := structs.TestIntention(t)
+		ixn.SourceType = sourceType
+		ixn.SourceName = src
+		ixn.DestinationName = dst
+		if sourceType == structs.IntentionSourceAuthMethod {
+			ixn.SourceAuthMethod = src
+			ixn.SourceName = ""
+		}
+		return ixn
+	}
+
+	tests := map[string]struct {
+		in     structs.Intentions
+		expect structs.Intentions
+	}{
+		"empty": {},
+		"no auth method sources": {
+			in: structs.Intentions{
+				testIntention(t, structs.IntentionSourceConsul, "web", "db"),
+			},
+			expect: structs.Intentions{
+				testIntention(t, structs.IntentionSourceConsul, "web", "db"),
+			},
+		},
+		"only auth method sources": {
+			in: structs.Intentions{
+				testIntention(t, structs.IntentionSourceAuthMethod, "okta", "db"),
+			},
+			expect: structs.Intentions{},
+		},
+		"mixed": {
+			in: structs.Intentions{
+				testIntention(t, structs.IntentionSourceConsul, "web", "db"),
+				testIntention(t, structs.IntentionSourceAuthMethod, "okta", "db"),
+			},
+			expect: structs.Intentions{
+				testIntention(t, structs.IntentionSourceConsul, "web", "db"),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := removeAuthMethodSourcedIntentions(tc.in)
+			require.Equal(t, tc.expect, got)
+		})
+	}
+}
+
 func TestSimplifyNotSourceSlice(t *testing.T) {
 	tests := map[string]struct {
 		in     []string
@@ -0,0 +1,94 @@
+package xds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hashicorp/consul/acl"
+)
+
+// fakeServerStream is a minimal grpc.ServerStream that only needs to carry a
+// context for these interceptor tests.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (f *fakeServerStream) Context() context.Context { return f.ctx }
+
+func TestServer_reflectionACLStreamInterceptor(t *testing.T) {
+	handlerCalled := false
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		handlerCalled = true
+		return nil
+	}
+
+	t.Run("non-reflection methods bypass the ACL check", func(t *testing.T) {
+		handlerCalled = false
+		s := &Server{
+			ResolveToken: func(id string) (acl.Authorizer, error) {
+				t.Fatal("ResolveToken should not be called for non-reflection methods")
+				return nil, nil
+			},
+		}
+		stream := &fakeServerStream{ctx: context.Background()}
+		info := &grpc.StreamServerInfo{FullMethod: "/envoy.service.discovery.v2.AggregatedDiscoveryService/StreamAggregatedResources"}
+
+		err := s.reflectionACLStreamInterceptor(nil, stream, info, handler)
+		require.NoError(t, err)
+		require.True(t, handlerCalled)
+	})
+
+	t.Run("reflection denied without OperatorRead", func(t *testing.T) {
+		handlerCalled = false
+		s := &Server{
+			ResolveToken: func(id string) (acl.Authorizer, error) {
+				return acl.RootAuthorizer("deny"), nil
+			},
+		}
+		stream := &fakeServerStream{ctx: context.Background()}
+		info := &grpc.StreamServerInfo{FullMethod: "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"}
+
+		err := s.reflectionACLStreamInterceptor(nil, stream, info, handler)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+		require.False(t, handlerCalled)
+	})
+
+	t.Run("reflection allowed with OperatorRead", func(t *testing.T) {
+		handlerCalled = false
+		s := &Server{
+			ResolveToken: func(id string) (acl.Authorizer, error) {
+				return acl.RootAuthorizer("manage"), nil
+			},
+		}
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-consul-token", "operator-token"))
+		stream := &fakeServerStream{ctx: ctx}
+		info := &grpc.StreamServerInfo{FullMethod: "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"}
+
+		err := s.reflectionACLStreamInterceptor(nil, stream, info, handler)
+		require.NoError(t, err)
+		require.True(t, handlerCalled)
+	})
+
+	t.Run("reflection propagates ACL resolution errors", func(t *testing.T) {
+		handlerCalled = false
+		s := &Server{
+			ResolveToken: func(id string) (acl.Authorizer, error) {
+				return nil, errors.New("boom")
+			},
+		}
+		stream := &fakeServerStream{ctx: context.Background()}
+		info := &grpc.StreamServerInfo{FullMethod: "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"}
+
+		err := s.reflectionACLStreamInterceptor(nil, stream, info, handler)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "boom")
+		require.False(t, handlerCalled)
+	})
+}
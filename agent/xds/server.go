@@ -2,8 +2,8 @@ package xds
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -12,6 +12,7 @@ import (
 	envoydisco "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/checks"
 	"github.com/hashicorp/consul/agent/proxycfg"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/logging"
@@ -20,7 +21,10 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 )
 
@@ -123,6 +127,20 @@ type Server struct {
 	AuthCheckFrequency time.Duration
 	CheckFetcher       HTTPCheckFetcher
 	CfgFetcher         ConfigFetcher
+
+	// IntentionMetricsCardinality bounds the source/destination labels used
+	// for the opt-in intention allow/deny decision metrics emitted during
+	// RBAC config generation. Nil when telemetry.enable_intention_metrics is
+	// not set.
+	IntentionMetricsCardinality *checks.MetricsCardinality
+
+	// rejections tracks connections refused because of an unsupported Envoy
+	// version, so they can be surfaced through the agent API.
+	rejections recentRejections
+
+	// proxies tracks the ACK/NACK status of every currently-connected proxy,
+	// so it can be surfaced through the agent API.
+	proxies proxyStatuses
 }
 
 // Initialize will finish configuring the Server for first use.
@@ -192,6 +210,7 @@ func (s *Server) process(stream ADSStream, reqCh <-chan *envoy.DiscoveryRequest)
 		stateCh       <-chan *proxycfg.ConfigSnapshot
 		watchCancel   func()
 		proxyID       structs.ServiceID
+		statusTracker *proxyStatus
 	)
 
 	// need to run a small state machine to get through initial authentication.
@@ -240,38 +259,7 @@ func (s *Server) process(stream ADSStream, reqCh <-chan *envoy.DiscoveryRequest)
 	}
 
 	checkStreamACLs := func(cfgSnap *proxycfg.ConfigSnapshot) error {
-		if cfgSnap == nil {
-			return status.Errorf(codes.Unauthenticated, "unauthenticated: no config snapshot")
-		}
-
-		rule, err := s.ResolveToken(tokenFromContext(stream.Context()))
-
-		if acl.IsErrNotFound(err) {
-			return status.Errorf(codes.Unauthenticated, "unauthenticated: %v", err)
-		} else if acl.IsErrPermissionDenied(err) {
-			return status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
-		} else if err != nil {
-			return err
-		}
-
-		var authzContext acl.AuthorizerContext
-		switch cfgSnap.Kind {
-		case structs.ServiceKindConnectProxy:
-			cfgSnap.ProxyID.EnterpriseMeta.FillAuthzContext(&authzContext)
-			if rule != nil && rule.ServiceWrite(cfgSnap.Proxy.DestinationServiceName, &authzContext) != acl.Allow {
-				return status.Errorf(codes.PermissionDenied, "permission denied")
-			}
-		case structs.ServiceKindMeshGateway, structs.ServiceKindTerminatingGateway, structs.ServiceKindIngressGateway:
-			cfgSnap.ProxyID.EnterpriseMeta.FillAuthzContext(&authzContext)
-			if rule != nil && rule.ServiceWrite(cfgSnap.Service, &authzContext) != acl.Allow {
-				return status.Errorf(codes.PermissionDenied, "permission denied")
-			}
-		default:
-			return status.Errorf(codes.Internal, "Invalid service kind")
-		}
-
-		// Authed OK!
-		return nil
+		return s.checkStreamACLs(stream.Context(), cfgSnap)
 	}
 
 	for {
@@ -300,6 +288,7 @@ func (s *Server) process(stream ADSStream, reqCh <-chan *envoy.DiscoveryRequest)
 				var err error
 				proxyFeatures, err = determineSupportedProxyFeatures(req.Node)
 				if err != nil {
+					s.rejections.record(req.Node.Id, envoyVersionFromNodeForLogging(req.Node), err.Error())
 					return status.Errorf(codes.InvalidArgument, err.Error())
 				}
 			}
@@ -307,9 +296,20 @@ func (s *Server) process(stream ADSStream, reqCh <-chan *envoy.DiscoveryRequest)
 			if handler, ok := handlers[req.TypeUrl]; ok {
 				handler.Recv(req, node, proxyFeatures)
 			}
+
+			if statusTracker != nil {
+				if detail := req.GetErrorDetail(); detail != nil {
+					statusTracker.trackNack(req.TypeUrl, req.GetVersionInfo(), detail.GetMessage())
+				} else if req.GetResponseNonce() != "" {
+					statusTracker.trackAck(req.TypeUrl, req.GetVersionInfo())
+				}
+			}
 		case cfgSnap = <-stateCh:
 			// We got a new config, update the version counter
 			configVersion++
+			if statusTracker != nil {
+				statusTracker.trackVersion(fmt.Sprintf("%08x", configVersion))
+			}
 		}
 
 		// Trigger state machine
@@ -323,6 +323,12 @@ func (s *Server) process(stream ADSStream, reqCh <-chan *envoy.DiscoveryRequest)
 			// Start authentication process, we need the proxyID
 			proxyID = structs.NewServiceID(req.Node.Id, parseEnterpriseMeta(req.Node))
 
+			// Track this connection's ACK/NACK status so it can be surfaced
+			// through the agent API, until the stream ends.
+			statusTracker = newProxyStatus(proxyID.ID)
+			s.proxies.register(statusTracker)
+			defer s.proxies.deregister(statusTracker)
+
 			// Start watching config for that proxy
 			stateCh, watchCancel = s.CfgMgr.Watch(proxyID)
 			// Note that in this case we _intend_ the defer to only be triggered when
@@ -471,15 +477,50 @@ func tokenFromContext(ctx context.Context) string {
 	return ""
 }
 
-// DeltaAggregatedResources implements envoydisco.AggregatedDiscoveryServiceServer
-func (s *Server) DeltaAggregatedResources(_ envoydisco.AggregatedDiscoveryService_DeltaAggregatedResourcesServer) error {
-	return errors.New("not implemented")
+// checkStreamACLs enforces that the token carried by ctx is authorized to
+// read/write the proxy's config for the given snapshot. It's shared by both
+// the state-of-the-world and the delta/incremental xDS implementations since
+// the authorization rules don't depend on which wire protocol is in use.
+func (s *Server) checkStreamACLs(ctx context.Context, cfgSnap *proxycfg.ConfigSnapshot) error {
+	if cfgSnap == nil {
+		return status.Errorf(codes.Unauthenticated, "unauthenticated: no config snapshot")
+	}
+
+	rule, err := s.ResolveToken(tokenFromContext(ctx))
+
+	if acl.IsErrNotFound(err) {
+		return status.Errorf(codes.Unauthenticated, "unauthenticated: %v", err)
+	} else if acl.IsErrPermissionDenied(err) {
+		return status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
+	} else if err != nil {
+		return err
+	}
+
+	var authzContext acl.AuthorizerContext
+	switch cfgSnap.Kind {
+	case structs.ServiceKindConnectProxy:
+		cfgSnap.ProxyID.EnterpriseMeta.FillAuthzContext(&authzContext)
+		if rule != nil && rule.ServiceWrite(cfgSnap.Proxy.DestinationServiceName, &authzContext) != acl.Allow {
+			return status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	case structs.ServiceKindMeshGateway, structs.ServiceKindTerminatingGateway, structs.ServiceKindIngressGateway:
+		cfgSnap.ProxyID.EnterpriseMeta.FillAuthzContext(&authzContext)
+		if rule != nil && rule.ServiceWrite(cfgSnap.Service, &authzContext) != acl.Allow {
+			return status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	default:
+		return status.Errorf(codes.Internal, "Invalid service kind")
+	}
+
+	// Authed OK!
+	return nil
 }
 
 // GRPCServer returns a server instance that can handle xDS requests.
 func (s *Server) GRPCServer(tlsConfigurator *tlsutil.Configurator) (*grpc.Server, error) {
 	opts := []grpc.ServerOption{
 		grpc.MaxConcurrentStreams(2048),
+		grpc.StreamInterceptor(s.reflectionACLStreamInterceptor),
 	}
 	if tlsConfigurator != nil {
 		if tlsConfigurator.Cert() != nil {
@@ -490,5 +531,45 @@ func (s *Server) GRPCServer(tlsConfigurator *tlsutil.Configurator) (*grpc.Server
 	srv := grpc.NewServer(opts...)
 	envoydisco.RegisterAggregatedDiscoveryServiceServer(srv, s)
 
+	// Register the standard gRPC health service so load balancers and other
+	// infrastructure can probe the listener without needing to speak xDS.
+	// Health checking intentionally isn't ACL-gated since it carries no
+	// sensitive information and needs to stay usable by unauthenticated
+	// infrastructure.
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(srv, healthServer)
+
+	// Register server reflection so tools like grpcurl can discover the xDS
+	// and health service descriptors without the operator needing to supply
+	// them out of band. Unlike health checking, reflection exposes details
+	// about what's running here, so it's gated by the same ACL token used
+	// for the rest of this listener.
+	reflection.Register(srv)
+
 	return srv, nil
 }
+
+// reflectionACLStreamInterceptor requires OperatorRead ACL permission for
+// calls to the gRPC reflection service, since reflection exposes service and
+// method descriptors that operators may not want exposed to anyone who can
+// reach the listener.
+func (s *Server) reflectionACLStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !strings.HasPrefix(info.FullMethod, "/grpc.reflection.v1alpha.ServerReflection/") {
+		return handler(srv, stream)
+	}
+
+	rule, err := s.ResolveToken(tokenFromContext(stream.Context()))
+	if acl.IsErrNotFound(err) {
+		return status.Errorf(codes.Unauthenticated, "unauthenticated: %v", err)
+	} else if acl.IsErrPermissionDenied(err) {
+		return status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
+	} else if err != nil {
+		return err
+	}
+	if rule != nil && rule.OperatorRead(nil) != acl.Allow {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	return handler(srv, stream)
+}
@@ -0,0 +1,138 @@
+package xds
+
+import (
+	"sync"
+	"time"
+)
+
+// TypeStatus describes the ACK/NACK state of a single xDS resource type
+// (clusters, endpoints, routes, or listeners) for one connected proxy.
+type TypeStatus struct {
+	// LastACKVersion is the version this proxy last successfully applied, or
+	// empty if it has never ACKed this type.
+	LastACKVersion string
+
+	// LastNackVersion and LastNackError describe the most recent rejected
+	// update, if any. A non-empty LastNackError means the proxy is currently
+	// running with a stale version of this resource type rather than the
+	// latest one we computed.
+	LastNackVersion string
+	LastNackError   string
+	LastNackAt      time.Time
+}
+
+// ProxyConfigStatus reports, for a single connected proxy, the latest xDS
+// config version we've computed against the version(s) it has actually
+// ACKed, broken down per resource type. Operators can use this to find
+// sidecars that are stuck running stale routing config after a bad config
+// entry, since a type with a LastNackError will keep serving its last good
+// config forever until the error is fixed.
+type ProxyConfigStatus struct {
+	ProxyID string
+
+	// LatestVersion is the version of the most recent config snapshot the
+	// server has computed for this proxy.
+	LatestVersion string
+
+	TypeStatuses map[string]TypeStatus
+}
+
+// proxyStatus is the mutable, server-side tracker backing a
+// ProxyConfigStatus. One is created per streaming xDS connection and torn
+// down when the stream ends.
+type proxyStatus struct {
+	mu            sync.Mutex
+	proxyID       string
+	latestVersion string
+	typeStatuses  map[string]TypeStatus
+}
+
+func newProxyStatus(proxyID string) *proxyStatus {
+	return &proxyStatus{
+		proxyID:      proxyID,
+		typeStatuses: make(map[string]TypeStatus),
+	}
+}
+
+func (p *proxyStatus) trackVersion(version string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.latestVersion = version
+}
+
+func (p *proxyStatus) trackAck(typeURL, version string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	ts := p.typeStatuses[typeURL]
+	ts.LastACKVersion = version
+	// An ACK means the proxy has applied a newer update, so an earlier
+	// NACK no longer leaves it on a stale version.
+	ts.LastNackVersion = ""
+	ts.LastNackError = ""
+	ts.LastNackAt = time.Time{}
+	p.typeStatuses[typeURL] = ts
+}
+
+func (p *proxyStatus) trackNack(typeURL, version, errMsg string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	ts := p.typeStatuses[typeURL]
+	ts.LastNackVersion = version
+	ts.LastNackError = errMsg
+	ts.LastNackAt = time.Now()
+	p.typeStatuses[typeURL] = ts
+}
+
+func (p *proxyStatus) snapshot() ProxyConfigStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	typeStatuses := make(map[string]TypeStatus, len(p.typeStatuses))
+	for k, v := range p.typeStatuses {
+		typeStatuses[k] = v
+	}
+	return ProxyConfigStatus{
+		ProxyID:       p.proxyID,
+		LatestVersion: p.latestVersion,
+		TypeStatuses:  typeStatuses,
+	}
+}
+
+// proxyStatuses tracks the set of currently-connected proxies so that
+// ProxyConfigStatuses can report on all of them at once.
+type proxyStatuses struct {
+	mu    sync.Mutex
+	conns map[*proxyStatus]struct{}
+}
+
+func (p *proxyStatuses) register(ps *proxyStatus) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conns == nil {
+		p.conns = make(map[*proxyStatus]struct{})
+	}
+	p.conns[ps] = struct{}{}
+}
+
+func (p *proxyStatuses) deregister(ps *proxyStatus) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.conns, ps)
+}
+
+func (p *proxyStatuses) list() []ProxyConfigStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	out := make([]ProxyConfigStatus, 0, len(p.conns))
+	for ps := range p.conns {
+		out = append(out, ps.snapshot())
+	}
+	return out
+}
+
+// ProxyConfigStatuses reports the xDS config drift status of every proxy
+// currently connected to this server: the latest config snapshot version
+// computed for each, versus the version(s) it has actually ACKed per
+// resource type, and any outstanding NACK errors.
+func (s *Server) ProxyConfigStatuses() []ProxyConfigStatus {
+	return s.proxies.list()
+}
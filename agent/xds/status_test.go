@@ -0,0 +1,35 @@
+package xds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxyStatus_TrackAckNackVersion(t *testing.T) {
+	ps := newProxyStatus("web-sidecar-proxy")
+
+	ps.trackVersion("00000001")
+	ps.trackAck(ClusterType, "00000001")
+	ps.trackNack(RouteType, "00000001", "invalid route config")
+
+	got := ps.snapshot()
+	require.Equal(t, "web-sidecar-proxy", got.ProxyID)
+	require.Equal(t, "00000001", got.LatestVersion)
+	require.Equal(t, "00000001", got.TypeStatuses[ClusterType].LastACKVersion)
+	require.Equal(t, "00000001", got.TypeStatuses[RouteType].LastNackVersion)
+	require.Equal(t, "invalid route config", got.TypeStatuses[RouteType].LastNackError)
+	require.NotZero(t, got.TypeStatuses[RouteType].LastNackAt)
+}
+
+func TestProxyStatuses_RegisterDeregister(t *testing.T) {
+	var statuses proxyStatuses
+	require.Empty(t, statuses.list())
+
+	ps := newProxyStatus("web-sidecar-proxy")
+	statuses.register(ps)
+	require.Len(t, statuses.list(), 1)
+
+	statuses.deregister(ps)
+	require.Empty(t, statuses.list())
+}
@@ -3,10 +3,14 @@ package api
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 )
 
 // ServiceKind is the kind of service being registered.
@@ -72,6 +76,15 @@ type AgentWeights struct {
 	Warning int
 }
 
+// AgentServiceOwner identifies the team responsible for a service and how
+// to reach them, so that alerts and mesh errors can be routed
+// automatically.
+type AgentServiceOwner struct {
+	Team    string `json:",omitempty"`
+	Contact string `json:",omitempty"`
+	URL     string `json:",omitempty"`
+}
+
 // AgentService represents a service known to the agent
 type AgentService struct {
 	Kind              ServiceKind `json:",omitempty"`
@@ -84,6 +97,9 @@ type AgentService struct {
 	TaggedAddresses   map[string]ServiceAddress `json:",omitempty"`
 	Weights           AgentWeights
 	EnableTagOverride bool
+	DNSAddressPolicy  string `json:",omitempty"`
+	Draining          bool                            `json:",omitempty"`
+	Owner             *AgentServiceOwner              `json:",omitempty"`
 	CreateIndex       uint64                          `json:",omitempty" bexpr:"-"`
 	ModifyIndex       uint64                          `json:",omitempty" bexpr:"-"`
 	ContentHash       string                          `json:",omitempty" bexpr:"-"`
@@ -206,6 +222,12 @@ type AgentMember struct {
 	DelegateMin uint8
 	DelegateMax uint8
 	DelegateCur uint8
+	// HealthScore is the memberlist health score of this member, as it
+	// perceives itself: 0 is healthy, and higher values indicate it's
+	// struggling to keep up with the soft real-time requirements of the
+	// gossip protocol. It's only populated for the local node that served
+	// the request, since nodes don't gossip their own health score.
+	HealthScore int `json:",omitempty"`
 }
 
 // ACLMode returns the ACL mode this agent is operating in.
@@ -243,6 +265,10 @@ type MembersOpts struct {
 	// Segment is the LAN segment to show members for. Setting this to the
 	// AllSegments value above will show members in all segments.
 	Segment string
+
+	// Filter specifies the go-bexpr filter expression to use for filtering
+	// the members of the cluster.
+	Filter string
 }
 
 // AgentServiceRegistration is used to register a new service
@@ -257,6 +283,8 @@ type AgentServiceRegistration struct {
 	EnableTagOverride bool                      `json:",omitempty"`
 	Meta              map[string]string         `json:",omitempty"`
 	Weights           *AgentWeights             `json:",omitempty"`
+	DNSAddressPolicy  string                    `json:",omitempty"`
+	Owner             *AgentServiceOwner        `json:",omitempty"`
 	Check             *AgentServiceCheck
 	Checks            AgentServiceChecks
 	Proxy             *AgentServiceConnectProxyConfig `json:",omitempty"`
@@ -264,7 +292,7 @@ type AgentServiceRegistration struct {
 	Namespace         string                          `json:",omitempty" bexpr:"-" hash:"ignore"`
 }
 
-//ServiceRegisterOpts is used to pass extra options to the service register.
+// ServiceRegisterOpts is used to pass extra options to the service register.
 type ServiceRegisterOpts struct {
 	//Missing healthchecks will be deleted from the agent.
 	//Using this parameter allows to idempotently register a service and its checks without
@@ -653,6 +681,9 @@ func (a *Agent) MembersOpts(opts MembersOpts) ([]*AgentMember, error) {
 	if opts.WAN {
 		r.params.Set("wan", "1")
 	}
+	if opts.Filter != "" {
+		r.params.Set("filter", opts.Filter)
+	}
 
 	_, resp, err := requireOK(a.c.doRequest(r))
 	if err != nil {
@@ -871,8 +902,8 @@ func (a *Agent) ForceLeave(node string) error {
 	return nil
 }
 
-//ForceLeavePrune is used to have an a failed agent removed
-//from the list of members
+// ForceLeavePrune is used to have an a failed agent removed
+// from the list of members
 func (a *Agent) ForceLeavePrune(node string) error {
 	r := a.c.newRequest("PUT", "/v1/agent/force-leave/"+node)
 	r.params.Set("prune", "1")
@@ -1052,6 +1083,152 @@ func (a *Agent) monitor(loglevel string, logJSON bool, stopCh <-chan struct{}, q
 	return logCh, nil
 }
 
+// Subscribe returns a channel which will receive streaming events from the
+// agent's local state store for the given topic, newline-delimited JSON
+// encoded, as a lower-latency alternative to polling a blocking query.
+// Providing a non-nil stopCh can be used to close the connection and stop
+// the stream. An empty string will be sent down the given channel when
+// there's nothing left to stream, after which the caller should close the
+// stopCh. Subscribe requires the agent it connects to be running in server
+// mode, and to have streaming enabled (see the "rpc.enable_streaming"
+// configuration option).
+func (a *Agent) Subscribe(topic, key string, index uint64, stopCh <-chan struct{}, q *QueryOptions) (chan string, error) {
+	r := a.c.newRequest("GET", "/v1/agent/subscribe")
+	r.setQueryOptions(q)
+	r.params.Set("topic", topic)
+	if key != "" {
+		r.params.Set("key", key)
+	}
+	if index > 0 {
+		r.params.Set("index", strconv.FormatUint(index, 10))
+	}
+	_, resp, err := requireOK(a.c.doRequest(r))
+	if err != nil {
+		return nil, err
+	}
+	eventCh := make(chan string, 64)
+	go func() {
+		defer resp.Body.Close()
+		scanner := bufio.NewScanner(resp.Body)
+		for {
+			select {
+			case <-stopCh:
+				close(eventCh)
+				return
+			default:
+			}
+			if scanner.Scan() {
+				if text := scanner.Text(); text != "" {
+					eventCh <- text
+				} else {
+					eventCh <- " "
+				}
+			} else {
+				eventCh <- ""
+			}
+		}
+	}()
+	return eventCh, nil
+}
+
+// DefaultSubscribeReconnectInterval is how long SubscribeWithReconnect waits
+// before reopening the stream after the agent closes it.
+var DefaultSubscribeReconnectInterval = 1 * time.Second
+
+// AgentSubscribeEvent is the typed, decoded form of one line emitted by the
+// agent's /v1/agent/subscribe endpoint. Payload is left as raw JSON since
+// its shape depends on Topic (for example a structs.CheckServiceNode for
+// the "service-health" topic) and decoding it is left to the caller.
+type AgentSubscribeEvent struct {
+	Topic               string
+	Key                 string `json:",omitempty"`
+	Index               uint64
+	Payload             json.RawMessage `json:",omitempty"`
+	EndOfSnapshot       bool            `json:",omitempty"`
+	NewSnapshotToFollow bool            `json:",omitempty"`
+}
+
+// SubscribeWithReconnect streams typed events from the agent's
+// /v1/agent/subscribe endpoint, as a supported alternative to Subscribe for
+// callers that don't want to hand-roll reconnect and resume-index handling.
+// Each line the agent emits is decoded into an AgentSubscribeEvent and sent
+// on the returned channel; the EndOfSnapshot and NewSnapshotToFollow fields
+// carry the same framing Subscribe's raw lines do. If the agent closes the
+// stream (for example because it lost leadership), SubscribeWithReconnect
+// reopens it starting from the last Index it saw, so the caller sees a
+// single continuous stream instead of having to notice the drop itself.
+//
+// The event channel is closed once ctx is cancelled or an unrecoverable
+// error occurs; in the latter case the error is sent to the returned error
+// channel before the event channel closes. A nil error on the error channel
+// means the stream ended because ctx was cancelled.
+func (a *Agent) SubscribeWithReconnect(ctx context.Context, topic, key string, index uint64, q *QueryOptions) (<-chan AgentSubscribeEvent, <-chan error) {
+	eventCh := make(chan AgentSubscribeEvent, 64)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(eventCh)
+
+		for {
+			stopCh := make(chan struct{})
+			lines, err := a.Subscribe(topic, key, index, stopCh, q.WithContext(ctx))
+			if err != nil {
+				if ctx.Err() == nil {
+					errCh <- err
+				} else {
+					errCh <- nil
+				}
+				return
+			}
+
+			reconnect := false
+		LINES:
+			for {
+				select {
+				case <-ctx.Done():
+					close(stopCh)
+					errCh <- nil
+					return
+				case line, ok := <-lines:
+					if !ok || line == "" {
+						close(stopCh)
+						reconnect = true
+						break LINES
+					}
+					if line == " " {
+						continue
+					}
+
+					var event AgentSubscribeEvent
+					if err := json.Unmarshal([]byte(line), &event); err != nil {
+						close(stopCh)
+						errCh <- fmt.Errorf("decoding subscribe event: %w", err)
+						return
+					}
+					index = event.Index
+					eventCh <- event
+				}
+			}
+
+			if !reconnect {
+				return
+			}
+
+			// The agent closed the stream, likely because it lost
+			// leadership or was restarted. Wait briefly and reconnect from
+			// the last index we saw rather than surfacing a spurious error.
+			select {
+			case <-ctx.Done():
+				errCh <- nil
+				return
+			case <-time.After(DefaultSubscribeReconnectInterval):
+			}
+		}
+	}()
+
+	return eventCh, errCh
+}
+
 // UpdateACLToken updates the agent's "acl_token". See updateToken for more
 // details.
 //
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -10,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -720,7 +722,7 @@ func TestAPI_AgentService(t *testing.T) {
 		ID:          "foo",
 		Service:     "foo",
 		Tags:        []string{"bar", "baz"},
-		ContentHash: "6b13684bfe179e67",
+		ContentHash: "969ee87c44f2876c",
 		Port:        8000,
 		Weights: AgentWeights{
 			Passing: 1,
@@ -1242,6 +1244,77 @@ func TestAPI_AgentMonitorJSON(t *testing.T) {
 	})
 }
 
+func TestAPI_AgentSubscribeWithReconnect(t *testing.T) {
+	t.Parallel()
+
+	// Fake out /v1/agent/subscribe: the first connection is cut after one
+	// event to exercise the reconnect path, and the second connection
+	// finishes normally with an empty line.
+	var attempt int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/agent/subscribe", func(w http.ResponseWriter, req *http.Request) {
+		flusher := w.(http.Flusher)
+		w.WriteHeader(http.StatusOK)
+
+		n := atomic.AddInt32(&attempt, 1)
+		if n == 1 {
+			fmt.Fprintln(w, `{"Topic":"service-health","Index":1,"EndOfSnapshot":true}`)
+			flusher.Flush()
+			return
+		}
+
+		if req.URL.Query().Get("index") != "1" {
+			t.Errorf("expected reconnect to resume from index 1, got %q", req.URL.Query().Get("index"))
+		}
+		fmt.Fprintln(w, `{"Topic":"service-health","Index":2,"Payload":{"Node":"web"}}`)
+		flusher.Flush()
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	c, err := NewClient(&Config{Address: srv.URL[len("http://"):]})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	origInterval := DefaultSubscribeReconnectInterval
+	DefaultSubscribeReconnectInterval = time.Millisecond
+	defer func() { DefaultSubscribeReconnectInterval = origInterval }()
+
+	eventCh, errCh := c.Agent().SubscribeWithReconnect(ctx, "service-health", "", 0, nil)
+
+	var events []AgentSubscribeEvent
+	for len(events) < 2 {
+		select {
+		case event, ok := <-eventCh:
+			if !ok {
+				t.Fatalf("event channel closed early after %d events", len(events))
+			}
+			events = append(events, event)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for event %d", len(events))
+		}
+	}
+
+	// The agent never signals "done" on its own, so by design the client
+	// keeps reconnecting until the caller cancels the context.
+	cancel()
+	for range eventCh {
+	}
+	if err := <-errCh; err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if !events[0].EndOfSnapshot || events[0].Index != 1 {
+		t.Fatalf("bad first event: %+v", events[0])
+	}
+	if events[1].Index != 2 || string(events[1].Payload) != `{"Node":"web"}` {
+		t.Fatalf("bad second event: %+v", events[1])
+	}
+}
+
 func TestAPI_ServiceMaintenance(t *testing.T) {
 	t.Parallel()
 	c, s := makeClient(t)
@@ -260,6 +260,12 @@ type QueryMeta struct {
 type WriteMeta struct {
 	// How long did the request take
 	RequestTime time.Duration
+
+	// LastIndex is the Raft index the write was committed at, if the
+	// endpoint reports one. It can be used as a WaitIndex on a subsequent
+	// read to guarantee that read reflects this write, without requiring a
+	// fully consistent read. It is zero for endpoints that don't report it.
+	LastIndex uint64
 }
 
 // HttpBasicAuth is used to authenticate http client with HTTP Basic Authentication
@@ -912,6 +918,7 @@ func (c *Client) write(endpoint string, in, out interface{}, q *WriteOptions) (*
 	defer resp.Body.Close()
 
 	wm := &WriteMeta{RequestTime: rtt}
+	parseWriteMeta(resp, wm)
 	if out != nil {
 		if err := decodeBody(resp, &out); err != nil {
 			return nil, err
@@ -922,6 +929,21 @@ func (c *Client) write(endpoint string, in, out interface{}, q *WriteOptions) (*
 	return wm, nil
 }
 
+// parseWriteMeta is used to help parse write meta-data
+func parseWriteMeta(resp *http.Response, q *WriteMeta) error {
+	header := resp.Header
+
+	// Parse the X-Consul-Index (not every write endpoint sets this)
+	if indexStr := header.Get("X-Consul-Index"); indexStr != "" {
+		index, err := strconv.ParseUint(indexStr, 10, 64)
+		if err != nil {
+			return fmt.Errorf("Failed to parse X-Consul-Index: %v", err)
+		}
+		q.LastIndex = index
+	}
+	return nil
+}
+
 // parseQueryMeta is used to help parse query meta-data
 //
 // TODO(rb): bug? the error from this function is never handled
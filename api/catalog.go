@@ -126,6 +126,7 @@ func (c *Catalog) Register(reg *CatalogRegistration, q *WriteOptions) (*WriteMet
 
 	wm := &WriteMeta{}
 	wm.RequestTime = rtt
+	parseWriteMeta(resp, wm)
 
 	return wm, nil
 }
@@ -142,6 +143,7 @@ func (c *Catalog) Deregister(dereg *CatalogDeregistration, q *WriteOptions) (*Wr
 
 	wm := &WriteMeta{}
 	wm.RequestTime = rtt
+	parseWriteMeta(resp, wm)
 
 	return wm, nil
 }
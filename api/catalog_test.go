@@ -871,9 +871,13 @@ func TestAPI_CatalogRegistration(t *testing.T) {
 		Service:    proxy,
 	}
 	retry.Run(t, func(r *retry.R) {
-		if _, err := catalog.Register(reg, nil); err != nil {
+		wm, err := catalog.Register(reg, nil)
+		if err != nil {
 			r.Fatal(err)
 		}
+		if wm.LastIndex == 0 {
+			r.Fatalf("unexpected value: %#v", wm)
+		}
 		if _, err := catalog.Register(proxyReg, nil); err != nil {
 			r.Fatal(err)
 		}
@@ -285,6 +285,40 @@ func (h *Connect) IntentionDelete(id string, q *WriteOptions) (*WriteMeta, error
 	return qm, nil
 }
 
+// IntentionReconcileResponse reports what IntentionReconcile actually
+// changed, since the request only supplies the desired end state.
+type IntentionReconcileResponse struct {
+	Added   []string
+	Updated []string
+	Removed []string
+}
+
+// IntentionReconcile declaratively replaces the complete set of source
+// intentions for destination with sources, computing and applying the
+// add/update/remove diff against what is currently stored as a single
+// transactional write. This allows GitOps-style tooling to submit its
+// desired state directly rather than fetching the existing sources and
+// diffing against them itself.
+func (h *Connect) IntentionReconcile(destination string, sources []*SourceIntention, q *WriteOptions) (*IntentionReconcileResponse, *WriteMeta, error) {
+	r := h.c.newRequest("PUT", "/v1/connect/intentions/reconcile/"+destination)
+	r.setWriteOptions(q)
+	r.obj = sources
+	rtt, resp, err := requireOK(h.c.doRequest(r))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	wm := &WriteMeta{}
+	wm.RequestTime = rtt
+
+	var out IntentionReconcileResponse
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, wm, nil
+}
+
 // IntentionMatch returns the list of intentions that match a given source
 // or destination. The returned intentions are ordered by precedence where
 // result[0] is the highest precedence (if that matches, then that rule overrides
@@ -213,6 +213,7 @@ func (k *KV) put(key string, params map[string]string, body []byte, q *WriteOpti
 
 	qm := &WriteMeta{}
 	qm.RequestTime = rtt
+	parseWriteMeta(resp, qm)
 
 	var buf bytes.Buffer
 	if _, err := io.Copy(&buf, resp.Body); err != nil {
@@ -257,6 +258,7 @@ func (k *KV) deleteInternal(key string, params map[string]string, q *WriteOption
 
 	qm := &WriteMeta{}
 	qm.RequestTime = rtt
+	parseWriteMeta(resp, qm)
 
 	var buf bytes.Buffer
 	if _, err := io.Copy(&buf, resp.Body); err != nil {
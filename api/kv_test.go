@@ -39,9 +39,13 @@ func TestAPI_ClientPutGetDelete(t *testing.T) {
 
 	// Put the key
 	p = &KVPair{Key: key, Flags: 42, Value: value}
-	if _, err := kv.Put(p, nil); err != nil {
+	wm, err := kv.Put(p, nil)
+	if err != nil {
 		t.Fatalf("err: %v", err)
 	}
+	if wm.LastIndex == 0 {
+		t.Fatalf("unexpected value: %#v", wm)
+	}
 
 	// Get should work
 	pair, meta, err := kv.Get(key, nil)
@@ -57,8 +61,8 @@ func TestAPI_ClientPutGetDelete(t *testing.T) {
 	if pair.Flags != 42 {
 		t.Fatalf("unexpected value: %#v", pair)
 	}
-	if meta.LastIndex == 0 {
-		t.Fatalf("unexpected value: %#v", meta)
+	if meta.LastIndex != wm.LastIndex {
+		t.Fatalf("expected matching index, got %#v and %#v", meta, wm)
 	}
 
 	// Delete
@@ -0,0 +1,43 @@
+package api
+
+import "time"
+
+// ConfigEntryAuditEntry describes a single recorded config entry or
+// intention change.
+type ConfigEntryAuditEntry struct {
+	Index uint64
+
+	Kind string
+	Name string
+
+	// Op is either "upsert" or "delete".
+	Op string
+
+	// Author is the accessor ID of the ACL token that made the change, or
+	// "anonymous" if ACLs are disabled or no token was presented.
+	Author string
+
+	Timestamp time.Time
+}
+
+// ConfigEntryAuditLog returns the bounded log of config entry and intention
+// changes, oldest first.
+func (op *Operator) ConfigEntryAuditLog(q *QueryOptions) ([]*ConfigEntryAuditEntry, *QueryMeta, error) {
+	r := op.c.newRequest("GET", "/v1/operator/audit/config-entries")
+	r.setQueryOptions(q)
+	rtt, resp, err := requireOK(op.c.doRequest(r))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out []*ConfigEntryAuditEntry
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return out, qm, nil
+}
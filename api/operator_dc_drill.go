@@ -0,0 +1,66 @@
+package api
+
+import "time"
+
+// DatacenterDrill describes an in-progress simulated failover drill
+// against a remote datacenter.
+type DatacenterDrill struct {
+	TargetDatacenter string
+	ExpiresAt        time.Time
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// DatacenterDrillStart starts a failover drill against the given remote
+// datacenter for the given duration. While it's active, this server treats
+// the target datacenter as unreachable for prepared query failover and
+// mesh gateway discovery, without touching real networking.
+func (op *Operator) DatacenterDrillStart(dc string, duration time.Duration, q *WriteOptions) error {
+	r := op.c.newRequest("PUT", "/v1/operator/dc-drill")
+	r.setWriteOptions(q)
+	r.params.Set("dc", dc)
+	r.params.Set("duration", duration.String())
+	_, resp, err := requireOK(op.c.doRequest(r))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// DatacenterDrillStop ends a failover drill against the given remote
+// datacenter early.
+func (op *Operator) DatacenterDrillStop(dc string, q *WriteOptions) error {
+	r := op.c.newRequest("DELETE", "/v1/operator/dc-drill")
+	r.setWriteOptions(q)
+	r.params.Set("dc", dc)
+	_, resp, err := requireOK(op.c.doRequest(r))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// DatacenterDrills returns the remote datacenters that currently have an
+// active failover drill against them.
+func (op *Operator) DatacenterDrills(q *QueryOptions) ([]*DatacenterDrill, *QueryMeta, error) {
+	r := op.c.newRequest("GET", "/v1/operator/dc-drills")
+	r.setQueryOptions(q)
+	rtt, resp, err := requireOK(op.c.doRequest(r))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out []*DatacenterDrill
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return out, qm, nil
+}
@@ -0,0 +1,42 @@
+package api
+
+// FSCKResult describes a single invariant violation found by a FSCK scan.
+type FSCKResult struct {
+	// Category identifies the kind of invariant violation, e.g.
+	// "service-missing-node" or "token-missing-policy".
+	Category string
+
+	// Resource identifies the offending object.
+	Resource string
+
+	// Reference identifies the missing object Resource points to.
+	Reference string
+
+	// Repairable is true if a repair run would correct this violation.
+	Repairable bool
+
+	// Repaired is true if this violation was corrected by this run.
+	Repaired bool
+}
+
+// FSCK scans the catalog, session, ACL, and config entry tables for
+// dangling references. If repair is true, any violations that can be
+// corrected automatically are fixed in place.
+func (op *Operator) FSCK(repair bool, q *QueryOptions) ([]*FSCKResult, error) {
+	r := op.c.newRequest("GET", "/v1/operator/fsck")
+	r.setQueryOptions(q)
+	if repair {
+		r.params.Set("repair", "true")
+	}
+	_, resp, err := requireOK(op.c.doRequest(r))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var out []*FSCKResult
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
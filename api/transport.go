@@ -0,0 +1,264 @@
+package api
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultTransportRetryMax is the default number of additional attempts
+	// FailoverTransport makes for an idempotent request before giving up.
+	DefaultTransportRetryMax = 2
+
+	// DefaultTransportRetryWaitMin is the default lower bound of the jittered
+	// backoff FailoverTransport waits between retries.
+	DefaultTransportRetryWaitMin = 100 * time.Millisecond
+
+	// DefaultTransportRetryWaitMax is the default upper bound of the jittered
+	// backoff FailoverTransport waits between retries.
+	DefaultTransportRetryWaitMax = 2 * time.Second
+
+	// DefaultTransportUnhealthyTimeout is how long FailoverTransport avoids an
+	// address after it fails a request, before giving it another chance.
+	DefaultTransportUnhealthyTimeout = 30 * time.Second
+)
+
+// FailoverTransportConfig configures a FailoverTransport. Addresses is the
+// only required field.
+type FailoverTransportConfig struct {
+	// Addresses is the list of agent/server "host:port" pairs to send
+	// requests to, in preference order. At least one address is required.
+	Addresses []string
+
+	// Transport is the underlying RoundTripper used to make requests to a
+	// chosen address. Defaults to http.DefaultTransport if not provided.
+	Transport http.RoundTripper
+
+	// Timeout bounds how long a single attempt against one address is
+	// allowed to take before it is treated as a failed attempt and the next
+	// address or retry is tried. Zero means no per-attempt timeout is
+	// enforced beyond whatever the caller's own context provides.
+	Timeout time.Duration
+
+	// RetryMax is the maximum number of additional attempts made for an
+	// idempotent request (GET, HEAD, OPTIONS) after the first one fails.
+	// Writes are never retried, since FailoverTransport has no way to know
+	// whether a failed write was already applied. Defaults to
+	// DefaultTransportRetryMax if zero; set to -1 to disable retries.
+	RetryMax int
+
+	// RetryWaitMin and RetryWaitMax bound the jittered backoff applied
+	// between retries. They default to DefaultTransportRetryWaitMin and
+	// DefaultTransportRetryWaitMax if not provided.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// UnhealthyTimeout is how long an address that failed a request is
+	// skipped over in favor of others, before being given another chance.
+	// Defaults to DefaultTransportUnhealthyTimeout if not provided.
+	UnhealthyTimeout time.Duration
+}
+
+// FailoverTransport is an http.RoundTripper that spreads requests across
+// multiple agent/server addresses, skipping ones that have recently failed,
+// and retries idempotent requests with jitter on failure. It's meant to
+// replace the ad-hoc retry loops that client code tends to build around a
+// single-address *Client: construct one, put it on Config.HttpClient, and
+// every call made with that client gets the same failover and retry
+// behavior for free.
+//
+// FailoverTransport itself does not change which address ends up in the
+// request URL that Client builds; it overwrites req.URL.Host with whichever
+// address it selects for each attempt, so the Address in Client's Config is
+// only used as a placeholder.
+type FailoverTransport struct {
+	addresses []string
+	transport http.RoundTripper
+	timeout   time.Duration
+
+	retryMax         int
+	retryWaitMin     time.Duration
+	retryWaitMax     time.Duration
+	unhealthyTimeout time.Duration
+
+	mu          sync.Mutex
+	unhealthyAt map[string]time.Time
+}
+
+// NewFailoverTransport creates a FailoverTransport from the given config.
+func NewFailoverTransport(config *FailoverTransportConfig) *FailoverTransport {
+	if len(config.Addresses) == 0 {
+		panic("api: FailoverTransportConfig.Addresses must not be empty")
+	}
+
+	transport := config.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	retryMax := config.RetryMax
+	if retryMax == 0 {
+		retryMax = DefaultTransportRetryMax
+	} else if retryMax < 0 {
+		retryMax = 0
+	}
+
+	retryWaitMin := config.RetryWaitMin
+	if retryWaitMin == 0 {
+		retryWaitMin = DefaultTransportRetryWaitMin
+	}
+
+	retryWaitMax := config.RetryWaitMax
+	if retryWaitMax == 0 {
+		retryWaitMax = DefaultTransportRetryWaitMax
+	}
+
+	unhealthyTimeout := config.UnhealthyTimeout
+	if unhealthyTimeout == 0 {
+		unhealthyTimeout = DefaultTransportUnhealthyTimeout
+	}
+
+	addresses := make([]string, len(config.Addresses))
+	copy(addresses, config.Addresses)
+
+	return &FailoverTransport{
+		addresses:        addresses,
+		transport:        transport,
+		timeout:          config.Timeout,
+		retryMax:         retryMax,
+		retryWaitMin:     retryWaitMin,
+		retryWaitMax:     retryWaitMax,
+		unhealthyTimeout: unhealthyTimeout,
+		unhealthyAt:      make(map[string]time.Time),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *FailoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attempts := 1
+	if isIdempotentMethod(req.Method) {
+		attempts += t.retryMax
+	}
+
+	addrs := t.candidateAddresses()
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(t.backoff(attempt))
+			select {
+			case <-req.Context().Done():
+				timer.Stop()
+				if lastErr != nil {
+					return nil, lastErr
+				}
+				return nil, req.Context().Err()
+			case <-timer.C:
+			}
+		}
+
+		addr := addrs[attempt%len(addrs)]
+		resp, err := t.roundTrip(req, addr)
+		if err == nil {
+			t.markHealthy(addr)
+			return resp, nil
+		}
+
+		lastErr = err
+		t.markUnhealthy(addr)
+	}
+	return nil, lastErr
+}
+
+// roundTrip sends req to addr using the underlying transport, applying the
+// configured per-attempt timeout if any.
+func (t *FailoverTransport) roundTrip(req *http.Request, addr string) (*http.Response, error) {
+	attemptReq := req.Clone(req.Context())
+	attemptReq.URL.Host = addr
+	attemptReq.Host = addr
+
+	if t.timeout <= 0 {
+		return t.transport.RoundTrip(attemptReq)
+	}
+
+	ctx, cancel := context.WithTimeout(attemptReq.Context(), t.timeout)
+	resp, err := t.transport.RoundTrip(attemptReq.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// The timeout must stay in effect while the caller reads the body, so
+	// cancel it when the body is closed rather than right away.
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnCloseBody cancels an attempt's timeout context once its response
+// body is closed, instead of as soon as RoundTrip returns.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// candidateAddresses returns the configured addresses ordered so that
+// currently-unhealthy ones are tried last, without dropping them entirely:
+// if every address is unhealthy we still need somewhere to send the request.
+func (t *FailoverTransport) candidateAddresses() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	healthy := make([]string, 0, len(t.addresses))
+	unhealthy := make([]string, 0, len(t.addresses))
+	for _, addr := range t.addresses {
+		if until, ok := t.unhealthyAt[addr]; ok && now.Before(until) {
+			unhealthy = append(unhealthy, addr)
+		} else {
+			healthy = append(healthy, addr)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+func (t *FailoverTransport) markHealthy(addr string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.unhealthyAt, addr)
+}
+
+func (t *FailoverTransport) markUnhealthy(addr string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.unhealthyAt[addr] = time.Now().Add(t.unhealthyTimeout)
+}
+
+// backoff returns a jittered backoff duration for the given retry attempt,
+// growing towards retryWaitMax as attempt increases.
+func (t *FailoverTransport) backoff(attempt int) time.Duration {
+	mult := time.Duration(1 << uint(attempt-1))
+	wait := t.retryWaitMin * mult
+	if wait > t.retryWaitMax || wait <= 0 {
+		wait = t.retryWaitMax
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2+1)))
+}
+
+// isIdempotentMethod reports whether it's safe to retry a request with the
+// given HTTP method after a failed attempt.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// deadAddr returns a "host:port" that nothing is listening on, by binding
+// and immediately closing a listener.
+func deadAddr(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	return addr
+}
+
+func TestFailoverTransport_Failover(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	ft := NewFailoverTransport(&FailoverTransportConfig{
+		Addresses:    []string{deadAddr(t), srv.Listener.Addr().String()},
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 5 * time.Millisecond,
+	})
+
+	req, err := http.NewRequest("GET", "http://"+deadAddr(t)+"/v1/status/leader", nil)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	resp, err := ft.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("bad status: %d", resp.StatusCode)
+	}
+}
+
+func TestFailoverTransport_RetriesIdempotentOnly(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		if n < 3 {
+			// Simulate a connection that never responds by closing early.
+			hj, ok := w.(http.Hijacker)
+			if !ok {
+				t.Fatal("expected hijackable ResponseWriter")
+			}
+			conn, _, err := hj.Hijack()
+			if err != nil {
+				t.Fatalf("err: %v", err)
+			}
+			conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	addr := srv.Listener.Addr().String()
+
+	ft := NewFailoverTransport(&FailoverTransportConfig{
+		Addresses:    []string{addr},
+		RetryMax:     3,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 5 * time.Millisecond,
+	})
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("http://%s/v1/status/leader", addr), nil)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	resp, err := ft.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("expected GET to eventually succeed via retries, got err: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+
+	atomic.StoreInt32(&requests, 0)
+	req, err = http.NewRequest("PUT", fmt.Sprintf("http://%s/v1/kv/foo", addr), nil)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if _, err := ft.RoundTrip(req); err == nil {
+		t.Fatal("expected PUT to fail without retrying")
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Fatalf("expected exactly 1 attempt for a write, got %d", got)
+	}
+}
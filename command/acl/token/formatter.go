@@ -5,13 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"text/template"
 
 	"github.com/hashicorp/consul/api"
 )
 
 const (
-	PrettyFormat string = "pretty"
-	JSONFormat   string = "json"
+	PrettyFormat   string = "pretty"
+	JSONFormat     string = "json"
+	TemplateFormat string = "template"
 )
 
 // Formatter defines methods provided by token command output formatter
@@ -22,16 +24,19 @@ type Formatter interface {
 
 // GetSupportedFormats returns supported formats
 func GetSupportedFormats() []string {
-	return []string{PrettyFormat, JSONFormat}
+	return []string{PrettyFormat, JSONFormat, TemplateFormat}
 }
 
-// NewFormatter returns Formatter implementation
-func NewFormatter(format string, showMeta bool) (formatter Formatter, err error) {
+// NewFormatter returns Formatter implementation. tmpl is the Go template
+// used by the template formatter, and is ignored by the other formats.
+func NewFormatter(format string, showMeta bool, tmpl string) (formatter Formatter, err error) {
 	switch format {
 	case PrettyFormat:
 		formatter = newPrettyFormatter(showMeta)
 	case JSONFormat:
 		formatter = newJSONFormatter(showMeta)
+	case TemplateFormat:
+		formatter, err = newTemplateFormatter(tmpl)
 	default:
 		err = fmt.Errorf("Unknown format: %s", format)
 	}
@@ -201,3 +206,34 @@ func (f *jsonFormatter) FormatToken(token *api.ACLToken) (string, error) {
 	}
 	return string(b), nil
 }
+
+func newTemplateFormatter(tmpl string) (Formatter, error) {
+	if tmpl == "" {
+		return nil, fmt.Errorf("-template is required when -format=%s", TemplateFormat)
+	}
+	t, err := template.New("token").Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid -template: %v", err)
+	}
+	return &templateFormatter{t}, nil
+}
+
+type templateFormatter struct {
+	tmpl *template.Template
+}
+
+func (f *templateFormatter) FormatToken(token *api.ACLToken) (string, error) {
+	var buf bytes.Buffer
+	if err := f.tmpl.Execute(&buf, token); err != nil {
+		return "", fmt.Errorf("Failed to execute -template: %v", err)
+	}
+	return buf.String(), nil
+}
+
+func (f *templateFormatter) FormatTokenList(tokens []*api.ACLTokenListEntry) (string, error) {
+	var buf bytes.Buffer
+	if err := f.tmpl.Execute(&buf, tokens); err != nil {
+		return "", fmt.Errorf("Failed to execute -template: %v", err)
+	}
+	return buf.String(), nil
+}
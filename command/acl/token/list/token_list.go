@@ -24,6 +24,7 @@ type cmd struct {
 
 	showMeta bool
 	format   string
+	template string
 }
 
 func (c *cmd) init() {
@@ -36,6 +37,12 @@ func (c *cmd) init() {
 		token.PrettyFormat,
 		fmt.Sprintf("Output format {%s}", strings.Join(token.GetSupportedFormats(), "|")),
 	)
+	c.flags.StringVar(
+		&c.template,
+		"template",
+		"",
+		fmt.Sprintf("Go template applied to the result when -format=%s.", token.TemplateFormat),
+	)
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
 	flags.Merge(c.flags, c.http.ServerFlags())
@@ -60,7 +67,7 @@ func (c *cmd) Run(args []string) int {
 		return 1
 	}
 
-	formatter, err := token.NewFormatter(c.format, c.showMeta)
+	formatter, err := token.NewFormatter(c.format, c.showMeta, c.template)
 	if err != nil {
 		c.UI.Error(err.Error())
 		return 1
@@ -39,6 +39,7 @@ type cmd struct {
 	showMeta           bool
 	upgradeLegacy      bool
 	format             string
+	template           string
 }
 
 func (c *cmd) init() {
@@ -82,6 +83,12 @@ func (c *cmd) init() {
 		token.PrettyFormat,
 		fmt.Sprintf("Output format {%s}", strings.Join(token.GetSupportedFormats(), "|")),
 	)
+	c.flags.StringVar(
+		&c.template,
+		"template",
+		"",
+		fmt.Sprintf("Go template applied to the result when -format=%s.", token.TemplateFormat),
+	)
 
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
@@ -306,7 +313,7 @@ func (c *cmd) Run(args []string) int {
 		return 1
 	}
 
-	formatter, err := token.NewFormatter(c.format, c.showMeta)
+	formatter, err := token.NewFormatter(c.format, c.showMeta, c.template)
 	if err != nil {
 		c.UI.Error(err.Error())
 		return 1
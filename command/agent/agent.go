@@ -170,6 +170,17 @@ func (c *cmd) run(args []string) int {
 		return 1
 	}
 
+	// Captured before agent shadows the package name below.
+	debugDumpSignals := agent.DebugDumpSignals
+	isDebugDumpSignal := func(sig os.Signal) bool {
+		for _, s := range debugDumpSignals {
+			if s == sig {
+				return true
+			}
+		}
+		return false
+	}
+
 	c.logger = bd.Logger
 	agent, err := agent.New(bd)
 	if err != nil {
@@ -210,7 +221,7 @@ func (c *cmd) run(args []string) int {
 
 	// wait for signal
 	signalCh := make(chan os.Signal, 10)
-	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGPIPE)
+	signal.Notify(signalCh, append([]os.Signal{os.Interrupt, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGPIPE}, debugDumpSignals...)...)
 
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -224,6 +235,11 @@ func (c *cmd) run(args []string) int {
 				return
 			}
 
+			if isDebugDumpSignal(sig) {
+				c.logger.Warn("Caught signal to dump debug profiles, but agent hasn't started yet", "signal", sig)
+				continue
+			}
+
 			switch sig {
 			case syscall.SIGPIPE:
 				continue
@@ -274,7 +290,7 @@ func (c *cmd) run(args []string) int {
 
 	// wait for signal
 	signalCh = make(chan os.Signal, 10)
-	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGPIPE)
+	signal.Notify(signalCh, append([]os.Signal{os.Interrupt, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGPIPE}, debugDumpSignals...)...)
 
 	for {
 		var sig os.Signal
@@ -296,6 +312,16 @@ func (c *cmd) run(args []string) int {
 			return 0
 		}
 
+		if isDebugDumpSignal(sig) {
+			c.logger.Info("Caught signal, dumping debug profiles", "signal", sig)
+			if paths, err := agent.DumpDebugProfiles(); err != nil {
+				c.logger.Error("Failed to dump debug profiles", "error", err)
+			} else {
+				c.logger.Info("Wrote debug profiles", "paths", paths)
+			}
+			continue
+		}
+
 		switch sig {
 		case syscall.SIGPIPE:
 			continue
@@ -3,6 +3,7 @@ package dc
 import (
 	"flag"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/consul/command/flags"
 	"github.com/mitchellh/cli"
@@ -15,14 +16,17 @@ func New(ui cli.Ui) *cmd {
 }
 
 type cmd struct {
-	UI    cli.Ui
-	flags *flag.FlagSet
-	http  *flags.HTTPFlags
-	help  string
+	UI     cli.Ui
+	flags  *flag.FlagSet
+	http   *flags.HTTPFlags
+	format *flags.FormatFlags
+	help   string
 }
 
 func (c *cmd) init() {
 	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.format = &flags.FormatFlags{}
+	flags.Merge(c.flags, c.format.Flags())
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
 	flags.Merge(c.flags, c.http.ServerFlags())
@@ -52,9 +56,18 @@ func (c *cmd) Run(args []string) int {
 		return 1
 	}
 
-	for _, dc := range dcs {
-		c.UI.Info(dc)
+	out, err := c.format.Format(dcs, func() (string, error) {
+		var b strings.Builder
+		for _, dc := range dcs {
+			fmt.Fprintln(&b, dc)
+		}
+		return strings.TrimSuffix(b.String(), "\n"), nil
+	})
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error formatting datacenters: %s", err))
+		return 1
 	}
+	c.UI.Info(out)
 
 	return 0
 }
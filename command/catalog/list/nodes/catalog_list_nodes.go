@@ -21,10 +21,11 @@ func New(ui cli.Ui) *cmd {
 }
 
 type cmd struct {
-	UI    cli.Ui
-	flags *flag.FlagSet
-	http  *flags.HTTPFlags
-	help  string
+	UI     cli.Ui
+	flags  *flag.FlagSet
+	http   *flags.HTTPFlags
+	format *flags.FormatFlags
+	help   string
 
 	// flags
 	detailed bool
@@ -39,6 +40,8 @@ type cmd struct {
 // init sets up command flags and help text
 func (c *cmd) init() {
 	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.format = &flags.FormatFlags{}
+	flags.Merge(c.flags, c.format.Flags())
 	c.flags.StringVar(&c.filter, "filter", "", "Filter to use with the request")
 	c.flags.BoolVar(&c.detailed, "detailed", false, "Output detailed information about "+
 		"the nodes including their addresses and metadata.")
@@ -126,7 +129,9 @@ func (c *cmd) Run(args []string) int {
 		return 0
 	}
 
-	output, err := printNodes(nodes, c.detailed)
+	output, err := c.format.Format(nodes, func() (string, error) {
+		return printNodes(nodes, c.detailed)
+	})
 	if err != nil {
 		c.UI.Error(fmt.Sprintf("Error printing nodes: %s", err))
 		return 1
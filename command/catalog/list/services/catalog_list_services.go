@@ -20,10 +20,11 @@ func New(ui cli.Ui) *cmd {
 }
 
 type cmd struct {
-	UI    cli.Ui
-	flags *flag.FlagSet
-	http  *flags.HTTPFlags
-	help  string
+	UI     cli.Ui
+	flags  *flag.FlagSet
+	http   *flags.HTTPFlags
+	format *flags.FormatFlags
+	help   string
 
 	// flags
 	node     string
@@ -33,6 +34,8 @@ type cmd struct {
 
 func (c *cmd) init() {
 	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.format = &flags.FormatFlags{}
+	flags.Merge(c.flags, c.format.Flags())
 	c.flags.StringVar(&c.node, "node", "",
 		"Node `id or name` for which to list services.")
 	c.flags.Var((*flags.FlagMapValue)(&c.nodeMeta), "node-meta", "Metadata to "+
@@ -105,23 +108,30 @@ func (c *cmd) Run(args []string) int {
 	}
 	sort.Strings(order)
 
-	if c.tags {
-		var b bytes.Buffer
-		tw := tabwriter.NewWriter(&b, 0, 2, 6, ' ', 0)
-		for _, s := range order {
-			sort.Strings(services[s])
-			fmt.Fprintf(tw, "%s\t%s\n", s, strings.Join(services[s], ","))
-		}
-		if err := tw.Flush(); err != nil {
-			c.UI.Error(fmt.Sprintf("Error flushing tabwriter: %s", err))
-			return 1
-		}
-		c.UI.Output(strings.TrimSpace(b.String()))
-	} else {
-		for _, s := range order {
-			c.UI.Output(s)
+	for _, s := range order {
+		sort.Strings(services[s])
+	}
+
+	output, err := c.format.Format(services, func() (string, error) {
+		if c.tags {
+			var b bytes.Buffer
+			tw := tabwriter.NewWriter(&b, 0, 2, 6, ' ', 0)
+			for _, s := range order {
+				fmt.Fprintf(tw, "%s\t%s\n", s, strings.Join(services[s], ","))
+			}
+			if err := tw.Flush(); err != nil {
+				return "", fmt.Errorf("error flushing tabwriter: %s", err)
+			}
+			return strings.TrimSpace(b.String()), nil
 		}
+
+		return strings.Join(order, "\n"), nil
+	})
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error formatting services: %s", err))
+		return 1
 	}
+	c.UI.Output(output)
 
 	return 0
 }
@@ -54,6 +54,11 @@ import (
 	pipebootstrap "github.com/hashicorp/consul/command/connect/envoy/pipe-bootstrap"
 	"github.com/hashicorp/consul/command/connect/expose"
 	"github.com/hashicorp/consul/command/connect/proxy"
+	"github.com/hashicorp/consul/command/context"
+	ctxdelete "github.com/hashicorp/consul/command/context/delete"
+	ctxlist "github.com/hashicorp/consul/command/context/list"
+	ctxset "github.com/hashicorp/consul/command/context/set"
+	ctxuse "github.com/hashicorp/consul/command/context/use"
 	"github.com/hashicorp/consul/command/debug"
 	"github.com/hashicorp/consul/command/event"
 	"github.com/hashicorp/consul/command/exec"
@@ -82,9 +87,12 @@ import (
 	"github.com/hashicorp/consul/command/members"
 	"github.com/hashicorp/consul/command/monitor"
 	"github.com/hashicorp/consul/command/operator"
+	operauditlog "github.com/hashicorp/consul/command/operator/auditlog"
 	operauto "github.com/hashicorp/consul/command/operator/autopilot"
 	operautoget "github.com/hashicorp/consul/command/operator/autopilot/get"
 	operautoset "github.com/hashicorp/consul/command/operator/autopilot/set"
+	operdcdrill "github.com/hashicorp/consul/command/operator/dcdrill"
+	operfsck "github.com/hashicorp/consul/command/operator/fsck"
 	operraft "github.com/hashicorp/consul/command/operator/raft"
 	operraftlist "github.com/hashicorp/consul/command/operator/raft/listpeers"
 	operraftremove "github.com/hashicorp/consul/command/operator/raft/removepeer"
@@ -92,11 +100,16 @@ import (
 	"github.com/hashicorp/consul/command/rtt"
 	"github.com/hashicorp/consul/command/services"
 	svcsderegister "github.com/hashicorp/consul/command/services/deregister"
+	svcsexport "github.com/hashicorp/consul/command/services/export"
+	svcshealth "github.com/hashicorp/consul/command/services/health"
+	svcsinfo "github.com/hashicorp/consul/command/services/info"
+	svcslist "github.com/hashicorp/consul/command/services/list"
 	svcsregister "github.com/hashicorp/consul/command/services/register"
 	"github.com/hashicorp/consul/command/snapshot"
 	snapinspect "github.com/hashicorp/consul/command/snapshot/inspect"
 	snaprestore "github.com/hashicorp/consul/command/snapshot/restore"
 	snapsave "github.com/hashicorp/consul/command/snapshot/save"
+	"github.com/hashicorp/consul/command/subscribe"
 	"github.com/hashicorp/consul/command/tls"
 	tlsca "github.com/hashicorp/consul/command/tls/ca"
 	tlscacreate "github.com/hashicorp/consul/command/tls/ca/create"
@@ -171,6 +184,11 @@ func init() {
 	Register("connect envoy", func(ui cli.Ui) (cli.Command, error) { return envoy.New(ui), nil })
 	Register("connect envoy pipe-bootstrap", func(ui cli.Ui) (cli.Command, error) { return pipebootstrap.New(ui), nil })
 	Register("connect expose", func(ui cli.Ui) (cli.Command, error) { return expose.New(ui), nil })
+	Register("context", func(ui cli.Ui) (cli.Command, error) { return context.New(), nil })
+	Register("context set", func(ui cli.Ui) (cli.Command, error) { return ctxset.New(ui), nil })
+	Register("context use", func(ui cli.Ui) (cli.Command, error) { return ctxuse.New(ui), nil })
+	Register("context list", func(ui cli.Ui) (cli.Command, error) { return ctxlist.New(ui), nil })
+	Register("context delete", func(ui cli.Ui) (cli.Command, error) { return ctxdelete.New(ui), nil })
 	Register("debug", func(ui cli.Ui) (cli.Command, error) { return debug.New(ui, MakeShutdownCh()), nil })
 	Register("event", func(ui cli.Ui) (cli.Command, error) { return event.New(ui), nil })
 	Register("exec", func(ui cli.Ui) (cli.Command, error) { return exec.New(ui, MakeShutdownCh()), nil })
@@ -199,9 +217,12 @@ func init() {
 	Register("members", func(ui cli.Ui) (cli.Command, error) { return members.New(ui), nil })
 	Register("monitor", func(ui cli.Ui) (cli.Command, error) { return monitor.New(ui, MakeShutdownCh()), nil })
 	Register("operator", func(cli.Ui) (cli.Command, error) { return operator.New(), nil })
+	Register("operator audit-log", func(ui cli.Ui) (cli.Command, error) { return operauditlog.New(ui), nil })
 	Register("operator autopilot", func(cli.Ui) (cli.Command, error) { return operauto.New(), nil })
 	Register("operator autopilot get-config", func(ui cli.Ui) (cli.Command, error) { return operautoget.New(ui), nil })
 	Register("operator autopilot set-config", func(ui cli.Ui) (cli.Command, error) { return operautoset.New(ui), nil })
+	Register("operator dc-drill", func(ui cli.Ui) (cli.Command, error) { return operdcdrill.New(ui), nil })
+	Register("operator fsck", func(ui cli.Ui) (cli.Command, error) { return operfsck.New(ui), nil })
 	Register("operator raft", func(cli.Ui) (cli.Command, error) { return operraft.New(), nil })
 	Register("operator raft list-peers", func(ui cli.Ui) (cli.Command, error) { return operraftlist.New(ui), nil })
 	Register("operator raft remove-peer", func(ui cli.Ui) (cli.Command, error) { return operraftremove.New(ui), nil })
@@ -210,10 +231,15 @@ func init() {
 	Register("services", func(cli.Ui) (cli.Command, error) { return services.New(), nil })
 	Register("services register", func(ui cli.Ui) (cli.Command, error) { return svcsregister.New(ui), nil })
 	Register("services deregister", func(ui cli.Ui) (cli.Command, error) { return svcsderegister.New(ui), nil })
+	Register("services list", func(ui cli.Ui) (cli.Command, error) { return svcslist.New(ui), nil })
+	Register("services info", func(ui cli.Ui) (cli.Command, error) { return svcsinfo.New(ui), nil })
+	Register("services health", func(ui cli.Ui) (cli.Command, error) { return svcshealth.New(ui), nil })
+	Register("services export", func(ui cli.Ui) (cli.Command, error) { return svcsexport.New(ui), nil })
 	Register("snapshot", func(cli.Ui) (cli.Command, error) { return snapshot.New(), nil })
 	Register("snapshot inspect", func(ui cli.Ui) (cli.Command, error) { return snapinspect.New(ui), nil })
 	Register("snapshot restore", func(ui cli.Ui) (cli.Command, error) { return snaprestore.New(ui), nil })
 	Register("snapshot save", func(ui cli.Ui) (cli.Command, error) { return snapsave.New(ui), nil })
+	Register("subscribe", func(ui cli.Ui) (cli.Command, error) { return subscribe.New(ui, MakeShutdownCh()), nil })
 	Register("tls", func(ui cli.Ui) (cli.Command, error) { return tls.New(), nil })
 	Register("tls ca", func(ui cli.Ui) (cli.Command, error) { return tlsca.New(), nil })
 	Register("tls ca create", func(ui cli.Ui) (cli.Command, error) { return tlscacreate.New(ui), nil })
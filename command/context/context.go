@@ -0,0 +1,56 @@
+package context
+
+import (
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+func New() *cmd {
+	return &cmd{}
+}
+
+type cmd struct{}
+
+func (c *cmd) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(help, nil)
+}
+
+const synopsis = "Manage named connection profiles for the Consul CLI"
+const help = `
+Usage: consul context <subcommand> [options] [args]
+
+  This command has subcommands for managing named connection profiles, which
+  store the address, datacenter, token, and TLS settings other commands need
+  to reach a particular Consul cluster. They're useful when working with
+  several clusters, as an alternative to repeating flags or exporting
+  environment variables such as CONSUL_HTTP_ADDR and CONSUL_HTTP_TOKEN.
+
+  Create or update a profile:
+
+      $ consul context set prod -address "consul.prod.example.com:8501" \
+                                 -token "0bc6bc46-f25e-4262-b2d9-ffbe1d96be6f" \
+                                 -ca-file /etc/consul/prod-ca.pem
+
+  Make it the default for subsequent commands:
+
+      $ consul context use prod
+
+  List known profiles:
+
+      $ consul context list
+
+  Any command that embeds the standard HTTP flags also accepts -context to
+  use a profile for a single invocation without changing the default:
+
+      $ consul members -context prod
+
+  For more examples, ask for subcommand help or view the documentation.
+`
@@ -0,0 +1,81 @@
+package contextdelete
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	help  string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	cmdArgs := c.flags.Args()
+	if len(cmdArgs) != 1 {
+		c.UI.Error("Must specify exactly one context name")
+		return 1
+	}
+	name := cmdArgs[0]
+
+	cf, err := flags.LoadContextFile()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error loading context file: %s", err))
+		return 1
+	}
+
+	if _, ok := cf.Contexts[name]; !ok {
+		c.UI.Error(fmt.Sprintf("No such context %q", name))
+		return 1
+	}
+	delete(cf.Contexts, name)
+	if cf.Current == name {
+		cf.Current = ""
+	}
+
+	if err := flags.SaveContextFile(cf); err != nil {
+		c.UI.Error(fmt.Sprintf("Error saving context file: %s", err))
+		return 1
+	}
+
+	c.UI.Info(fmt.Sprintf("Context %q deleted successfully", name))
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const synopsis = "Delete a connection profile"
+const help = `
+Usage: consul context delete <name>
+
+    Deletes the named connection profile. If it was the default selected
+    with 'consul context use', there is no default profile until another
+    one is selected.
+
+        $ consul context delete prod
+`
@@ -0,0 +1,46 @@
+package contextdelete
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextDeleteCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestContextDeleteCommand(t *testing.T) {
+	t.Setenv("CONSUL_CONTEXT_FILE", filepath.Join(t.TempDir(), "contexts.json"))
+
+	require.NoError(t, flags.SaveContextFile(&flags.ContextFile{
+		Current:  "prod",
+		Contexts: map[string]flags.Context{"prod": {Address: "consul.example.com:8501"}},
+	}))
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	code := c.Run([]string{"prod"})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+	cf, err := flags.LoadContextFile()
+	require.NoError(t, err)
+	require.Empty(t, cf.Contexts)
+	require.Empty(t, cf.Current)
+}
+
+func TestContextDeleteCommand_UnknownContext(t *testing.T) {
+	t.Setenv("CONSUL_CONTEXT_FILE", filepath.Join(t.TempDir(), "contexts.json"))
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	code := c.Run([]string{"does-not-exist"})
+	require.Equal(t, 1, code)
+}
@@ -0,0 +1,77 @@
+package contextlist
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	help  string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	cf, err := flags.LoadContextFile()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error loading context file: %s", err))
+		return 1
+	}
+
+	if len(cf.Contexts) == 0 {
+		c.UI.Info("No contexts defined. Create one with 'consul context set'.")
+		return 0
+	}
+
+	names := make([]string, 0, len(cf.Contexts))
+	for name := range cf.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		ctx := cf.Contexts[name]
+		marker := " "
+		if name == cf.Current {
+			marker = "*"
+		}
+		c.UI.Info(fmt.Sprintf("%s %-20s %s", marker, name, ctx.Address))
+	}
+
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const synopsis = "List known connection profiles"
+const help = `
+Usage: consul context list
+
+    Lists every connection profile created with 'consul context set'. The
+    profile currently selected with 'consul context use' is marked with '*'.
+`
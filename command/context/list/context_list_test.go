@@ -0,0 +1,39 @@
+package contextlist
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextListCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestContextListCommand(t *testing.T) {
+	t.Setenv("CONSUL_CONTEXT_FILE", filepath.Join(t.TempDir(), "contexts.json"))
+
+	require.NoError(t, flags.SaveContextFile(&flags.ContextFile{
+		Current: "prod",
+		Contexts: map[string]flags.Context{
+			"prod": {Address: "consul.prod.example.com:8501"},
+			"dev":  {Address: "127.0.0.1:8500"},
+		},
+	}))
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	code := c.Run(nil)
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+	out := ui.OutputWriter.String()
+	require.Contains(t, out, "* prod")
+	require.Contains(t, out, "  dev")
+}
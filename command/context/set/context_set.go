@@ -0,0 +1,121 @@
+package contextset
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	help  string
+
+	address       string
+	datacenter    string
+	namespace     string
+	token         string
+	tokenFile     string
+	caFile        string
+	caPath        string
+	certFile      string
+	keyFile       string
+	tlsServerName string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.address, "address", "", "The address and port of the "+
+		"Consul HTTP agent to use for this profile.")
+	c.flags.StringVar(&c.datacenter, "datacenter", "", "The datacenter to use for this profile.")
+	c.flags.StringVar(&c.namespace, "namespace", "", "The namespace to use for this profile. "+
+		"Namespaces are a Consul Enterprise feature.")
+	c.flags.StringVar(&c.token, "token", "", "The ACL token to use for this profile.")
+	c.flags.StringVar(&c.tokenFile, "token-file", "", "Path to a file containing the "+
+		"ACL token to use for this profile.")
+	c.flags.StringVar(&c.caFile, "ca-file", "", "Path to a CA file to use for TLS "+
+		"when communicating with this profile's agent.")
+	c.flags.StringVar(&c.caPath, "ca-path", "", "Path to a directory of CA certificates "+
+		"to use for TLS when communicating with this profile's agent.")
+	c.flags.StringVar(&c.certFile, "client-cert", "", "Path to a client cert file to "+
+		"use for TLS when 'verify_incoming' is enabled on this profile's agent.")
+	c.flags.StringVar(&c.keyFile, "client-key", "", "Path to a client key file to use "+
+		"for TLS when 'verify_incoming' is enabled on this profile's agent.")
+	c.flags.StringVar(&c.tlsServerName, "tls-server-name", "", "The server name to use "+
+		"as the SNI host when connecting to this profile's agent via TLS.")
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	cmdArgs := c.flags.Args()
+	if len(cmdArgs) != 1 {
+		c.UI.Error("Must specify exactly one context name")
+		return 1
+	}
+	name := cmdArgs[0]
+
+	cf, err := flags.LoadContextFile()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error loading context file: %s", err))
+		return 1
+	}
+
+	cf.Contexts[name] = flags.Context{
+		Address:       c.address,
+		Datacenter:    c.datacenter,
+		Namespace:     c.namespace,
+		Token:         c.token,
+		TokenFile:     c.tokenFile,
+		CAFile:        c.caFile,
+		CAPath:        c.caPath,
+		CertFile:      c.certFile,
+		KeyFile:       c.keyFile,
+		TLSServerName: c.tlsServerName,
+	}
+
+	if err := flags.SaveContextFile(cf); err != nil {
+		c.UI.Error(fmt.Sprintf("Error saving context file: %s", err))
+		return 1
+	}
+
+	c.UI.Info(fmt.Sprintf("Context %q set successfully", name))
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const synopsis = "Create or replace a named connection profile"
+const help = `
+Usage: consul context set <name> [options]
+
+    Creates a new connection profile, or replaces the existing profile with
+    the given name. Replacing a profile overwrites every field, not just the
+    ones passed on the command line.
+
+    Create a profile for a production cluster reachable over TLS:
+
+        $ consul context set prod -address "consul.prod.example.com:8501" \
+                                   -token "0bc6bc46-f25e-4262-b2d9-ffbe1d96be6f" \
+                                   -ca-file /etc/consul/prod-ca.pem
+
+    Run 'consul context use prod' to make it the default, or pass
+    '-context prod' to any command to use it for a single invocation.
+`
@@ -0,0 +1,47 @@
+package contextset
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextSetCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestContextSetCommand(t *testing.T) {
+	t.Setenv("CONSUL_CONTEXT_FILE", filepath.Join(t.TempDir(), "contexts.json"))
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	code := c.Run([]string{
+		"-address", "consul.example.com:8501",
+		"-token", "test-token",
+		"prod",
+	})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+	cf, err := flags.LoadContextFile()
+	require.NoError(t, err)
+	require.Equal(t, flags.Context{
+		Address: "consul.example.com:8501",
+		Token:   "test-token",
+	}, cf.Contexts["prod"])
+}
+
+func TestContextSetCommand_MissingName(t *testing.T) {
+	t.Setenv("CONSUL_CONTEXT_FILE", filepath.Join(t.TempDir(), "contexts.json"))
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	code := c.Run([]string{"-address", "consul.example.com:8501"})
+	require.Equal(t, 1, code)
+}
@@ -0,0 +1,77 @@
+package contextuse
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	help  string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	cmdArgs := c.flags.Args()
+	if len(cmdArgs) != 1 {
+		c.UI.Error("Must specify exactly one context name")
+		return 1
+	}
+	name := cmdArgs[0]
+
+	cf, err := flags.LoadContextFile()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error loading context file: %s", err))
+		return 1
+	}
+
+	if _, ok := cf.Contexts[name]; !ok {
+		c.UI.Error(fmt.Sprintf("No such context %q (create it with 'consul context set')", name))
+		return 1
+	}
+
+	cf.Current = name
+	if err := flags.SaveContextFile(cf); err != nil {
+		c.UI.Error(fmt.Sprintf("Error saving context file: %s", err))
+		return 1
+	}
+
+	c.UI.Info(fmt.Sprintf("Now using context %q", name))
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const synopsis = "Set the default connection profile"
+const help = `
+Usage: consul context use <name>
+
+    Makes the named connection profile the default for commands that don't
+    pass -context and don't have CONSUL_CONTEXT set.
+
+        $ consul context use prod
+`
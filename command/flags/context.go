@@ -0,0 +1,94 @@
+package flags
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/consul/lib/file"
+)
+
+// contextFileEnvName overrides the location of the context file. It exists
+// primarily so tests don't have to touch the real user's home directory.
+const contextFileEnvName = "CONSUL_CONTEXT_FILE"
+
+// Context is a named connection profile: the subset of api.Config that can
+// be saved with `consul context set` and later applied by HTTPFlags.APIClient
+// to fill in defaults for -http-addr, -token, -datacenter, and the TLS flags.
+// Fields left empty are simply not applied.
+type Context struct {
+	Address       string `json:",omitempty"`
+	Datacenter    string `json:",omitempty"`
+	Namespace     string `json:",omitempty"`
+	Token         string `json:",omitempty"`
+	TokenFile     string `json:",omitempty"`
+	CAFile        string `json:",omitempty"`
+	CAPath        string `json:",omitempty"`
+	CertFile      string `json:",omitempty"`
+	KeyFile       string `json:",omitempty"`
+	TLSServerName string `json:",omitempty"`
+}
+
+// ContextFile is the on-disk format of the CLI's named connection profiles.
+// Current, when set, names the profile that's used by default when neither
+// the -context flag nor the CONSUL_CONTEXT environment variable is given.
+type ContextFile struct {
+	Current  string             `json:",omitempty"`
+	Contexts map[string]Context `json:",omitempty"`
+}
+
+// ContextFilePath returns the location of the context file, defaulting to
+// $HOME/.consul.d/contexts.json. It can be overridden with the
+// CONSUL_CONTEXT_FILE environment variable.
+func ContextFilePath() (string, error) {
+	if p := os.Getenv(contextFileEnvName); p != "" {
+		return p, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".consul.d", "contexts.json"), nil
+}
+
+// LoadContextFile reads the context file, returning an empty ContextFile if
+// one doesn't exist yet.
+func LoadContextFile() (*ContextFile, error) {
+	path, err := ContextFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	cf := &ContextFile{Contexts: make(map[string]Context)}
+	data, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return cf, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, cf); err != nil {
+		return nil, err
+	}
+	if cf.Contexts == nil {
+		cf.Contexts = make(map[string]Context)
+	}
+	return cf, nil
+}
+
+// SaveContextFile writes the context file atomically. It contains ACL
+// tokens, so it's written with owner-only permissions.
+func SaveContextFile(cf *ContextFile) error {
+	path, err := ContextFilePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cf, "", "  ")
+	if err != nil {
+		return err
+	}
+	return file.WriteAtomicWithPerms(path, data, 0700, 0600)
+}
@@ -0,0 +1,34 @@
+package flags
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadContextFile_Missing(t *testing.T) {
+	t.Setenv(contextFileEnvName, filepath.Join(t.TempDir(), "does-not-exist", "contexts.json"))
+
+	cf, err := LoadContextFile()
+	require.NoError(t, err)
+	require.Empty(t, cf.Contexts)
+	require.Empty(t, cf.Current)
+}
+
+func TestSaveAndLoadContextFile(t *testing.T) {
+	t.Setenv(contextFileEnvName, filepath.Join(t.TempDir(), "contexts.json"))
+
+	cf := &ContextFile{
+		Current: "prod",
+		Contexts: map[string]Context{
+			"prod": {Address: "consul.prod.example.com:8501", Token: "prod-token"},
+			"dev":  {Address: "127.0.0.1:8500"},
+		},
+	}
+	require.NoError(t, SaveContextFile(cf))
+
+	loaded, err := LoadContextFile()
+	require.NoError(t, err)
+	require.Equal(t, cf, loaded)
+}
@@ -0,0 +1,81 @@
+package flags
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+const (
+	// FormatPretty is the default, human-oriented output format. Commands
+	// that support FormatFlags are responsible for rendering this format
+	// themselves since it is typically a table tailored to that command.
+	FormatPretty = "pretty"
+
+	// FormatJSON renders the command's result data as indented JSON.
+	FormatJSON = "json"
+
+	// FormatTemplate renders the command's result data through the Go
+	// template given via -template.
+	FormatTemplate = "template"
+)
+
+// SupportedFormats is the full list of formats accepted by FormatFlags,
+// for use in flag usage strings and format validation error messages.
+var SupportedFormats = []string{FormatPretty, FormatJSON, FormatTemplate}
+
+// FormatFlags provides -format and -template flags shared by CLI commands
+// that can emit machine-readable output in addition to their default
+// human-oriented output, so that automation does not have to scrape
+// command tables. Embed it in a command, call Flags() to merge its flags
+// in during init, and call Format() once the command has assembled the
+// data it would otherwise print.
+type FormatFlags struct {
+	format   string
+	template string
+}
+
+// Flags returns a FlagSet with -format and -template registered. Merge it
+// into the command's own FlagSet.
+func (f *FormatFlags) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.StringVar(&f.format, "format", FormatPretty,
+		fmt.Sprintf("Output format {%s}", strings.Join(SupportedFormats, "|")))
+	fs.StringVar(&f.template, "template", "",
+		"Go template applied to the result when -format=template.")
+	return fs
+}
+
+// Format renders data according to the configured -format. pretty is
+// invoked to produce the command's usual human-oriented output, and is
+// only called when -format=pretty (the default).
+func (f *FormatFlags) Format(data interface{}, pretty func() (string, error)) (string, error) {
+	switch f.format {
+	case FormatPretty, "":
+		return pretty()
+	case FormatJSON:
+		b, err := json.MarshalIndent(data, "", "    ")
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal result as JSON: %v", err)
+		}
+		return string(b), nil
+	case FormatTemplate:
+		if f.template == "" {
+			return "", fmt.Errorf("-template is required when -format=template")
+		}
+		tmpl, err := template.New("format").Parse(f.template)
+		if err != nil {
+			return "", fmt.Errorf("invalid -template: %v", err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return "", fmt.Errorf("failed to execute -template: %v", err)
+		}
+		return buf.String(), nil
+	default:
+		return "", fmt.Errorf("unsupported -format: %q, must be one of %s", f.format, strings.Join(SupportedFormats, "|"))
+	}
+}
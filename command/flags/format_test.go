@@ -0,0 +1,50 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatFlags_Format(t *testing.T) {
+	t.Parallel()
+
+	prettyFn := func() (string, error) { return "pretty output", nil }
+
+	t.Run("defaults to pretty", func(t *testing.T) {
+		f := &FormatFlags{}
+		out, err := f.Format(map[string]string{"foo": "bar"}, prettyFn)
+		require.NoError(t, err)
+		require.Equal(t, "pretty output", out)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		f := &FormatFlags{}
+		require.NoError(t, f.Flags().Parse([]string{"-format=json"}))
+		out, err := f.Format(map[string]string{"foo": "bar"}, prettyFn)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"foo":"bar"}`, out)
+	})
+
+	t.Run("template", func(t *testing.T) {
+		f := &FormatFlags{}
+		require.NoError(t, f.Flags().Parse([]string{"-format=template", "-template={{.foo}}"}))
+		out, err := f.Format(map[string]string{"foo": "bar"}, prettyFn)
+		require.NoError(t, err)
+		require.Equal(t, "bar", out)
+	})
+
+	t.Run("template requires -template", func(t *testing.T) {
+		f := &FormatFlags{}
+		require.NoError(t, f.Flags().Parse([]string{"-format=template"}))
+		_, err := f.Format(map[string]string{"foo": "bar"}, prettyFn)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		f := &FormatFlags{}
+		require.NoError(t, f.Flags().Parse([]string{"-format=yaml"}))
+		_, err := f.Format(map[string]string{"foo": "bar"}, prettyFn)
+		require.Error(t, err)
+	})
+}
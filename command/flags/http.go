@@ -2,12 +2,19 @@ package flags
 
 import (
 	"flag"
+	"fmt"
 	"io/ioutil"
+	"os"
 	"strings"
 
 	"github.com/hashicorp/consul/api"
 )
 
+// contextEnvName is the environment variable that selects a named
+// connection profile, as an alternative to the -context flag. See
+// ContextFilePath for where profiles are stored.
+const contextEnvName = "CONSUL_CONTEXT"
+
 type HTTPFlags struct {
 	// client api flags
 	address       StringValue
@@ -18,6 +25,7 @@ type HTTPFlags struct {
 	certFile      StringValue
 	keyFile       StringValue
 	tlsServerName StringValue
+	context       StringValue
 
 	// server flags
 	datacenter StringValue
@@ -58,6 +66,12 @@ func (f *HTTPFlags) ClientFlags() *flag.FlagSet {
 	fs.Var(&f.tlsServerName, "tls-server-name",
 		"The server name to use as the SNI host when connecting via TLS. This "+
 			"can also be specified via the CONSUL_TLS_SERVER_NAME environment variable.")
+	fs.Var(&f.context, "context",
+		"Name of a connection profile, created with `consul context set`, to use "+
+			"for the address, token, datacenter, and TLS settings of this command. "+
+			"This can also be specified via the CONSUL_CONTEXT environment variable. "+
+			"Explicit flags and their environment variables always take precedence "+
+			"over a profile's settings.")
 	return fs
 }
 
@@ -102,6 +116,23 @@ func (f *HTTPFlags) Token() string {
 	return f.token.String()
 }
 
+// Context returns the name of the connection profile to use: the -context
+// flag if given, otherwise the CONSUL_CONTEXT environment variable,
+// otherwise whichever profile was last selected with `consul context use`.
+func (f *HTTPFlags) Context() string {
+	if v := f.context.String(); v != "" {
+		return v
+	}
+	if v := os.Getenv(contextEnvName); v != "" {
+		return v
+	}
+	cf, err := LoadContextFile()
+	if err != nil {
+		return ""
+	}
+	return cf.Current
+}
+
 func (f *HTTPFlags) SetToken(v string) error {
 	return f.token.Set(v)
 }
@@ -131,11 +162,68 @@ func (f *HTTPFlags) ReadTokenFile() (string, error) {
 func (f *HTTPFlags) APIClient() (*api.Client, error) {
 	c := api.DefaultConfig()
 
+	if err := f.mergeContextOntoConfig(c); err != nil {
+		return nil, err
+	}
+
 	f.MergeOntoConfig(c)
 
 	return api.NewClient(c)
 }
 
+// mergeContextOntoConfig applies the named connection profile selected via
+// Context(), if any, onto c. It only fills in fields that api.DefaultConfig
+// left at their zero value, so that an environment variable such as
+// CONSUL_HTTP_TOKEN still wins over a profile; explicit flags are applied
+// afterwards by MergeOntoConfig and always take precedence over both.
+func (f *HTTPFlags) mergeContextOntoConfig(c *api.Config) error {
+	name := f.Context()
+	if name == "" {
+		return nil
+	}
+
+	cf, err := LoadContextFile()
+	if err != nil {
+		return err
+	}
+	ctx, ok := cf.Contexts[name]
+	if !ok {
+		return fmt.Errorf("no such context %q (create it with 'consul context set')", name)
+	}
+
+	if ctx.Address != "" && os.Getenv(api.HTTPAddrEnvName) == "" {
+		c.Address = ctx.Address
+	}
+	if ctx.Token != "" && c.Token == "" {
+		c.Token = ctx.Token
+	}
+	if ctx.TokenFile != "" && c.TokenFile == "" {
+		c.TokenFile = ctx.TokenFile
+	}
+	if ctx.Datacenter != "" && c.Datacenter == "" {
+		c.Datacenter = ctx.Datacenter
+	}
+	if ctx.Namespace != "" && c.Namespace == "" {
+		c.Namespace = ctx.Namespace
+	}
+	if ctx.CAFile != "" && c.TLSConfig.CAFile == "" {
+		c.TLSConfig.CAFile = ctx.CAFile
+	}
+	if ctx.CAPath != "" && c.TLSConfig.CAPath == "" {
+		c.TLSConfig.CAPath = ctx.CAPath
+	}
+	if ctx.CertFile != "" && c.TLSConfig.CertFile == "" {
+		c.TLSConfig.CertFile = ctx.CertFile
+	}
+	if ctx.KeyFile != "" && c.TLSConfig.KeyFile == "" {
+		c.TLSConfig.KeyFile = ctx.KeyFile
+	}
+	if ctx.TLSServerName != "" && c.TLSConfig.Address == "" {
+		c.TLSConfig.Address = ctx.TLSServerName
+	}
+	return nil
+}
+
 func (f *HTTPFlags) MergeOntoConfig(c *api.Config) {
 	f.address.Merge(&c.Address)
 	f.token.Merge(&c.Token)
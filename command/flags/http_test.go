@@ -1,8 +1,10 @@
 package flags
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/hashicorp/consul/api"
 	"github.com/stretchr/testify/require"
 )
 
@@ -13,3 +15,49 @@ func TestHTTPFlagsSetToken(t *testing.T) {
 	require.NoError(f.SetToken("foo"))
 	require.Equal("foo", f.Token())
 }
+
+func TestHTTPFlagsContext(t *testing.T) {
+	t.Setenv(contextFileEnvName, filepath.Join(t.TempDir(), "contexts.json"))
+
+	var f HTTPFlags
+	require.Empty(t, f.Context())
+
+	t.Setenv(contextEnvName, "from-env")
+	require.Equal(t, "from-env", f.Context())
+
+	require.NoError(t, f.context.Set("from-flag"))
+	require.Equal(t, "from-flag", f.Context(), "flag takes precedence over env var")
+}
+
+func TestHTTPFlagsMergeContextOntoConfig(t *testing.T) {
+	t.Setenv(contextFileEnvName, filepath.Join(t.TempDir(), "contexts.json"))
+
+	require.NoError(t, SaveContextFile(&ContextFile{
+		Contexts: map[string]Context{
+			"prod": {Address: "consul.example.com:8501", Token: "prod-token"},
+		},
+	}))
+
+	var f HTTPFlags
+	require.NoError(t, f.context.Set("prod"))
+
+	c := api.DefaultConfig()
+	require.NoError(t, f.mergeContextOntoConfig(c))
+	require.Equal(t, "consul.example.com:8501", c.Address)
+	require.Equal(t, "prod-token", c.Token)
+
+	// An explicit -token flag still wins over the profile.
+	require.NoError(t, f.SetToken("explicit-token"))
+	f.MergeOntoConfig(c)
+	require.Equal(t, "explicit-token", c.Token)
+}
+
+func TestHTTPFlagsMergeContextOntoConfig_UnknownContext(t *testing.T) {
+	t.Setenv(contextFileEnvName, filepath.Join(t.TempDir(), "contexts.json"))
+
+	var f HTTPFlags
+	require.NoError(t, f.context.Set("does-not-exist"))
+
+	err := f.mergeContextOntoConfig(api.DefaultConfig())
+	require.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/hashicorp/consul/api"
@@ -23,6 +24,7 @@ type cmd struct {
 	UI           cli.Ui
 	flags        *flag.FlagSet
 	http         *flags.HTTPFlags
+	format       *flags.FormatFlags
 	help         string
 	base64encode bool
 	detailed     bool
@@ -33,6 +35,8 @@ type cmd struct {
 
 func (c *cmd) init() {
 	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.format = &flags.FormatFlags{}
+	flags.Merge(c.flags, c.format.Flags())
 	c.flags.BoolVar(&c.base64encode, "base64", false,
 		"Base64 encode the value. The default value is false.")
 	c.flags.BoolVar(&c.detailed, "detailed", false,
@@ -108,9 +112,18 @@ func (c *cmd) Run(args []string) int {
 			return 1
 		}
 
-		for _, k := range keys {
-			c.UI.Info(k)
+		output, err := c.format.Format(keys, func() (string, error) {
+			var b strings.Builder
+			for _, k := range keys {
+				fmt.Fprintln(&b, k)
+			}
+			return strings.TrimSuffix(b.String(), "\n"), nil
+		})
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error formatting keys: %s", err))
+			return 1
 		}
+		c.UI.Info(output)
 
 		return 0
 	case c.recurse:
@@ -122,27 +135,33 @@ func (c *cmd) Run(args []string) int {
 			return 1
 		}
 
-		for i, pair := range pairs {
-			if c.detailed {
-				var b bytes.Buffer
-				if err := prettyKVPair(&b, pair, c.base64encode); err != nil {
-					c.UI.Error(fmt.Sprintf("Error rendering KV pair: %s", err))
-					return 1
-				}
-
-				c.UI.Info(b.String())
-
-				if i < len(pairs)-1 {
-					c.UI.Info("")
-				}
-			} else {
-				if c.base64encode {
-					c.UI.Info(fmt.Sprintf("%s:%s", pair.Key, base64.StdEncoding.EncodeToString(pair.Value)))
+		output, err := c.format.Format(pairs, func() (string, error) {
+			var b strings.Builder
+			for i, pair := range pairs {
+				if c.detailed {
+					if err := prettyKVPair(&b, pair, c.base64encode); err != nil {
+						return "", fmt.Errorf("error rendering KV pair: %s", err)
+					}
+					fmt.Fprintln(&b)
+
+					if i < len(pairs)-1 {
+						fmt.Fprintln(&b)
+					}
 				} else {
-					c.UI.Info(fmt.Sprintf("%s:%s", pair.Key, pair.Value))
+					if c.base64encode {
+						fmt.Fprintf(&b, "%s:%s\n", pair.Key, base64.StdEncoding.EncodeToString(pair.Value))
+					} else {
+						fmt.Fprintf(&b, "%s:%s\n", pair.Key, pair.Value)
+					}
 				}
 			}
+			return strings.TrimSuffix(b.String(), "\n"), nil
+		})
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error formatting KV pairs: %s", err))
+			return 1
 		}
+		c.UI.Info(output)
 
 		return 0
 	default:
@@ -159,22 +178,25 @@ func (c *cmd) Run(args []string) int {
 			return 1
 		}
 
-		if c.detailed {
-			var b bytes.Buffer
-			if err := prettyKVPair(&b, pair, c.base64encode); err != nil {
-				c.UI.Error(fmt.Sprintf("Error rendering KV pair: %s", err))
-				return 1
+		output, err := c.format.Format(pair, func() (string, error) {
+			if c.detailed {
+				var b bytes.Buffer
+				if err := prettyKVPair(&b, pair, c.base64encode); err != nil {
+					return "", fmt.Errorf("error rendering KV pair: %s", err)
+				}
+				return b.String(), nil
 			}
 
-			c.UI.Info(b.String())
-			return 0
-		}
-
-		if c.base64encode {
-			c.UI.Info(base64.StdEncoding.EncodeToString(pair.Value))
-		} else {
-			c.UI.Info(string(pair.Value))
+			if c.base64encode {
+				return base64.StdEncoding.EncodeToString(pair.Value), nil
+			}
+			return string(pair.Value), nil
+		})
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error formatting KV pair: %s", err))
+			return 1
 		}
+		c.UI.Info(output)
 		return 0
 	}
 }
@@ -0,0 +1,159 @@
+package members
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/serf/serf"
+	"github.com/ryanuber/columnize"
+)
+
+const (
+	PrettyFormat   string = "pretty"
+	JSONFormat     string = "json"
+	TemplateFormat string = "template"
+)
+
+// Formatter defines methods provided by the members command output formatter
+type Formatter interface {
+	FormatMembers(members []*api.AgentMember) (string, error)
+}
+
+// GetSupportedFormats returns supported formats
+func GetSupportedFormats() []string {
+	return []string{PrettyFormat, JSONFormat, TemplateFormat}
+}
+
+// NewFormatter returns a Formatter implementation. detailed controls how
+// much of each member's tags the pretty formatter prints; it has no effect
+// on the JSON or template formatters, which always have access to every
+// field. template is the Go template used by the template formatter, and is
+// ignored by the other formats.
+func NewFormatter(format string, detailed bool, tmpl string) (Formatter, error) {
+	switch format {
+	case PrettyFormat:
+		return &prettyFormatter{detailed}, nil
+	case JSONFormat:
+		return &jsonFormatter{}, nil
+	case TemplateFormat:
+		if tmpl == "" {
+			return nil, fmt.Errorf("-template is required when -format=%s", TemplateFormat)
+		}
+		t, err := template.New("members").Parse(tmpl)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid -template: %v", err)
+		}
+		return &templateFormatter{t}, nil
+	default:
+		return nil, fmt.Errorf("Unknown format: %s", format)
+	}
+}
+
+type jsonFormatter struct{}
+
+func (f *jsonFormatter) FormatMembers(members []*api.AgentMember) (string, error) {
+	b, err := json.MarshalIndent(members, "", "    ")
+	if err != nil {
+		return "", fmt.Errorf("Failed to marshal members: %v", err)
+	}
+	return string(b), nil
+}
+
+type templateFormatter struct {
+	tmpl *template.Template
+}
+
+func (f *templateFormatter) FormatMembers(members []*api.AgentMember) (string, error) {
+	var buf bytes.Buffer
+	if err := f.tmpl.Execute(&buf, members); err != nil {
+		return "", fmt.Errorf("Failed to execute -template: %v", err)
+	}
+	return buf.String(), nil
+}
+
+type prettyFormatter struct {
+	detailed bool
+}
+
+func (f *prettyFormatter) FormatMembers(members []*api.AgentMember) (string, error) {
+	var result []string
+	if f.detailed {
+		result = detailedOutput(members)
+	} else {
+		result = standardOutput(members)
+	}
+	return columnize.Format(result, &columnize.Config{Delim: string([]byte{0x1f})}), nil
+}
+
+// standardOutput is used to dump the most useful information about nodes
+// in a more human-friendly format
+func standardOutput(members []*api.AgentMember) []string {
+	result := make([]string, 0, len(members))
+	header := "Node\x1fAddress\x1fStatus\x1fType\x1fBuild\x1fProtocol\x1fDC\x1fSegment"
+	result = append(result, header)
+	for _, member := range members {
+		addr := net.TCPAddr{IP: net.ParseIP(member.Addr), Port: int(member.Port)}
+		protocol := member.Tags["vsn"]
+		build := member.Tags["build"]
+		if build == "" {
+			build = "< 0.3"
+		} else if idx := strings.Index(build, ":"); idx != -1 {
+			build = build[:idx]
+		}
+		dc := member.Tags["dc"]
+		segment := member.Tags["segment"]
+
+		statusString := serf.MemberStatus(member.Status).String()
+		switch member.Tags["role"] {
+		case "node":
+			line := fmt.Sprintf("%s\x1f%s\x1f%s\x1fclient\x1f%s\x1f%s\x1f%s\x1f%s",
+				member.Name, addr.String(), statusString, build, protocol, dc, segment)
+			result = append(result, line)
+		case "consul":
+			line := fmt.Sprintf("%s\x1f%s\x1f%s\x1fserver\x1f%s\x1f%s\x1f%s\x1f%s",
+				member.Name, addr.String(), statusString, build, protocol, dc, segment)
+			result = append(result, line)
+		default:
+			line := fmt.Sprintf("%s\x1f%s\x1f%s\x1funknown\x1f\x1f\x1f\x1f",
+				member.Name, addr.String(), statusString)
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+// detailedOutput is used to dump all known information about nodes in
+// their raw format
+func detailedOutput(members []*api.AgentMember) []string {
+	result := make([]string, 0, len(members))
+	header := "Node\x1fAddress\x1fStatus\x1fTags"
+	result = append(result, header)
+	for _, member := range members {
+		// Get the tags sorted by key
+		tagKeys := make([]string, 0, len(member.Tags))
+		for key := range member.Tags {
+			tagKeys = append(tagKeys, key)
+		}
+		sort.Strings(tagKeys)
+
+		// Format the tags as tag1=v1,tag2=v2,...
+		var tagPairs []string
+		for _, key := range tagKeys {
+			tagPairs = append(tagPairs, fmt.Sprintf("%s=%s", key, member.Tags[key]))
+		}
+
+		tags := strings.Join(tagPairs, ",")
+
+		addr := net.TCPAddr{IP: net.ParseIP(member.Addr), Port: int(member.Port)}
+		line := fmt.Sprintf("%s\x1f%s\x1f%s\x1f%s",
+			member.Name, addr.String(), serf.MemberStatus(member.Status).String(), tags)
+		result = append(result, line)
+	}
+	return result
+}
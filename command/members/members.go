@@ -3,7 +3,6 @@ package members
 import (
 	"flag"
 	"fmt"
-	"net"
 	"regexp"
 	"sort"
 	"strings"
@@ -12,7 +11,6 @@ import (
 	"github.com/hashicorp/consul/command/flags"
 	"github.com/hashicorp/serf/serf"
 	"github.com/mitchellh/cli"
-	"github.com/ryanuber/columnize"
 )
 
 // cmd is a Command implementation that queries a running
@@ -28,6 +26,9 @@ type cmd struct {
 	wan          bool
 	statusFilter string
 	segment      string
+	filter       string
+	format       string
+	template     string
 }
 
 func New(ui cli.Ui) *cmd {
@@ -49,6 +50,14 @@ func (c *cmd) init() {
 	c.flags.StringVar(&c.segment, "segment", consulapi.AllSegments,
 		"(Enterprise-only) If provided, output is filtered to only nodes in"+
 			"the given segment.")
+	c.flags.StringVar(&c.filter, "filter", "",
+		"Filter expression to use with the request to filter the members "+
+			"returned, evaluated against each member's Name, Addr, Port, Tags, "+
+			"Status, and protocol/delegate version fields.")
+	c.flags.StringVar(&c.format, "format", PrettyFormat,
+		fmt.Sprintf("Output format {%s}", strings.Join(GetSupportedFormats(), "|")))
+	c.flags.StringVar(&c.template, "template", "",
+		fmt.Sprintf("Go template applied to the result when -format=%s.", TemplateFormat))
 
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
@@ -67,6 +76,12 @@ func (c *cmd) Run(args []string) int {
 		return 1
 	}
 
+	formatter, err := NewFormatter(c.format, c.detailed, c.template)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
 	client, err := c.http.APIClient()
 	if err != nil {
 		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
@@ -77,6 +92,7 @@ func (c *cmd) Run(args []string) int {
 	opts := consulapi.MembersOpts{
 		Segment: c.segment,
 		WAN:     c.wan,
+		Filter:  c.filter,
 	}
 	members, err := client.Agent().MembersOpts(opts)
 	if err != nil {
@@ -111,16 +127,11 @@ func (c *cmd) Run(args []string) int {
 
 	sort.Sort(ByMemberNameAndSegment(members))
 
-	// Generate the output
-	var result []string
-	if c.detailed {
-		result = c.detailedOutput(members)
-	} else {
-		result = c.standardOutput(members)
+	output, err := formatter.FormatMembers(members)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error formatting members: %s", err))
+		return 1
 	}
-
-	// Generate the columnized version
-	output := columnize.Format(result, &columnize.Config{Delim: string([]byte{0x1f})})
 	c.UI.Output(output)
 
 	return 0
@@ -142,73 +153,6 @@ func (m ByMemberNameAndSegment) Less(i, j int) bool {
 	}
 }
 
-// standardOutput is used to dump the most useful information about nodes
-// in a more human-friendly format
-func (c *cmd) standardOutput(members []*consulapi.AgentMember) []string {
-	result := make([]string, 0, len(members))
-	header := "Node\x1fAddress\x1fStatus\x1fType\x1fBuild\x1fProtocol\x1fDC\x1fSegment"
-	result = append(result, header)
-	for _, member := range members {
-		addr := net.TCPAddr{IP: net.ParseIP(member.Addr), Port: int(member.Port)}
-		protocol := member.Tags["vsn"]
-		build := member.Tags["build"]
-		if build == "" {
-			build = "< 0.3"
-		} else if idx := strings.Index(build, ":"); idx != -1 {
-			build = build[:idx]
-		}
-		dc := member.Tags["dc"]
-		segment := member.Tags["segment"]
-
-		statusString := serf.MemberStatus(member.Status).String()
-		switch member.Tags["role"] {
-		case "node":
-			line := fmt.Sprintf("%s\x1f%s\x1f%s\x1fclient\x1f%s\x1f%s\x1f%s\x1f%s",
-				member.Name, addr.String(), statusString, build, protocol, dc, segment)
-			result = append(result, line)
-		case "consul":
-			line := fmt.Sprintf("%s\x1f%s\x1f%s\x1fserver\x1f%s\x1f%s\x1f%s\x1f%s",
-				member.Name, addr.String(), statusString, build, protocol, dc, segment)
-			result = append(result, line)
-		default:
-			line := fmt.Sprintf("%s\x1f%s\x1f%s\x1funknown\x1f\x1f\x1f\x1f",
-				member.Name, addr.String(), statusString)
-			result = append(result, line)
-		}
-	}
-	return result
-}
-
-// detailedOutput is used to dump all known information about nodes in
-// their raw format
-func (c *cmd) detailedOutput(members []*consulapi.AgentMember) []string {
-	result := make([]string, 0, len(members))
-	header := "Node\x1fAddress\x1fStatus\x1fTags"
-	result = append(result, header)
-	for _, member := range members {
-		// Get the tags sorted by key
-		tagKeys := make([]string, 0, len(member.Tags))
-		for key := range member.Tags {
-			tagKeys = append(tagKeys, key)
-		}
-		sort.Strings(tagKeys)
-
-		// Format the tags as tag1=v1,tag2=v2,...
-		var tagPairs []string
-		for _, key := range tagKeys {
-			tagPairs = append(tagPairs, fmt.Sprintf("%s=%s", key, member.Tags[key]))
-		}
-
-		tags := strings.Join(tagPairs, ",")
-
-		addr := net.TCPAddr{IP: net.ParseIP(member.Addr), Port: int(member.Port)}
-		line := fmt.Sprintf("%s\x1f%s\x1f%s\x1f%s",
-			member.Name, addr.String(), serf.MemberStatus(member.Status).String(), tags)
-		result = append(result, line)
-	}
-	return result
-}
-
 func (c *cmd) Synopsis() string {
 	return synopsis
 }
@@ -222,4 +166,13 @@ const help = `
 Usage: consul members [options]
 
   Outputs the members of a running Consul agent.
+
+  To show a JSON representation of the members, including memberlist
+  protocol versions and (for the local node) its memberlist health score:
+
+      $ consul members -format=json
+
+  To filter the members returned using a filter expression:
+
+      $ consul members -filter '"consul" in Tags.role'
 `
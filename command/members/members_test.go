@@ -1,11 +1,13 @@
 package members
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
 	"github.com/mitchellh/cli"
 )
 
@@ -121,6 +123,58 @@ func TestMembersCommand_statusFilter_failed(t *testing.T) {
 	}
 }
 
+func TestMembersCommand_JSONFormat(t *testing.T) {
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	c.flags.SetOutput(ui.ErrorWriter)
+
+	args := []string{
+		"-http-addr=" + a.HTTPAddr(),
+		"-format=json",
+	}
+
+	code := c.Run(args)
+	if code != 0 {
+		t.Fatalf("bad: %d. %#v", code, ui.ErrorWriter.String())
+	}
+
+	var members []*api.AgentMember
+	if err := json.Unmarshal([]byte(ui.OutputWriter.String()), &members); err != nil {
+		t.Fatalf("bad json: %s: %v", ui.OutputWriter.String(), err)
+	}
+	if len(members) != 1 || members[0].Name != a.Config.NodeName {
+		t.Fatalf("bad: %#v", members)
+	}
+}
+
+func TestMembersCommand_Filter(t *testing.T) {
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	c.flags.SetOutput(ui.ErrorWriter)
+
+	args := []string{
+		"-http-addr=" + a.HTTPAddr(),
+		"-filter=Name == \"not-a-real-node\"",
+	}
+
+	code := c.Run(args)
+	if code != 2 {
+		t.Fatalf("bad: %d. %#v", code, ui.ErrorWriter.String())
+	}
+
+	if strings.Contains(ui.OutputWriter.String(), a.Config.NodeName) {
+		t.Fatalf("bad: %#v", ui.OutputWriter.String())
+	}
+}
+
 func TestMembersCommand_verticalBar(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,75 @@
+package auditlog
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	entries, _, err := client.Operator().ConfigEntryAuditLog(nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error fetching config entry audit log: %s", err))
+		return 1
+	}
+
+	if len(entries) == 0 {
+		c.UI.Output("No config entry changes recorded.")
+		return 0
+	}
+
+	for _, entry := range entries {
+		c.UI.Output(fmt.Sprintf("%s: %s %s/%s by %s",
+			entry.Timestamp.Format("2006-01-02T15:04:05Z07:00"), entry.Op, entry.Kind, entry.Name, entry.Author))
+	}
+
+	return 0
+}
+
+func (c *cmd) Synopsis() string { return synopsis }
+func (c *cmd) Help() string     { return c.help }
+
+const synopsis = "Displays the config entry and intention change audit log"
+const help = `
+Usage: consul operator audit-log [options]
+
+  Displays the bounded log of config entry and intention changes, recording
+  who (ACL accessor ID) changed what and when.
+`
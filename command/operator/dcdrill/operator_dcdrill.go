@@ -0,0 +1,119 @@
+package dcdrill
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	// flags
+	dc       string
+	duration time.Duration
+	stop     bool
+	list     bool
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.dc, "target-dc", "",
+		"The remote datacenter to simulate as unreachable.")
+	c.flags.DurationVar(&c.duration, "duration", 5*time.Minute,
+		"How long the drill should last before it automatically ends.")
+	c.flags.BoolVar(&c.stop, "stop", false,
+		"End an in-progress drill against -target-dc early.")
+	c.flags.BoolVar(&c.list, "list", false,
+		"List the datacenters that currently have an active drill against them.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	if c.list {
+		drills, _, err := client.Operator().DatacenterDrills(nil)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error listing datacenter drills: %s", err))
+			return 1
+		}
+		if len(drills) == 0 {
+			c.UI.Output("No active datacenter drills.")
+			return 0
+		}
+		for _, drill := range drills {
+			c.UI.Output(fmt.Sprintf("%s: expires at %s",
+				drill.TargetDatacenter, drill.ExpiresAt.Format("2006-01-02T15:04:05Z07:00")))
+		}
+		return 0
+	}
+
+	if c.dc == "" {
+		c.UI.Error("-target-dc is required")
+		return 1
+	}
+
+	if c.stop {
+		if err := client.Operator().DatacenterDrillStop(c.dc, nil); err != nil {
+			c.UI.Error(fmt.Sprintf("Error stopping datacenter drill: %s", err))
+			return 1
+		}
+		c.UI.Output(fmt.Sprintf("Stopped datacenter drill against %q.", c.dc))
+		return 0
+	}
+
+	if err := client.Operator().DatacenterDrillStart(c.dc, c.duration, nil); err != nil {
+		c.UI.Error(fmt.Sprintf("Error starting datacenter drill: %s", err))
+		return 1
+	}
+	c.UI.Output(fmt.Sprintf("Started datacenter drill against %q for %s.", c.dc, c.duration))
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Starts or stops a simulated datacenter failover drill"
+const help = `
+Usage: consul operator dc-drill [options]
+
+  Starts or stops an operator-triggered drill that makes the servers treat
+  a selected remote datacenter as unreachable for prepared query failover
+  and mesh gateway routing decisions, without touching real networking, so
+  that DC failover can be rehearsed safely. Pass -list to see which
+  datacenters currently have an active drill against them.
+`
@@ -0,0 +1,95 @@
+package fsck
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	// flags
+	repair bool
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.BoolVar(&c.repair, "repair", false,
+		"Repair any invariant violations that can be corrected automatically, "+
+			"rather than only reporting them.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	results, err := client.Operator().FSCK(c.repair, nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error running fsck: %s", err))
+		return 1
+	}
+
+	if len(results) == 0 {
+		c.UI.Output("No invariant violations found.")
+		return 0
+	}
+
+	for _, result := range results {
+		status := "not repaired"
+		if result.Repaired {
+			status = "repaired"
+		} else if !result.Repairable {
+			status = "not repairable, manual intervention required"
+		}
+		c.UI.Output(fmt.Sprintf("%s: %s references missing %s (%s)",
+			result.Category, result.Resource, result.Reference, status))
+	}
+
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Checks the cluster state for dangling references"
+const help = `
+Usage: consul operator fsck [options]
+
+  Scans the catalog, sessions, ACL tokens, and config entries for dangling
+  references, such as a service instance registered against a node that no
+  longer exists. By default violations are only reported; pass -repair to
+  correct the subset of violations that can be fixed automatically.
+`
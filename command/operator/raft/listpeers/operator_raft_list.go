@@ -17,14 +17,17 @@ func New(ui cli.Ui) *cmd {
 }
 
 type cmd struct {
-	UI    cli.Ui
-	flags *flag.FlagSet
-	http  *flags.HTTPFlags
-	help  string
+	UI     cli.Ui
+	flags  *flag.FlagSet
+	http   *flags.HTTPFlags
+	format *flags.FormatFlags
+	help   string
 }
 
 func (c *cmd) init() {
 	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.format = &flags.FormatFlags{}
+	flags.Merge(c.flags, c.format.Flags())
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
 	flags.Merge(c.flags, c.http.ServerFlags())
@@ -48,28 +51,31 @@ func (c *cmd) Run(args []string) int {
 	}
 
 	// Fetch the current configuration.
-	result, err := raftListPeers(client, c.http.Stale())
+	q := &api.QueryOptions{
+		AllowStale: c.http.Stale(),
+	}
+	reply, err := client.Operator().RaftGetConfiguration(q)
 	if err != nil {
 		c.UI.Error(fmt.Sprintf("Error getting peers: %v", err))
 		return 1
 	}
 
-	c.UI.Output(result)
-	return 0
-}
-
-func raftListPeers(client *api.Client, stale bool) (string, error) {
-	q := &api.QueryOptions{
-		AllowStale: stale,
-	}
-	reply, err := client.Operator().RaftGetConfiguration(q)
+	output, err := c.format.Format(reply.Servers, func() (string, error) {
+		return formatRaftServers(reply.Servers), nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("Failed to retrieve raft configuration: %v", err)
+		c.UI.Error(fmt.Sprintf("Error formatting peers: %v", err))
+		return 1
 	}
 
-	// Format it as a nice table.
+	c.UI.Output(output)
+	return 0
+}
+
+// formatRaftServers formats the given Raft servers as a nice table.
+func formatRaftServers(servers []*api.RaftServer) string {
 	result := []string{"Node\x1fID\x1fAddress\x1fState\x1fVoter\x1fRaftProtocol"}
-	for _, s := range reply.Servers {
+	for _, s := range servers {
 		raftProtocol := s.ProtocolVersion
 
 		if raftProtocol == "" {
@@ -83,7 +89,7 @@ func raftListPeers(client *api.Client, stale bool) (string, error) {
 			s.Node, s.ID, s.Address, state, s.Voter, raftProtocol))
 	}
 
-	return columnize.Format(result, &columnize.Config{Delim: string([]byte{0x1f})}), nil
+	return columnize.Format(result, &columnize.Config{Delim: string([]byte{0x1f})})
 }
 
 func (c *cmd) Synopsis() string {
@@ -0,0 +1,139 @@
+package export
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+	"github.com/ryanuber/columnize"
+)
+
+const (
+	PrettyFormat string = "pretty"
+	JSONFormat   string = "json"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	format string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.format, "format", JSONFormat,
+		fmt.Sprintf("Output format {%s}", strings.Join([]string{PrettyFormat, JSONFormat}, "|")))
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.NamespaceFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+	if l := len(c.flags.Args()); l > 0 {
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 0, got %d)", l))
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	names, _, err := client.Catalog().Services(nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error listing services: %s", err))
+		return 1
+	}
+
+	export := make(map[string][]*api.CatalogService, len(names))
+	for name := range names {
+		instances, _, err := client.Catalog().Service(name, "", nil)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error exporting service %q: %s", name, err))
+			return 1
+		}
+		export[name] = instances
+	}
+
+	switch c.format {
+	case PrettyFormat:
+		c.UI.Output(formatPretty(export))
+	default:
+		b, err := json.MarshalIndent(export, "", "    ")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error marshaling services: %s", err))
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+
+	return 0
+}
+
+func formatPretty(export map[string][]*api.CatalogService) string {
+	names := make([]string, 0, len(export))
+	for name := range export {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]string, 0, len(names)+1)
+	result = append(result, "Service\x1fInstances\x1fNodes")
+	for _, name := range names {
+		instances := export[name]
+		nodes := make([]string, 0, len(instances))
+		for _, inst := range instances {
+			nodes = append(nodes, inst.Node)
+		}
+		sort.Strings(nodes)
+		result = append(result, fmt.Sprintf("%s\x1f%d\x1f%s", name, len(instances), strings.Join(nodes, ",")))
+	}
+	return columnize.Format(result, &columnize.Config{Delim: string([]byte{0x1f})})
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const synopsis = "Exports every service and its instances from the catalog"
+const help = `
+Usage: consul services export [options]
+
+  Retrieves every service registered in the catalog along with its
+  instances, as a single document, instead of combining
+  'consul catalog services' with a 'consul catalog nodes -service' call per
+  service name.
+
+  To export the catalog as JSON, suitable for piping into another tool:
+
+      $ consul services export
+
+  To print a human-readable summary instead:
+
+      $ consul services export -format=pretty
+`
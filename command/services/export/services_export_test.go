@@ -0,0 +1,38 @@
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestCommand(t *testing.T) {
+	t.Parallel()
+
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	require.NoError(t, client.Agent().ServiceRegister(&api.AgentServiceRegistration{
+		Name: "web",
+		Port: 8080,
+	}))
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	require.Equal(t, 0, c.Run([]string{"-http-addr=" + a.HTTPAddr()}), ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), `"web"`)
+}
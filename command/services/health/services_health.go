@@ -0,0 +1,138 @@
+package health
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+const (
+	PrettyFormat string = "pretty"
+	JSONFormat   string = "json"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	id     string
+	format string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.id, "id", "", "Look up a single service instance by "+
+		"ID instead of aggregating every instance of the named service.")
+	c.flags.StringVar(&c.format, "format", PrettyFormat,
+		fmt.Sprintf("Output format {%s}", strings.Join([]string{PrettyFormat, JSONFormat}, "|")))
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.NamespaceFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	cmdArgs := c.flags.Args()
+	if c.id == "" && len(cmdArgs) != 1 {
+		c.UI.Error("Must specify either a service name or -id <service-id>")
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	var (
+		status string
+		info   interface{}
+	)
+	if c.id != "" {
+		var checksInfo *api.AgentServiceChecksInfo
+		status, checksInfo, err = client.Agent().AgentHealthServiceByID(c.id)
+		info = checksInfo
+	} else {
+		var checksInfo []api.AgentServiceChecksInfo
+		status, checksInfo, err = client.Agent().AgentHealthServiceByName(cmdArgs[0])
+		info = checksInfo
+	}
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error getting service health: %s", err))
+		return 1
+	}
+
+	switch c.format {
+	case JSONFormat:
+		b, err := json.MarshalIndent(map[string]interface{}{
+			"AggregatedStatus": status,
+			"Checks":           info,
+		}, "", "    ")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error marshaling service health: %s", err))
+			return 1
+		}
+		c.UI.Output(string(b))
+	default:
+		c.UI.Output(fmt.Sprintf("Status: %s", status))
+	}
+
+	switch status {
+	case api.HealthPassing:
+		return 0
+	case api.HealthWarning:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const synopsis = "Shows the aggregated health of a locally registered service"
+const help = `
+Usage: consul services health [options] <service-name>
+       consul services health [options] -id <service-id>
+
+  Shows the aggregated health status of a service registered with the local
+  agent, along with the status of each of its checks. The exit code
+  reflects the aggregated status: 0 for passing, 1 for warning, 2 for
+  critical (or not found).
+
+  To check the aggregated health of every instance of a service by name:
+
+      $ consul services health web
+
+  To check the health of a single service instance by ID:
+
+      $ consul services health -id web-1
+
+  To get the result as JSON:
+
+      $ consul services health -format=json web
+`
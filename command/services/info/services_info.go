@@ -0,0 +1,117 @@
+package info
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+const (
+	PrettyFormat string = "pretty"
+	JSONFormat   string = "json"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	format string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.format, "format", PrettyFormat,
+		fmt.Sprintf("Output format {%s}", strings.Join([]string{PrettyFormat, JSONFormat}, "|")))
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.NamespaceFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	cmdArgs := c.flags.Args()
+	if len(cmdArgs) != 1 {
+		c.UI.Error("Must specify exactly one service ID")
+		return 1
+	}
+	id := cmdArgs[0]
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	svc, _, err := client.Agent().Service(id, nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error getting service %q: %s", id, err))
+		return 1
+	}
+
+	switch c.format {
+	case JSONFormat:
+		b, err := json.MarshalIndent(svc, "", "    ")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error marshaling service: %s", err))
+			return 1
+		}
+		c.UI.Output(string(b))
+	default:
+		c.UI.Output(fmt.Sprintf("ID:      %s", svc.ID))
+		c.UI.Output(fmt.Sprintf("Service: %s", svc.Service))
+		c.UI.Output(fmt.Sprintf("Tags:    %s", strings.Join(svc.Tags, ",")))
+		c.UI.Output(fmt.Sprintf("Address: %s", svc.Address))
+		c.UI.Output(fmt.Sprintf("Port:    %d", svc.Port))
+		if len(svc.Meta) > 0 {
+			c.UI.Output("Meta:")
+			for k, v := range svc.Meta {
+				c.UI.Output(fmt.Sprintf("  %s=%s", k, v))
+			}
+		}
+	}
+
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const synopsis = "Shows the locally registered service matching the given ID"
+const help = `
+Usage: consul services info [options] <service-id>
+
+  Shows the full definition of a single service registered with the local
+  agent, as an alternative to hand-crafting a GET against
+  /v1/agent/service/<service-id>.
+
+  To show a service:
+
+      $ consul services info web
+
+  To get the result as JSON:
+
+      $ consul services info -format=json web
+`
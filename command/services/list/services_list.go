@@ -0,0 +1,131 @@
+package list
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+	"github.com/ryanuber/columnize"
+)
+
+const (
+	PrettyFormat string = "pretty"
+	JSONFormat   string = "json"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	filter string
+	format string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.filter, "filter", "",
+		"Filter expression to use with the request to filter the services "+
+			"returned, evaluated against each service's ID, Service, Tags, "+
+			"Meta, and Port fields.")
+	c.flags.StringVar(&c.format, "format", PrettyFormat,
+		fmt.Sprintf("Output format {%s}", strings.Join([]string{PrettyFormat, JSONFormat}, "|")))
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.NamespaceFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+	if l := len(c.flags.Args()); l > 0 {
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 0, got %d)", l))
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	services, err := client.Agent().ServicesWithFilter(c.filter)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error listing services: %s", err))
+		return 1
+	}
+
+	switch c.format {
+	case JSONFormat:
+		b, err := json.MarshalIndent(services, "", "    ")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error marshaling services: %s", err))
+			return 1
+		}
+		c.UI.Output(string(b))
+	default:
+		c.UI.Output(formatPretty(services))
+	}
+
+	return 0
+}
+
+func formatPretty(services map[string]*api.AgentService) string {
+	ids := make([]string, 0, len(services))
+	for id := range services {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	result := make([]string, 0, len(ids)+1)
+	result = append(result, "ID\x1fService\x1fTags\x1fPort")
+	for _, id := range ids {
+		svc := services[id]
+		tags := strings.Join(svc.Tags, ",")
+		result = append(result, fmt.Sprintf("%s\x1f%s\x1f%s\x1f%d", svc.ID, svc.Service, tags, svc.Port))
+	}
+	return columnize.Format(result, &columnize.Config{Delim: string([]byte{0x1f})})
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const synopsis = "Lists the services registered with the local agent"
+const help = `
+Usage: consul services list [options]
+
+  Retrieves the services registered with the local agent.
+
+  To list all services:
+
+      $ consul services list
+
+  To filter the results:
+
+      $ consul services list -filter "Service == web"
+
+  To get the result as JSON:
+
+      $ consul services list -format=json
+`
@@ -0,0 +1,43 @@
+package list
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestCommand(t *testing.T) {
+	t.Parallel()
+
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+
+	require.NoError(t, client.Agent().ServiceRegister(&api.AgentServiceRegistration{
+		Name: "web",
+		Port: 8080,
+		Tags: []string{"primary"},
+	}))
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+
+	require.Equal(t, 0, c.Run([]string{"-http-addr=" + a.HTTPAddr()}), ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), "web")
+
+	ui = cli.NewMockUi()
+	c = New(ui)
+	require.Equal(t, 0, c.Run([]string{"-http-addr=" + a.HTTPAddr(), "-format=json"}), ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), `"Service": "web"`)
+}
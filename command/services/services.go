@@ -31,5 +31,21 @@ Usage: consul services <subcommand> [options] [args]
   default to working with services registered with the local agent. Please see
   the "consul catalog" command for interacting with the entire catalog.
 
+  Register or deregister a service with the local agent:
+
+      $ consul services register service.hcl
+      $ consul services deregister service.hcl
+
+  List, inspect, or check the health of services registered with the local
+  agent:
+
+      $ consul services list
+      $ consul services info web
+      $ consul services health web
+
+  Export every service in the catalog as JSON:
+
+      $ consul services export
+
   For more examples, ask for subcommand help or view the documentation.
 `
@@ -0,0 +1,67 @@
+package subscribe
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/mitchellh/cli"
+)
+
+func TestSubscribeCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi(), nil).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestSubscribeCommand_MissingTopic(t *testing.T) {
+	t.Parallel()
+	ui := cli.NewMockUi()
+	c := New(ui, make(chan struct{}))
+	c.flags.SetOutput(ui.ErrorWriter)
+
+	code := c.Run([]string{"-http-addr=foo"})
+	if code != 1 {
+		t.Fatalf("bad: %d. %#v", code, ui.ErrorWriter.String())
+	}
+}
+
+func TestSubscribeCommand_exitsOnSignalBeforeEventsArrive(t *testing.T) {
+	t.Parallel()
+	a := agent.StartTestAgent(t, agent.TestAgent{HCL: `rpc { enable_streaming = true }`})
+	defer a.Shutdown()
+
+	shutdownCh := make(chan struct{})
+
+	ui := cli.NewMockUi()
+	c := New(ui, shutdownCh)
+	args := []string{"-http-addr=" + a.HTTPAddr(), "-topic=service-health", "-key=does-not-exist"}
+
+	exitCode := make(chan int, 1)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		wg.Done()
+		exitCode <- c.Run(args)
+	}()
+
+	wg.Wait()
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		shutdownCh <- struct{}{}
+	}()
+
+	select {
+	case ret := <-exitCode:
+		if ret != 0 {
+			t.Fatalf("command returned with non-zero code: %d. %#v", ret, ui.ErrorWriter.String())
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for exit")
+	}
+}
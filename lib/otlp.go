@@ -0,0 +1,315 @@
+package lib
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+)
+
+// otlpDefaultFlushInterval is how often accumulated metrics are pushed to
+// the configured OTLP endpoint.
+const otlpDefaultFlushInterval = 10 * time.Second
+
+// otlpSink is a metrics.MetricSink that accumulates gauges and counters in
+// memory and periodically pushes them to an OTLP/HTTP metrics receiver
+// (such as an OpenTelemetry Collector) using the OTLP JSON wire format.
+//
+// Unlike the statsd/dogstatsd sinks, which forward every event to a server
+// that does its own aggregation, OTLP export is self-contained: otlpSink
+// keeps the latest value reported for each gauge and sample, and a running
+// total for each counter, and reports that snapshot on every flush.
+type otlpSink struct {
+	endpoint   string
+	insecure   bool
+	resource   []otlpKeyValue
+	httpClient *http.Client
+
+	mu       sync.Mutex
+	gauges   map[string]*otlpPoint
+	counters map[string]*otlpPoint
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+type otlpPoint struct {
+	labels []metrics.Label
+	value  float64
+}
+
+// newOTLPSink creates an otlpSink that pushes to cfg.OTLPEndpoint and starts
+// its background flush loop.
+func newOTLPSink(cfg TelemetryConfig) (*otlpSink, error) {
+	s := &otlpSink{
+		endpoint:   cfg.OTLPEndpoint,
+		insecure:   cfg.OTLPInsecure,
+		resource:   otlpParseResourceAttributes(cfg.OTLPResourceAttributes),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		gauges:     make(map[string]*otlpPoint),
+		counters:   make(map[string]*otlpPoint),
+		stopCh:     make(chan struct{}),
+	}
+	go s.flushLoop(otlpDefaultFlushInterval)
+	return s, nil
+}
+
+func otlpParseResourceAttributes(attrs []string) []otlpKeyValue {
+	kvs := make([]otlpKeyValue, 0, len(attrs))
+	for _, attr := range attrs {
+		k, v, ok := strings.Cut(attr, "=")
+		if !ok {
+			continue
+		}
+		kvs = append(kvs, otlpStringAttr(k, v))
+	}
+	return kvs
+}
+
+// Shutdown stops the background flush loop. It does not flush any
+// remaining buffered metrics.
+func (s *otlpSink) Shutdown() {
+	s.stopOnce.Do(func() { close(s.stopCh) })
+}
+
+func (s *otlpSink) SetGauge(key []string, val float32) {
+	s.SetGaugeWithLabels(key, val, nil)
+}
+
+func (s *otlpSink) SetGaugeWithLabels(key []string, val float32, labels []metrics.Label) {
+	s.store(s.gauges, key, val, labels)
+}
+
+func (s *otlpSink) EmitKey(key []string, val float32) {
+	// EmitKey is treated the same as a gauge: the most recent value wins.
+	s.store(s.gauges, key, val, nil)
+}
+
+func (s *otlpSink) IncrCounter(key []string, val float32) {
+	s.IncrCounterWithLabels(key, val, nil)
+}
+
+func (s *otlpSink) IncrCounterWithLabels(key []string, val float32, labels []metrics.Label) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	flatKey := otlpFlattenKey(key, labels)
+	if p, ok := s.counters[flatKey]; ok {
+		p.value += float64(val)
+		return
+	}
+	s.counters[flatKey] = &otlpPoint{labels: labels, value: float64(val)}
+}
+
+func (s *otlpSink) AddSample(key []string, val float32) {
+	s.AddSampleWithLabels(key, val, nil)
+}
+
+func (s *otlpSink) AddSampleWithLabels(key []string, val float32, labels []metrics.Label) {
+	// Samples (timers) are reported as gauges of the most recent observation.
+	// otlpSink does not compute quantiles; do that in the OTel Collector or
+	// downstream backend if needed.
+	s.store(s.gauges, key, val, labels)
+}
+
+func (s *otlpSink) store(points map[string]*otlpPoint, key []string, val float32, labels []metrics.Label) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	flatKey := otlpFlattenKey(key, labels)
+	points[flatKey] = &otlpPoint{labels: labels, value: float64(val)}
+}
+
+func otlpFlattenKey(key []string, labels []metrics.Label) string {
+	var b strings.Builder
+	b.WriteString(strings.Join(key, "."))
+	for _, l := range labels {
+		b.WriteString(",")
+		b.WriteString(l.Name)
+		b.WriteString("=")
+		b.WriteString(l.Value)
+	}
+	return b.String()
+}
+
+func (s *otlpSink) flushLoop(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if err := s.flush(); err != nil {
+				// There's no logger threaded through MetricSink, so the
+				// best we can do is drop the batch; the next flush will
+				// retry with fresh data.
+				continue
+			}
+		case <-s.stopCh:
+			return
+		}
+	}
+}
+
+func (s *otlpSink) flush() error {
+	s.mu.Lock()
+	metricsList := make([]otlpMetric, 0, len(s.gauges)+len(s.counters))
+	now := time.Now()
+	for name, p := range s.gauges {
+		metricsList = append(metricsList, otlpGaugeMetric(otlpMetricName(name), p, now))
+	}
+	for name, p := range s.counters {
+		metricsList = append(metricsList, otlpSumMetric(otlpMetricName(name), p, now))
+	}
+	s.mu.Unlock()
+
+	if len(metricsList) == 0 {
+		return nil
+	}
+
+	req := otlpExportRequest{
+		ResourceMetrics: []otlpResourceMetrics{
+			{
+				Resource: otlpResource{Attributes: s.resource},
+				ScopeMetrics: []otlpScopeMetrics{
+					{
+						Scope:   otlpScope{Name: "github.com/hashicorp/consul"},
+						Metrics: metricsList,
+					},
+				},
+			},
+		},
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	scheme := "https"
+	if s.insecure {
+		scheme = "http"
+	}
+	url := fmt.Sprintf("%s://%s/v1/metrics", scheme, s.endpoint)
+
+	resp, err := s.httpClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("otlp export failed: %s", resp.Status)
+	}
+	return nil
+}
+
+// otlpMetricName strips the flattened label suffix (everything from the
+// first comma on) back off a key produced by otlpFlattenKey, since labels
+// are exported as attributes rather than folded into the metric name.
+func otlpMetricName(flatKey string) string {
+	if i := strings.IndexByte(flatKey, ','); i >= 0 {
+		return flatKey[:i]
+	}
+	return flatKey
+}
+
+func otlpGaugeMetric(name string, p *otlpPoint, t time.Time) otlpMetric {
+	return otlpMetric{
+		Name: name,
+		Gauge: &otlpGauge{
+			DataPoints: []otlpNumberDataPoint{otlpDataPoint(p, t)},
+		},
+	}
+}
+
+func otlpSumMetric(name string, p *otlpPoint, t time.Time) otlpMetric {
+	return otlpMetric{
+		Name: name,
+		Sum: &otlpSum{
+			DataPoints:             []otlpNumberDataPoint{otlpDataPoint(p, t)},
+			AggregationTemporality: otlpAggregationTemporalityCumulative,
+			IsMonotonic:            true,
+		},
+	}
+}
+
+func otlpDataPoint(p *otlpPoint, t time.Time) otlpNumberDataPoint {
+	attrs := make([]otlpKeyValue, 0, len(p.labels))
+	for _, l := range p.labels {
+		attrs = append(attrs, otlpStringAttr(l.Name, l.Value))
+	}
+	return otlpNumberDataPoint{
+		Attributes:   attrs,
+		TimeUnixNano: strconv.FormatInt(t.UnixNano(), 10),
+		AsDouble:     p.value,
+	}
+}
+
+func otlpStringAttr(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: value}}
+}
+
+// The following types implement just enough of the OTLP/HTTP JSON wire
+// format (see https://github.com/open-telemetry/opentelemetry-proto) to
+// export gauges and cumulative counters. timeUnixNano is a fixed64 field,
+// which the OTLP JSON mapping encodes as a decimal string.
+
+// otlpAggregationTemporalityCumulative is
+// AGGREGATION_TEMPORALITY_CUMULATIVE from opentelemetry/proto/metrics/v1.
+const otlpAggregationTemporalityCumulative = 2
+
+type otlpExportRequest struct {
+	ResourceMetrics []otlpResourceMetrics `json:"resourceMetrics"`
+}
+
+type otlpResourceMetrics struct {
+	Resource     otlpResource       `json:"resource"`
+	ScopeMetrics []otlpScopeMetrics `json:"scopeMetrics"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeMetrics struct {
+	Scope   otlpScope    `json:"scope"`
+	Metrics []otlpMetric `json:"metrics"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpMetric struct {
+	Name  string     `json:"name"`
+	Gauge *otlpGauge `json:"gauge,omitempty"`
+	Sum   *otlpSum   `json:"sum,omitempty"`
+}
+
+type otlpGauge struct {
+	DataPoints []otlpNumberDataPoint `json:"dataPoints"`
+}
+
+type otlpSum struct {
+	DataPoints             []otlpNumberDataPoint `json:"dataPoints"`
+	AggregationTemporality int                   `json:"aggregationTemporality"`
+	IsMonotonic            bool                  `json:"isMonotonic"`
+}
+
+type otlpNumberDataPoint struct {
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+	TimeUnixNano string         `json:"timeUnixNano"`
+	AsDouble     float64        `json:"asDouble"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue,omitempty"`
+}
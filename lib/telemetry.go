@@ -199,6 +199,66 @@ type TelemetryConfig struct {
 	//
 	// hcl: telemetry { statsite_address = string }
 	StatsiteAddr string `json:"statsite_address,omitempty" mapstructure:"statsite_address"`
+
+	// EnableCheckMetrics opts in to emitting per-check and per-service
+	// metrics for check latency, state transitions, and consecutive
+	// failures. This is disabled by default since the check/service name
+	// labels are unbounded cardinality on agents with many checks;
+	// CheckMetricsMaxServices caps how many distinct services are labeled.
+	//
+	// hcl: telemetry { enable_check_metrics = (true|false) }
+	EnableCheckMetrics bool `json:"enable_check_metrics,omitempty" mapstructure:"enable_check_metrics"`
+
+	// CheckMetricsMaxServices caps the number of distinct service names that
+	// will be used as metric labels when EnableCheckMetrics is set, to bound
+	// metric cardinality. Checks for services beyond this cap are labeled
+	// with a shared "other" service name instead of their own.
+	// Default: 512
+	//
+	// hcl: telemetry { check_metrics_max_services = int }
+	CheckMetricsMaxServices int `json:"check_metrics_max_services,omitempty" mapstructure:"check_metrics_max_services"`
+
+	// EnableIntentionMetrics opts in to emitting counters for intention
+	// allow/deny decisions, labeled by source and destination service, from
+	// the agent's Connect authorize endpoint and from Envoy xDS RBAC config
+	// generation. This is disabled by default since the source/destination
+	// labels are unbounded cardinality in a large mesh;
+	// IntentionMetricsMaxElements caps how many distinct label pairs are
+	// tracked.
+	//
+	// hcl: telemetry { enable_intention_metrics = (true|false) }
+	EnableIntentionMetrics bool `json:"enable_intention_metrics,omitempty" mapstructure:"enable_intention_metrics"`
+
+	// IntentionMetricsMaxElements caps the number of distinct
+	// source/destination label pairs that will be used as metric labels
+	// when EnableIntentionMetrics is set, to bound metric cardinality.
+	// Decisions beyond this cap are labeled with a shared "other" pair
+	// instead of their own source and destination.
+	// Default: 512
+	//
+	// hcl: telemetry { intention_metrics_max_elements = int }
+	IntentionMetricsMaxElements int `json:"intention_metrics_max_elements,omitempty" mapstructure:"intention_metrics_max_elements"`
+
+	// OTLPEndpoint is the host:port of an OTLP/HTTP metrics receiver, such as
+	// an OpenTelemetry Collector. If provided, metrics are pushed there on
+	// OTLPFlushInterval using the OTLP JSON wire format, in addition to any
+	// other configured sinks.
+	//
+	// hcl: telemetry { otlp_endpoint = string }
+	OTLPEndpoint string `json:"otlp_endpoint,omitempty" mapstructure:"otlp_endpoint"`
+
+	// OTLPInsecure disables TLS when connecting to OTLPEndpoint.
+	//
+	// hcl: telemetry { otlp_insecure = (true|false) }
+	OTLPInsecure bool `json:"otlp_insecure,omitempty" mapstructure:"otlp_insecure"`
+
+	// OTLPResourceAttributes are additional "key=value" resource attributes
+	// attached to every metric pushed to OTLPEndpoint, alongside the
+	// service.name, node, dc, and server role attributes Consul always
+	// includes.
+	//
+	// hcl: telemetry { otlp_resource_attributes = []string{"key=value", ...} }
+	OTLPResourceAttributes []string `json:"otlp_resource_attributes,omitempty" mapstructure:"otlp_resource_attributes"`
 }
 
 // MergeDefaults copies any non-zero field from defaults into the current
@@ -290,6 +350,13 @@ func prometheusSink(cfg TelemetryConfig, hostname string) (metrics.MetricSink, e
 	return sink, nil
 }
 
+func otlpMetricsSink(cfg TelemetryConfig, hostname string) (metrics.MetricSink, error) {
+	if cfg.OTLPEndpoint == "" {
+		return nil, nil
+	}
+	return newOTLPSink(cfg)
+}
+
 func circonusSink(cfg TelemetryConfig, hostname string) (metrics.MetricSink, error) {
 	token := cfg.CirconusAPIToken
 	url := cfg.CirconusSubmissionURL
@@ -376,6 +443,9 @@ func InitTelemetry(cfg TelemetryConfig) (*metrics.InmemSink, error) {
 	if err := addSink(prometheusSink); err != nil {
 		return nil, err
 	}
+	if err := addSink(otlpMetricsSink); err != nil {
+		return nil, err
+	}
 
 	if len(sinks) > 0 {
 		sinks = append(sinks, memSink)
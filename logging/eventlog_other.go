@@ -0,0 +1,14 @@
+// +build !windows
+
+package logging
+
+import (
+	"errors"
+	"io"
+)
+
+// newEventLogWriter is only available on Windows; the Windows Event Log
+// has no analogue on other platforms.
+func newEventLogWriter(source string) (io.Writer, error) {
+	return nil, errors.New("event log forwarding is only supported on windows")
+}
@@ -0,0 +1,37 @@
+// +build windows
+
+package logging
+
+import (
+	"io"
+
+	"golang.org/x/sys/windows"
+)
+
+// eventLogWriter forwards log lines to the Windows Event Log via
+// ReportEvent, implementing io.Writer so it can be plugged into the
+// hclog multi-writer alongside the other sinks.
+type eventLogWriter struct {
+	handle windows.Handle
+}
+
+func newEventLogWriter(source string) (io.Writer, error) {
+	handle, err := windows.RegisterEventSource(nil, windows.StringToUTF16Ptr(source))
+	if err != nil {
+		return nil, err
+	}
+	return &eventLogWriter{handle: handle}, nil
+}
+
+func (w *eventLogWriter) Write(p []byte) (int, error) {
+	msg, err := windows.UTF16PtrFromString(string(p))
+	if err != nil {
+		return 0, err
+	}
+	strings := []*uint16{msg}
+	err = windows.ReportEvent(w.handle, windows.EVENTLOG_INFORMATION_TYPE, 0, 1, 0, 1, 0, &strings[0], nil)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -27,6 +27,10 @@ type Config struct {
 	// SyslogFacility is the destination for syslog forwarding.
 	SyslogFacility string
 
+	// EnableEventLog controls forwarding to the Windows Event Log. It has
+	// no effect on non-Windows platforms.
+	EnableEventLog bool
+
 	//LogFilePath is the path to write the logs to the user specified file.
 	LogFilePath string
 
@@ -83,6 +87,14 @@ func Setup(config Config, out io.Writer) (hclog.InterceptLogger, error) {
 		}
 	}
 
+	if config.EnableEventLog {
+		eventLog, err := newEventLogWriter("Consul")
+		if err != nil {
+			return nil, fmt.Errorf("Failed to setup event log: %w", err)
+		}
+		writers = append(writers, eventLog)
+	}
+
 	// Create a file logger if the user has specified the path to the log file
 	if config.LogFilePath != "" {
 		dir, fileName := filepath.Split(config.LogFilePath)
@@ -38,6 +38,7 @@ const (
 	NetworkAreas       string = "network_areas"
 	Operator           string = "operator"
 	PreparedQuery      string = "prepared_query"
+	QueryView          string = "query_view"
 	Proxy              string = "proxy"
 	ProxyConfig        string = "proxycfg"
 	Raft               string = "raft"
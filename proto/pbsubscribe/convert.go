@@ -0,0 +1,23 @@
+package pbsubscribe
+
+import (
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// NewIntentionFromStructs converts a structs.Intention into the wire-format
+// Intention used by the IntentionMatch topic.
+//
+// TODO: use mog once it supports pointers and slices
+func NewIntentionFromStructs(s *structs.Intention) *Intention {
+	if s == nil {
+		return nil
+	}
+	return &Intention{
+		ID:              s.ID,
+		SourceNS:        s.SourceNS,
+		SourceName:      s.SourceName,
+		DestinationNS:   s.DestinationNS,
+		DestinationName: s.DestinationName,
+		Action:          string(s.Action),
+	}
+}
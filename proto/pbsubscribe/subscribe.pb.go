@@ -37,18 +37,23 @@ const (
 	// ServiceHealthConnect topic contains events for any changes to service
 	// health for connect-enabled services.
 	Topic_ServiceHealthConnect Topic = 2
+	// IntentionMatch topic contains events for any changes to intentions
+	// scoped to a destination service, keyed by the destination service name.
+	Topic_IntentionMatch Topic = 3
 )
 
 var Topic_name = map[int32]string{
 	0: "Unknown",
 	1: "ServiceHealth",
 	2: "ServiceHealthConnect",
+	3: "IntentionMatch",
 }
 
 var Topic_value = map[string]int32{
 	"Unknown":              0,
 	"ServiceHealth":        1,
 	"ServiceHealthConnect": 2,
+	"IntentionMatch":       3,
 }
 
 func (x Topic) String() string {
@@ -260,11 +265,15 @@ type Event_EventBatch struct {
 type Event_ServiceHealth struct {
 	ServiceHealth *ServiceHealthUpdate `protobuf:"bytes,10,opt,name=ServiceHealth,proto3,oneof"`
 }
+type Event_IntentionMatch struct {
+	IntentionMatch *IntentionMatchUpdate `protobuf:"bytes,11,opt,name=IntentionMatch,proto3,oneof"`
+}
 
 func (*Event_EndOfSnapshot) isEvent_Payload()       {}
 func (*Event_NewSnapshotToFollow) isEvent_Payload() {}
 func (*Event_EventBatch) isEvent_Payload()          {}
 func (*Event_ServiceHealth) isEvent_Payload()       {}
+func (*Event_IntentionMatch) isEvent_Payload()      {}
 
 func (m *Event) GetPayload() isEvent_Payload {
 	if m != nil {
@@ -322,6 +331,13 @@ func (m *Event) GetServiceHealth() *ServiceHealthUpdate {
 	return nil
 }
 
+func (m *Event) GetIntentionMatch() *IntentionMatchUpdate {
+	if x, ok := m.GetPayload().(*Event_IntentionMatch); ok {
+		return x.IntentionMatch
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Event) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Event_OneofMarshaler, _Event_OneofUnmarshaler, _Event_OneofSizer, []interface{}{
@@ -329,6 +345,7 @@ func (*Event) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error,
 		(*Event_NewSnapshotToFollow)(nil),
 		(*Event_EventBatch)(nil),
 		(*Event_ServiceHealth)(nil),
+		(*Event_IntentionMatch)(nil),
 	}
 }
 
@@ -360,6 +377,11 @@ func _Event_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.ServiceHealth); err != nil {
 			return err
 		}
+	case *Event_IntentionMatch:
+		_ = b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.IntentionMatch); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Event.Payload has unexpected type %T", x)
@@ -400,6 +422,14 @@ func _Event_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer)
 		err := b.DecodeMessage(msg)
 		m.Payload = &Event_ServiceHealth{msg}
 		return true, err
+	case 11: // Payload.IntentionMatch
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(IntentionMatchUpdate)
+		err := b.DecodeMessage(msg)
+		m.Payload = &Event_IntentionMatch{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -425,6 +455,11 @@ func _Event_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Event_IntentionMatch:
+		s := proto.Size(x.IntentionMatch)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -534,6 +569,150 @@ func (m *ServiceHealthUpdate) GetCheckServiceNode() *pbservice.CheckServiceNode
 	return nil
 }
 
+// Intention describes a single intention affecting a destination service.
+type Intention struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SourceNS             string   `protobuf:"bytes,2,opt,name=SourceNS,proto3" json:"SourceNS,omitempty"`
+	SourceName           string   `protobuf:"bytes,3,opt,name=SourceName,proto3" json:"SourceName,omitempty"`
+	DestinationNS        string   `protobuf:"bytes,4,opt,name=DestinationNS,proto3" json:"DestinationNS,omitempty"`
+	DestinationName      string   `protobuf:"bytes,5,opt,name=DestinationName,proto3" json:"DestinationName,omitempty"`
+	Action               string   `protobuf:"bytes,6,opt,name=Action,proto3" json:"Action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Intention) Reset()         { *m = Intention{} }
+func (m *Intention) String() string { return proto.CompactTextString(m) }
+func (*Intention) ProtoMessage()    {}
+func (*Intention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab3eb8c810e315fb, []int{3}
+}
+func (m *Intention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Intention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Intention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Intention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Intention.Merge(m, src)
+}
+func (m *Intention) XXX_Size() int {
+	return m.Size()
+}
+func (m *Intention) XXX_DiscardUnknown() {
+	xxx_messageInfo_Intention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Intention proto.InternalMessageInfo
+
+func (m *Intention) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Intention) GetSourceNS() string {
+	if m != nil {
+		return m.SourceNS
+	}
+	return ""
+}
+
+func (m *Intention) GetSourceName() string {
+	if m != nil {
+		return m.SourceName
+	}
+	return ""
+}
+
+func (m *Intention) GetDestinationNS() string {
+	if m != nil {
+		return m.DestinationNS
+	}
+	return ""
+}
+
+func (m *Intention) GetDestinationName() string {
+	if m != nil {
+		return m.DestinationName
+	}
+	return ""
+}
+
+func (m *Intention) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+// IntentionMatchUpdate is used for the IntentionMatch topic.
+type IntentionMatchUpdate struct {
+	Op                   CatalogOp  `protobuf:"varint,1,opt,name=Op,proto3,enum=subscribe.CatalogOp" json:"Op,omitempty"`
+	Intention            *Intention `protobuf:"bytes,2,opt,name=Intention,proto3" json:"Intention,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *IntentionMatchUpdate) Reset()         { *m = IntentionMatchUpdate{} }
+func (m *IntentionMatchUpdate) String() string { return proto.CompactTextString(m) }
+func (*IntentionMatchUpdate) ProtoMessage()    {}
+func (*IntentionMatchUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab3eb8c810e315fb, []int{3}
+}
+func (m *IntentionMatchUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntentionMatchUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntentionMatchUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntentionMatchUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntentionMatchUpdate.Merge(m, src)
+}
+func (m *IntentionMatchUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntentionMatchUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntentionMatchUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntentionMatchUpdate proto.InternalMessageInfo
+
+func (m *IntentionMatchUpdate) GetOp() CatalogOp {
+	if m != nil {
+		return m.Op
+	}
+	return CatalogOp_Register
+}
+
+func (m *IntentionMatchUpdate) GetIntention() *Intention {
+	if m != nil {
+		return m.Intention
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("subscribe.Topic", Topic_name, Topic_value)
 	proto.RegisterEnum("subscribe.CatalogOp", CatalogOp_name, CatalogOp_value)
@@ -541,6 +720,8 @@ func init() {
 	proto.RegisterType((*Event)(nil), "subscribe.Event")
 	proto.RegisterType((*EventBatch)(nil), "subscribe.EventBatch")
 	proto.RegisterType((*ServiceHealthUpdate)(nil), "subscribe.ServiceHealthUpdate")
+	proto.RegisterType((*Intention)(nil), "subscribe.Intention")
+	proto.RegisterType((*IntentionMatchUpdate)(nil), "subscribe.IntentionMatchUpdate")
 }
 
 func init() { proto.RegisterFile("proto/pbsubscribe/subscribe.proto", fileDescriptor_ab3eb8c810e315fb) }
@@ -910,6 +1091,26 @@ func (m *Event_ServiceHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Event_IntentionMatch) MarshalTo(dAtA []byte) (int, error) {
+	return m.MarshalToSizedBuffer(dAtA[:m.Size()])
+}
+
+func (m *Event_IntentionMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IntentionMatch != nil {
+		{
+			size, err := m.IntentionMatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubscribe(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1103,6 +1304,18 @@ func (m *Event_ServiceHealth) Size() (n int) {
 	}
 	return n
 }
+func (m *Event_IntentionMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IntentionMatch != nil {
+		l = m.IntentionMatch.Size()
+		n += 1 + l + sovSubscribe(uint64(l))
+	}
+	return n
+}
 func (m *EventBatch) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1140,6 +1353,174 @@ func (m *ServiceHealthUpdate) Size() (n int) {
 	return n
 }
 
+func (m *Intention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Intention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Intention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintSubscribe(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DestinationName) > 0 {
+		i -= len(m.DestinationName)
+		copy(dAtA[i:], m.DestinationName)
+		i = encodeVarintSubscribe(dAtA, i, uint64(len(m.DestinationName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DestinationNS) > 0 {
+		i -= len(m.DestinationNS)
+		copy(dAtA[i:], m.DestinationNS)
+		i = encodeVarintSubscribe(dAtA, i, uint64(len(m.DestinationNS)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceName) > 0 {
+		i -= len(m.SourceName)
+		copy(dAtA[i:], m.SourceName)
+		i = encodeVarintSubscribe(dAtA, i, uint64(len(m.SourceName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceNS) > 0 {
+		i -= len(m.SourceNS)
+		copy(dAtA[i:], m.SourceNS)
+		i = encodeVarintSubscribe(dAtA, i, uint64(len(m.SourceNS)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintSubscribe(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Intention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovSubscribe(uint64(l))
+	}
+	l = len(m.SourceNS)
+	if l > 0 {
+		n += 1 + l + sovSubscribe(uint64(l))
+	}
+	l = len(m.SourceName)
+	if l > 0 {
+		n += 1 + l + sovSubscribe(uint64(l))
+	}
+	l = len(m.DestinationNS)
+	if l > 0 {
+		n += 1 + l + sovSubscribe(uint64(l))
+	}
+	l = len(m.DestinationName)
+	if l > 0 {
+		n += 1 + l + sovSubscribe(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovSubscribe(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IntentionMatchUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntentionMatchUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntentionMatchUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Intention != nil {
+		{
+			size, err := m.Intention.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubscribe(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintSubscribe(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntentionMatchUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovSubscribe(uint64(m.Op))
+	}
+	if m.Intention != nil {
+		l = m.Intention.Size()
+		n += 1 + l + sovSubscribe(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovSubscribe(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1767,6 +2148,361 @@ func (m *ServiceHealthUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Intention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscribe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Intention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Intention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscribe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceNS", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscribe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceNS = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscribe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationNS", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscribe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationNS = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscribe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscribe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscribe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntentionMatchUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscribe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntentionMatchUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntentionMatchUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscribe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= CatalogOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscribe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Intention == nil {
+				m.Intention = &Intention{}
+			}
+			if err := m.Intention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscribe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubscribe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSubscribe(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22,7 +22,7 @@ func init() {
 }
 
 func (serviceWindows) Execute(args []string, r <-chan wsvc.ChangeRequest, s chan<- wsvc.Status) (svcSpecificEC bool, exitCode uint32) {
-	const accCommands = wsvc.AcceptStop | wsvc.AcceptShutdown
+	const accCommands = wsvc.AcceptStop | wsvc.AcceptShutdown | wsvc.AcceptPauseAndContinue
 	s <- wsvc.Status{State: wsvc.StartPending}
 
 	s <- wsvc.Status{State: wsvc.Running, Accepts: accCommands}
@@ -31,12 +31,14 @@ func (serviceWindows) Execute(args []string, r <-chan wsvc.ChangeRequest, s chan
 		switch c.Cmd {
 		case wsvc.Interrogate:
 			s <- c.CurrentStatus
+		case wsvc.Pause:
+			s <- wsvc.Status{State: wsvc.Paused, Accepts: accCommands}
+		case wsvc.Continue:
+			s <- wsvc.Status{State: wsvc.Running, Accepts: accCommands}
 		case wsvc.Stop, wsvc.Shutdown:
 			chanGraceExit <- 1
 			s <- wsvc.Status{State: wsvc.StopPending}
 			return false, 0
 		}
 	}
-
-	return false, 0
 }